@@ -789,38 +789,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/healthy": {
-            "get": {
-                "description": "Get app health",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Health"
-                ],
-                "summary": "Get Health",
-                "responses": {
-                    "200": {
-                        "description": ""
-                    },
-                    "400": {
-                        "description": ""
-                    },
-                    "404": {
-                        "description": ""
-                    },
-                    "500": {
-                        "description": ""
-                    }
-                }
-            }
-        },
-        "/jobs": {
+        "/cron-jobs": {
             "get": {
-                "description": "Get Jobs",
+                "description": "Get CronJobs",
                 "consumes": [
                     "application/json"
                 ],
@@ -828,9 +799,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Job"
+                    "CronJob"
                 ],
-                "summary": "Get Jobs",
+                "summary": "Get CronJobs",
                 "parameters": [
                     {
                         "type": "integer",
@@ -857,7 +828,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.Job"
+                                "$ref": "#/definitions/model.CronJob"
                             }
                         }
                     },
@@ -873,7 +844,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "create by json config",
+                "description": "Create by json config, the job defined by cron job is created when the cron expression is fired",
                 "consumes": [
                     "application/json"
                 ],
@@ -881,17 +852,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Job"
+                    "CronJob"
                 ],
-                "summary": "Create Job",
+                "summary": "Create CronJob",
                 "parameters": [
                     {
-                        "description": "Job",
-                        "name": "Job",
+                        "description": "CronJob",
+                        "name": "CronJob",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.CreateJobRequest"
+                            "$ref": "#/definitions/types.CreateCronJobRequest"
                         }
                     }
                 ],
@@ -899,7 +870,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Job"
+                            "$ref": "#/definitions/model.CronJob"
                         }
                     },
                     "400": {
@@ -914,9 +885,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/jobs/{id}": {
+        "/cron-jobs/{id}": {
             "get": {
-                "description": "Get Job by id",
+                "description": "Get CronJob by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -924,9 +895,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Job"
+                    "CronJob"
                 ],
-                "summary": "Get Job",
+                "summary": "Get CronJob",
                 "parameters": [
                     {
                         "type": "string",
@@ -940,7 +911,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Job"
+                            "$ref": "#/definitions/model.CronJob"
                         }
                     },
                     "400": {
@@ -963,9 +934,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Job"
+                    "CronJob"
                 ],
-                "summary": "Destroy Job",
+                "summary": "Destroy CronJob",
                 "parameters": [
                     {
                         "type": "string",
@@ -999,9 +970,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Job"
+                    "CronJob"
                 ],
-                "summary": "Update Job",
+                "summary": "Update CronJob",
                 "parameters": [
                     {
                         "type": "string",
@@ -1011,13 +982,54 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Job",
-                        "name": "Job",
+                        "description": "CronJob",
+                        "name": "CronJob",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.UpdateJobRequest"
+                            "$ref": "#/definitions/types.UpdateCronJobRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.CronJob"
                         }
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/cron-jobs/{id}/trigger": {
+            "post": {
+                "description": "Create the job defined by cron job immediately",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CronJob"
+                ],
+                "summary": "Trigger CronJob",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1039,9 +1051,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/oauth": {
+        "/healthy": {
             "get": {
-                "description": "Get Oauths",
+                "description": "Get app health",
                 "consumes": [
                     "application/json"
                 ],
@@ -1049,9 +1061,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Oauth"
+                    "Health"
                 ],
-                "summary": "Get Oauths",
+                "summary": "Get Health",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/jobs": {
+            "get": {
+                "description": "Get Jobs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Job"
+                ],
+                "summary": "Get Jobs",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1078,7 +1119,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.Oauth"
+                                "$ref": "#/definitions/model.Job"
                             }
                         }
                     },
@@ -1102,17 +1143,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Oauth"
+                    "Job"
                 ],
-                "summary": "Create Oauth",
+                "summary": "Create Job",
                 "parameters": [
                     {
-                        "description": "Oauth",
-                        "name": "Oauth",
+                        "description": "Job",
+                        "name": "Job",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.CreateOauthRequest"
+                            "$ref": "#/definitions/types.CreateJobRequest"
                         }
                     }
                 ],
@@ -1120,7 +1161,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Oauth"
+                            "$ref": "#/definitions/model.Job"
                         }
                     },
                     "400": {
@@ -1135,9 +1176,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/oauth/{id}": {
+        "/jobs/{id}": {
             "get": {
-                "description": "Get Oauth by id",
+                "description": "Get Job by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -1145,9 +1186,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Oauth"
+                    "Job"
                 ],
-                "summary": "Get Oauth",
+                "summary": "Get Job",
                 "parameters": [
                     {
                         "type": "string",
@@ -1161,7 +1202,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Oauth"
+                            "$ref": "#/definitions/model.Job"
                         }
                     },
                     "400": {
@@ -1184,9 +1225,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Oauth"
+                    "Job"
                 ],
-                "summary": "Destroy Oauth",
+                "summary": "Destroy Job",
                 "parameters": [
                     {
                         "type": "string",
@@ -1220,9 +1261,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Oauth"
+                    "Job"
                 ],
-                "summary": "Update Oauth",
+                "summary": "Update Job",
                 "parameters": [
                     {
                         "type": "string",
@@ -1232,12 +1273,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Oauth",
-                        "name": "Oauth",
+                        "description": "Job",
+                        "name": "Job",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.UpdateOauthRequest"
+                            "$ref": "#/definitions/types.UpdateJobRequest"
                         }
                     }
                 ],
@@ -1245,7 +1286,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Oauth"
+                            "$ref": "#/definitions/model.Job"
                         }
                     },
                     "400": {
@@ -1260,9 +1301,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/peers": {
-            "get": {
-                "description": "Get Peers",
+        "/jobs/{id}/cancel": {
+            "post": {
+                "description": "Cancel by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -1270,17 +1311,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Peer"
+                    "Job"
+                ],
+                "summary": "Cancel Job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Peers",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.Job"
                         }
                     },
                     "400": {
@@ -1289,42 +1336,69 @@ const docTemplate = `{
                     "404": {
                         "description": ""
                     },
+                    "409": {
+                        "description": ""
+                    },
                     "500": {
                         "description": ""
                     }
                 }
             }
         },
-        "/permissions": {
+        "/oauth": {
             "get": {
-                "description": "Get Permissions",
+                "description": "Get Oauths",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Permission"
+                    "Oauth"
+                ],
+                "summary": "Get Oauths",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "current page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 50,
+                        "minimum": 2,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "return max item count, default 10, max 50",
+                        "name": "per_page",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Permissions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/rbac.Permission"
+                                "$ref": "#/definitions/model.Oauth"
                             }
                         }
                     },
                     "400": {
                         "description": ""
                     },
+                    "404": {
+                        "description": ""
+                    },
                     "500": {
                         "description": ""
                     }
                 }
-            }
-        },
-        "/preheats": {
+            },
             "post": {
                 "description": "create by json config",
                 "consumes": [
@@ -1334,17 +1408,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Preheat"
+                    "Oauth"
                 ],
-                "summary": "Create V1 Preheat",
+                "summary": "Create Oauth",
                 "parameters": [
                     {
-                        "description": "Preheat",
-                        "name": "Preheat",
+                        "description": "Oauth",
+                        "name": "Oauth",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.CreateV1PreheatRequest"
+                            "$ref": "#/definitions/types.CreateOauthRequest"
                         }
                     }
                 ],
@@ -1352,7 +1426,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/types.CreateV1PreheatResponse"
+                            "$ref": "#/definitions/model.Oauth"
                         }
                     },
                     "400": {
@@ -1367,9 +1441,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/preheats/{id}": {
+        "/oauth/{id}": {
             "get": {
-                "description": "Get Preheat by id",
+                "description": "Get Oauth by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -1377,9 +1451,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Preheat"
+                    "Oauth"
                 ],
-                "summary": "Get V1 Preheat",
+                "summary": "Get Oauth",
                 "parameters": [
                     {
                         "type": "string",
@@ -1393,7 +1467,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/types.GetV1PreheatResponse"
+                            "$ref": "#/definitions/model.Oauth"
                         }
                     },
                     "400": {
@@ -1406,11 +1480,243 @@ const docTemplate = `{
                         "description": ""
                     }
                 }
-            }
-        },
-        "/roles": {
-            "get": {
-                "description": "Get roles",
+            },
+            "delete": {
+                "description": "Destroy by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Oauth"
+                ],
+                "summary": "Destroy Oauth",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            },
+            "patch": {
+                "description": "Update by json config",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Oauth"
+                ],
+                "summary": "Update Oauth",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Oauth",
+                        "name": "Oauth",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.UpdateOauthRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Oauth"
+                        }
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/peers": {
+            "get": {
+                "description": "Get Peers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Peer"
+                ],
+                "summary": "Get Peers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/permissions": {
+            "get": {
+                "description": "Get Permissions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Permission"
+                ],
+                "summary": "Get Permissions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/rbac.Permission"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/preheats": {
+            "post": {
+                "description": "create by json config",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Preheat"
+                ],
+                "summary": "Create V1 Preheat",
+                "parameters": [
+                    {
+                        "description": "Preheat",
+                        "name": "Preheat",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.CreateV1PreheatRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/types.CreateV1PreheatResponse"
+                        }
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/preheats/{id}": {
+            "get": {
+                "description": "Get Preheat by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Preheat"
+                ],
+                "summary": "Get V1 Preheat",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/types.GetV1PreheatResponse"
+                        }
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/roles": {
+            "get": {
+                "description": "Get roles",
                 "consumes": [
                     "application/json"
                 ],
@@ -1844,9 +2150,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/scheduler-clusters/{id}/schedulers/{scheduler_id}": {
-            "put": {
-                "description": "Add Scheduler to schedulerCluster",
+        "/scheduler-clusters/{id}/federated-scheduler-clusters": {
+            "get": {
+                "description": "Get federated SchedulerClusters of schedulerCluster",
                 "consumes": [
                     "application/json"
                 ],
@@ -1856,7 +2162,7 @@ const docTemplate = `{
                 "tags": [
                     "SchedulerCluster"
                 ],
-                "summary": "Add Scheduler to schedulerCluster",
+                "summary": "Get federated SchedulerClusters",
                 "parameters": [
                     {
                         "type": "string",
@@ -1864,18 +2170,162 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "scheduler id",
-                        "name": "scheduler_id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": ""
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.SchedulerClusterFederation"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/scheduler-clusters/{id}/federated-scheduler-clusters/{federated_scheduler_cluster_id}": {
+            "put": {
+                "description": "Add federated SchedulerCluster to schedulerCluster, schedulers of schedulerCluster can find the peers of tasks in federated schedulerCluster",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SchedulerCluster"
+                ],
+                "summary": "Add federated SchedulerCluster to schedulerCluster",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "federated scheduler cluster id",
+                        "name": "federated_scheduler_cluster_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "FederatedSchedulerCluster",
+                        "name": "FederatedSchedulerCluster",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.AddFederatedSchedulerClusterToSchedulerClusterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SchedulerClusterFederation"
+                        }
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete federated SchedulerCluster to schedulerCluster",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SchedulerCluster"
+                ],
+                "summary": "Delete federated SchedulerCluster to schedulerCluster",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "federated scheduler cluster id",
+                        "name": "federated_scheduler_cluster_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/scheduler-clusters/{id}/schedulers/{scheduler_id}": {
+            "put": {
+                "description": "Add Scheduler to schedulerCluster",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SchedulerCluster"
+                ],
+                "summary": "Add Scheduler to schedulerCluster",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "scheduler id",
+                        "name": "scheduler_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
                     },
                     "400": {
                         "description": ""
@@ -3369,10 +3819,311 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.User"
-                        }
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.User"
+                        }
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "description": "Get Users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get Users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "current page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 50,
+                        "minimum": 2,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "return max item count, default 10, max 50",
+                        "name": "per_page",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.User"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "description": "Get User by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.User"
+                        }
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            },
+            "patch": {
+                "description": "Update by json config",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User",
+                        "name": "User",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.User"
+                        }
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/users/{id}/reset_password": {
+            "post": {
+                "description": "reset password by json config",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Reset Password For User",
+                "parameters": [
+                    {
+                        "description": "User",
+                        "name": "User",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/users/{id}/roles": {
+            "get": {
+                "description": "get roles by json config",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get User Roles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            }
+        },
+        "/users/{id}/roles/{role}": {
+            "put": {
+                "description": "add role to user by uri config",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Add Role For User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "role",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": ""
+                    },
+                    "500": {
+                        "description": ""
+                    }
+                }
+            },
+            "delete": {
+                "description": "delete role by uri config",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete Role For User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "role",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
                     },
                     "400": {
                         "description": ""
@@ -3383,9 +4134,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
+        "/webhook-rules": {
             "get": {
-                "description": "Get Users",
+                "description": "Get WebhookRules",
                 "consumes": [
                     "application/json"
                 ],
@@ -3393,9 +4144,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "WebhookRule"
                 ],
-                "summary": "Get Users",
+                "summary": "Get WebhookRules",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3422,7 +4173,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.User"
+                                "$ref": "#/definitions/model.WebhookRule"
                             }
                         }
                     },
@@ -3436,11 +4187,9 @@ const docTemplate = `{
                         "description": ""
                     }
                 }
-            }
-        },
-        "/users/{id}": {
-            "get": {
-                "description": "Get User by id",
+            },
+            "post": {
+                "description": "Create by json config, preheat jobs are created for the images pushed to registry matching the webhook rule",
                 "consumes": [
                     "application/json"
                 ],
@@ -3448,23 +4197,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "WebhookRule"
                 ],
-                "summary": "Get User",
+                "summary": "Create WebhookRule",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "WebhookRule",
+                        "name": "WebhookRule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.CreateWebhookRuleRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.User"
+                            "$ref": "#/definitions/model.WebhookRule"
                         }
                     },
                     "400": {
@@ -3477,9 +4228,11 @@ const docTemplate = `{
                         "description": ""
                     }
                 }
-            },
-            "patch": {
-                "description": "Update by json config",
+            }
+        },
+        "/webhook-rules/{id}": {
+            "get": {
+                "description": "Get WebhookRule by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -3487,9 +4240,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "WebhookRule"
                 ],
-                "summary": "Update User",
+                "summary": "Get WebhookRule",
                 "parameters": [
                     {
                         "type": "string",
@@ -3497,22 +4250,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "User",
-                        "name": "User",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/types.UpdateUserRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.User"
+                            "$ref": "#/definitions/model.WebhookRule"
                         }
                     },
                     "400": {
@@ -3525,11 +4269,9 @@ const docTemplate = `{
                         "description": ""
                     }
                 }
-            }
-        },
-        "/users/{id}/reset_password": {
-            "post": {
-                "description": "reset password by json config",
+            },
+            "delete": {
+                "description": "Destroy by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -3537,43 +4279,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
-                ],
-                "summary": "Reset Password For User",
-                "parameters": [
-                    {
-                        "description": "User",
-                        "name": "User",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/types.ResetPasswordRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": ""
-                    },
-                    "400": {
-                        "description": ""
-                    },
-                    "500": {
-                        "description": ""
-                    }
-                }
-            }
-        },
-        "/users/{id}/roles": {
-            "get": {
-                "description": "get roles by json config",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "User"
+                    "WebhookRule"
                 ],
-                "summary": "Get User Roles",
+                "summary": "Destroy WebhookRule",
                 "parameters": [
                     {
                         "type": "string",
@@ -3585,26 +4293,21 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
+                        "description": ""
                     },
                     "400": {
                         "description": ""
                     },
+                    "404": {
+                        "description": ""
+                    },
                     "500": {
                         "description": ""
                     }
                 }
-            }
-        },
-        "/users/{id}/roles/{role}": {
-            "put": {
-                "description": "add role to user by uri config",
+            },
+            "patch": {
+                "description": "Update by json config",
                 "consumes": [
                     "application/json"
                 ],
@@ -3612,9 +4315,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "WebhookRule"
                 ],
-                "summary": "Add Role For User",
+                "summary": "Update WebhookRule",
                 "parameters": [
                     {
                         "type": "string",
@@ -3624,27 +4327,37 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "role",
-                        "name": "role",
-                        "in": "path",
-                        "required": true
+                        "description": "WebhookRule",
+                        "name": "WebhookRule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.UpdateWebhookRuleRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": ""
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookRule"
+                        }
                     },
                     "400": {
                         "description": ""
                     },
+                    "404": {
+                        "description": ""
+                    },
                     "500": {
                         "description": ""
                     }
                 }
-            },
-            "delete": {
-                "description": "delete role by uri config",
+            }
+        },
+        "/webhooks/registry": {
+            "post": {
+                "description": "Receive the push event of Docker Distribution or Harbor, and create preheat jobs for the images matching the webhook rules of secret",
                 "consumes": [
                     "application/json"
                 ],
@@ -3652,32 +4365,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Webhook"
                 ],
-                "summary": "Delete Role For User",
+                "summary": "Create Registry Webhook Jobs",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "role",
-                        "name": "role",
-                        "in": "path",
+                        "description": "secret of webhook rule",
+                        "name": "Authorization",
+                        "in": "header",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": ""
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Job"
+                            }
+                        }
                     },
                     "400": {
                         "description": ""
                     },
+                    "401": {
+                        "description": ""
+                    },
                     "500": {
                         "description": ""
                     }
@@ -3759,6 +4474,47 @@ const docTemplate = `{
                 }
             }
         },
+        "model.CronJob": {
+            "type": "object",
+            "properties": {
+                "args": {
+                    "$ref": "#/definitions/model.JSONMap"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "cron": {
+                    "type": "string"
+                },
+                "enable": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scheduler_clusters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SchedulerCluster"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "model.JSONMap": {
             "type": "object",
             "additionalProperties": true
@@ -3775,6 +4531,9 @@ const docTemplate = `{
                 "created_at": {
                     "type": "string"
                 },
+                "cron_job_id": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -3892,6 +4651,12 @@ const docTemplate = `{
                 "config": {
                     "$ref": "#/definitions/model.JSONMap"
                 },
+                "cordoned_host_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -3927,6 +4692,29 @@ const docTemplate = `{
                 }
             }
         },
+        "model.SchedulerClusterFederation": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "federated_scheduler_cluster_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "scheduler_cluster_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
         "model.SecurityGroup": {
             "type": "object",
             "properties": {
@@ -4106,11 +4894,55 @@ const docTemplate = `{
                 "phone": {
                     "type": "string"
                 },
-                "state": {
+                "state": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.WebhookRule": {
+            "type": "object",
+            "properties": {
+                "args": {
+                    "$ref": "#/definitions/model.JSONMap"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "enable": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "registry": {
+                    "type": "string"
+                },
+                "repository": {
+                    "type": "string"
+                },
+                "scheduler_clusters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SchedulerCluster"
+                    }
+                },
+                "tag": {
                     "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
@@ -4146,6 +4978,14 @@ const docTemplate = `{
                 }
             }
         },
+        "types.AddFederatedSchedulerClusterToSchedulerClusterRequest": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "type": "integer"
+                }
+            }
+        },
         "types.AddPermissionForRoleRequest": {
             "type": "object",
             "required": [
@@ -4229,6 +5069,47 @@ const docTemplate = `{
                 }
             }
         },
+        "types.CreateCronJobRequest": {
+            "type": "object",
+            "required": [
+                "args",
+                "cron",
+                "name",
+                "type"
+            ],
+            "properties": {
+                "args": {
+                    "$ref": "#/definitions/types.PreheatArgs"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "cron": {
+                    "type": "string"
+                },
+                "enable": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scheduler_cluster_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "preheat"
+                    ]
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "types.CreateJobRequest": {
             "type": "object",
             "required": [
@@ -4522,6 +5403,48 @@ const docTemplate = `{
                 }
             }
         },
+        "types.CreateWebhookRuleRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "secret"
+            ],
+            "properties": {
+                "args": {
+                    "$ref": "#/definitions/types.WebhookPreheatArgs"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "enable": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "registry": {
+                    "type": "string"
+                },
+                "repository": {
+                    "type": "string"
+                },
+                "scheduler_cluster_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "tag": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "types.DeletePermissionForRoleRequest": {
             "type": "object",
             "required": [
@@ -4558,6 +5481,64 @@ const docTemplate = `{
                 }
             }
         },
+        "types.PreheatArgs": {
+            "type": "object",
+            "required": [
+                "type",
+                "url"
+            ],
+            "properties": {
+                "all_platforms": {
+                    "type": "boolean"
+                },
+                "filter": {
+                    "type": "string"
+                },
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "platforms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.PreheatPlatform"
+                    }
+                },
+                "tag": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "image",
+                        "file"
+                    ]
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "types.PreheatPlatform": {
+            "type": "object",
+            "required": [
+                "architecture",
+                "os"
+            ],
+            "properties": {
+                "architecture": {
+                    "type": "string"
+                },
+                "os": {
+                    "type": "string"
+                },
+                "variant": {
+                    "type": "string"
+                }
+            }
+        },
         "types.ResetPasswordRequest": {
             "type": "object",
             "required": [
@@ -4595,10 +5576,120 @@ const docTemplate = `{
         "types.SchedulerClusterConfig": {
             "type": "object",
             "properties": {
+                "back_to_source_concurrent_limit": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "back_to_source_rate_limit": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "candidate_parent_limit": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                },
+                "evaluator_profile": {
+                    "type": "string"
+                },
+                "evaluator_profiles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.SchedulerClusterEvaluatorProfile"
+                    }
+                },
                 "filter_parent_limit": {
                     "type": "integer",
                     "maximum": 100,
                     "minimum": 1
+                },
+                "piece_range_assignment": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "types.SchedulerClusterEvaluatorProfile": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "bandwidth_weight": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "finished_piece_weight": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "free_load_weight": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "host_type_affinity_weight": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "idc_affinity_weight": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "location_affinity_weight": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string"
+                },
+                "net_topology_affinity_weight": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "network_distance_weight": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.SchedulerClusterEvaluatorRule"
+                    }
+                }
+            }
+        },
+        "types.SchedulerClusterEvaluatorRule": {
+            "type": "object",
+            "properties": {
+                "host_type": {
+                    "type": "string",
+                    "enum": [
+                        "normal",
+                        "super",
+                        "strong",
+                        "weak"
+                    ]
+                },
+                "idc": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "net_topology": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": -1
                 }
             }
         },
@@ -4722,6 +5813,32 @@ const docTemplate = `{
                 }
             }
         },
+        "types.UpdateCronJobRequest": {
+            "type": "object",
+            "properties": {
+                "args": {
+                    "$ref": "#/definitions/types.PreheatArgs"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "cron": {
+                    "type": "string"
+                },
+                "enable": {
+                    "type": "boolean"
+                },
+                "scheduler_cluster_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "types.UpdateJobRequest": {
             "type": "object",
             "properties": {
@@ -4916,6 +6033,67 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "types.UpdateWebhookRuleRequest": {
+            "type": "object",
+            "properties": {
+                "args": {
+                    "$ref": "#/definitions/types.WebhookPreheatArgs"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "enable": {
+                    "type": "boolean"
+                },
+                "registry": {
+                    "type": "string"
+                },
+                "repository": {
+                    "type": "string"
+                },
+                "scheduler_cluster_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "tag": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "types.WebhookPreheatArgs": {
+            "type": "object",
+            "properties": {
+                "all_platforms": {
+                    "type": "boolean"
+                },
+                "filter": {
+                    "type": "string"
+                },
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "platforms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.PreheatPlatform"
+                    }
+                },
+                "tag": {
+                    "type": "string"
+                }
+            }
         }
     }
 }`
@@ -45,6 +45,13 @@ const (
 	PatternSource   = "source"
 )
 
+// Download priority.
+const (
+	PriorityLow     = "low"
+	PriorityDefault = "default"
+	PriorityHigh    = "high"
+)
+
 // Download limit.
 const (
 	DefaultPerPeerDownloadLimit = 20 * unit.MB
@@ -58,11 +65,13 @@ const (
 	DefaultTimestampFormat = "2006-01-02 15:04:05"
 	SchemaHTTP             = "http"
 
-	DefaultTaskExpireTime  = 6 * time.Hour
-	DefaultGCInterval      = 1 * time.Minute
-	DefaultDaemonAliveTime = 5 * time.Minute
-	DefaultScheduleTimeout = 5 * time.Minute
-	DefaultDownloadTimeout = 5 * time.Minute
+	DefaultTaskExpireTime   = 6 * time.Hour
+	DefaultGCInterval       = 1 * time.Minute
+	DefaultDaemonAliveTime  = 5 * time.Minute
+	DefaultScheduleTimeout  = 5 * time.Minute
+	DefaultDownloadTimeout  = 5 * time.Minute
+	DefaultProbeInterval    = 10 * time.Minute
+	DefaultAnnounceInterval = 30 * time.Second
 
 	DefaultSchedulerSchema = "http"
 	DefaultSchedulerIP     = "127.0.0.1"
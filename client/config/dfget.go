@@ -74,6 +74,14 @@ type ClientOption struct {
 	// default:`p2p`.
 	Pattern string `yaml:"pattern,omitempty" mapstructure:"pattern,omitempty"`
 
+	// Priority download priority, must be 'low' or 'default' or 'high',
+	// default:`default`.
+	Priority string `yaml:"priority,omitempty" mapstructure:"priority,omitempty"`
+
+	// Application is the name of application which the download belongs to,
+	// it is used by the watchers of scheduler events.
+	Application string `yaml:"application,omitempty" mapstructure:"application,omitempty"`
+
 	// CA certificate to verify when supernode interact with the source.
 	Cacerts []string `yaml:"cacert,omitempty" mapstructure:"cacert,omitempty"`
 
@@ -161,6 +169,12 @@ func (cfg *ClientOption) Validate() error {
 		return fmt.Errorf("output %s: %w", err.Error(), dferrors.ErrInvalidHeader)
 	}
 
+	switch cfg.Priority {
+	case "", PriorityLow, PriorityDefault, PriorityHigh:
+	default:
+		return fmt.Errorf("priority %s, available priority: low, default, high: %w", cfg.Priority, dferrors.ErrInvalidArgument)
+	}
+
 	if int64(cfg.RateLimit.Limit) < DefaultMinRate.ToNumber() {
 		return fmt.Errorf("rate limit must be greater than %s: %w", DefaultMinRate.String(), dferrors.ErrInvalidArgument)
 	}
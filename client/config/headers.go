@@ -22,6 +22,10 @@ const (
 	HeaderDragonflyTask   = "X-Dragonfly-Task"
 	HeaderDragonflyRange  = "X-Dragonfly-Range"
 	HeaderDragonflyTag    = "X-Dragonfly-Tag"
+	// HeaderDragonflyPriority is used for priority of download.
+	HeaderDragonflyPriority = "X-Dragonfly-Priority"
+	// HeaderDragonflyApplication is used for application of download.
+	HeaderDragonflyApplication = "X-Dragonfly-Application"
 	// HeaderDragonflyRegistry is used for dynamic registry mirrors.
 	HeaderDragonflyRegistry = "X-Dragonfly-Registry"
 	// HeaderDragonflyObjectMetaDigest is used for digest of object storage.
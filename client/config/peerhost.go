@@ -171,6 +171,21 @@ func ConvertPattern(p string, defaultPattern rpcbase.Pattern) rpcbase.Pattern {
 	return defaultPattern
 }
 
+// ConvertPriority converts the priority name to rpcbase.Priority,
+// unknown priority is regarded as the default priority.
+func ConvertPriority(p string) rpcbase.Priority {
+	switch p {
+	case PriorityLow:
+		return rpcbase.Priority_LOW_PRIORITY
+	case PriorityHigh:
+		return rpcbase.Priority_HIGH_PRIORITY
+	case PriorityDefault, "":
+		return rpcbase.Priority_DEFAULT_PRIORITY
+	}
+	logger.Warnf("unknown priority %s, use default priority", p)
+	return rpcbase.Priority_DEFAULT_PRIORITY
+}
+
 type SchedulerOption struct {
 	// Manager is to get the scheduler configuration remotely.
 	Manager ManagerOption `mapstructure:"manager" yaml:"manager"`
@@ -180,6 +195,10 @@ type SchedulerOption struct {
 	ScheduleTimeout util.Duration `mapstructure:"scheduleTimeout" yaml:"scheduleTimeout"`
 	// DisableAutoBackSource indicates not back source normally, only scheduler says back source.
 	DisableAutoBackSource bool `mapstructure:"disableAutoBackSource" yaml:"disableAutoBackSource"`
+	// ProbeInterval is the interval of probing hosts sampled by scheduler, zero value disables probing.
+	ProbeInterval util.Duration `mapstructure:"probeInterval" yaml:"probeInterval"`
+	// AnnounceInterval is the interval of announcing host load to schedulers, zero value disables announcing.
+	AnnounceInterval util.Duration `mapstructure:"announceInterval" yaml:"announceInterval"`
 }
 
 type ManagerOption struct {
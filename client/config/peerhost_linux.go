@@ -56,7 +56,9 @@ var peerHostConfig = func() *DaemonOption {
 					Addr: "127.0.0.1:8002",
 				},
 			},
-			ScheduleTimeout: util.Duration{Duration: DefaultScheduleTimeout},
+			ScheduleTimeout:  util.Duration{Duration: DefaultScheduleTimeout},
+			ProbeInterval:    util.Duration{Duration: DefaultProbeInterval},
+			AnnounceInterval: util.Duration{Duration: DefaultAnnounceInterval},
 		},
 		Host: HostOption{
 			Hostname:       fqdn.FQDNHostname,
@@ -270,6 +270,12 @@ func TestPeerHostOption_Load(t *testing.T) {
 				Duration: 0,
 			},
 			DisableAutoBackSource: true,
+			ProbeInterval: util.Duration{
+				Duration: 10 * time.Minute,
+			},
+			AnnounceInterval: util.Duration{
+				Duration: 30 * time.Second,
+			},
 		},
 		Host: HostOption{
 			Hostname:       "d7y.io",
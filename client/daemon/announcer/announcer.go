@@ -0,0 +1,214 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package announcer
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/shirou/gopsutil/v3/net"
+
+	logger "d7y.io/dragonfly/v2/internal/dflog"
+	"d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+	schedulerclient "d7y.io/dragonfly/v2/pkg/rpc/scheduler/client"
+)
+
+const (
+	// DefaultTimeout is the default timeout of announcing host.
+	DefaultTimeout = 10 * time.Second
+
+	// loopbackPrefix is the name prefix of loopback interfaces, the traffic is not counted.
+	loopbackPrefix = "lo"
+)
+
+// Announcer collects the load of host periodically,
+// and announces it to schedulers.
+type Announcer interface {
+	// Start starts announcing.
+	Start()
+
+	// Stop stops announcing.
+	Stop()
+}
+
+// Option is a functional option for configuring the announcer.
+type Option func(a *announcer)
+
+// WithUploadBandwidthLimit sets the upload bandwidth capacity of host in bytes per second.
+func WithUploadBandwidthLimit(limit uint64) Option {
+	return func(a *announcer) {
+		a.uploadBandwidthLimit = limit
+	}
+}
+
+// WithTimeout sets the timeout of announcing host.
+func WithTimeout(timeout time.Duration) Option {
+	return func(a *announcer) {
+		a.timeout = timeout
+	}
+}
+
+type announcer struct {
+	host                 *scheduler.PeerHost
+	schedulerClient      schedulerclient.Client
+	interval             time.Duration
+	dataDir              string
+	uploadBandwidthLimit uint64
+	timeout              time.Duration
+	done                 chan bool
+
+	// rxBytes, txBytes and collectAt are the network counters of the last collection.
+	rxBytes   uint64
+	txBytes   uint64
+	collectAt time.Time
+}
+
+var _ Announcer = (*announcer)(nil)
+
+// New returns a new Announcer.
+func New(host *scheduler.PeerHost, schedulerClient schedulerclient.Client, interval time.Duration, dataDir string, options ...Option) Announcer {
+	a := &announcer{
+		host:            host,
+		schedulerClient: schedulerClient,
+		interval:        interval,
+		dataDir:         dataDir,
+		timeout:         DefaultTimeout,
+		done:            make(chan bool),
+	}
+
+	for _, opt := range options {
+		opt(a)
+	}
+
+	return a
+}
+
+// Start starts announcing, the network rates are calculated
+// by the counters between two collections.
+func (a *announcer) Start() {
+	go func() {
+		a.collect()
+
+		tick := time.NewTicker(a.interval)
+		defer tick.Stop()
+		for {
+			select {
+			case <-tick.C:
+				if err := a.announce(a.collect()); err != nil {
+					logger.Errorf("announce host failed: %s", err.Error())
+				}
+			case <-a.done:
+				logger.Infof("announcer exited")
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops announcing.
+func (a *announcer) Stop() {
+	close(a.done)
+}
+
+// announce reports the load of host to schedulers.
+func (a *announcer) announce(stats *scheduler.HostStats) error {
+	ctx, cancel := context.WithTimeout(context.Background(), a.timeout)
+	defer cancel()
+
+	return a.schedulerClient.AnnounceHost(ctx, &scheduler.AnnounceHostRequest{
+		PeerHost: a.host,
+		Stats:    stats,
+	})
+}
+
+// collect collects the load of host, the stats that fail to be collected are left zero.
+func (a *announcer) collect() *scheduler.HostStats {
+	stats := &scheduler.HostStats{
+		UploadBandwidthLimit: a.uploadBandwidthLimit,
+	}
+
+	if counters, err := net.IOCounters(true); err != nil {
+		logger.Warnf("collect network counters failed: %s", err.Error())
+	} else {
+		var rxBytes, txBytes uint64
+		for _, counter := range counters {
+			if strings.HasPrefix(counter.Name, loopbackPrefix) {
+				continue
+			}
+
+			rxBytes += counter.BytesRecv
+			txBytes += counter.BytesSent
+		}
+
+		now := time.Now()
+		if elapsed := now.Sub(a.collectAt).Seconds(); !a.collectAt.IsZero() && elapsed > 0 {
+			stats.RxBandwidth = rate(a.rxBytes, rxBytes, elapsed)
+			stats.TxBandwidth = rate(a.txBytes, txBytes, elapsed)
+		}
+
+		a.rxBytes, a.txBytes, a.collectAt = rxBytes, txBytes, now
+	}
+
+	if usage, err := disk.Usage(a.dataDir); err != nil {
+		logger.Warnf("collect disk usage of %s failed: %s", a.dataDir, err.Error())
+	} else {
+		stats.DiskFree = usage.Free
+		stats.DiskTotal = usage.Total
+	}
+
+	if percents, err := cpu.Percent(0, false); err != nil || len(percents) == 0 {
+		logger.Warnf("collect cpu usage failed: %v", err)
+	} else {
+		stats.CpuRatio = ratio(percents[0])
+	}
+
+	if memory, err := mem.VirtualMemory(); err != nil {
+		logger.Warnf("collect memory usage failed: %s", err.Error())
+	} else {
+		stats.MemoryRatio = ratio(memory.UsedPercent)
+	}
+
+	return stats
+}
+
+// rate returns the bytes per second between two counters,
+// the counters may be reset when the interfaces change.
+func rate(prev, cur uint64, elapsed float64) uint64 {
+	if cur < prev {
+		return 0
+	}
+
+	return uint64(float64(cur-prev) / elapsed)
+}
+
+// ratio converts the percent to ratio in range of [0, 1].
+func ratio(percent float64) float64 {
+	r := percent / 100
+	if r < 0 {
+		return 0
+	}
+
+	if r > 1 {
+		return 1
+	}
+
+	return r
+}
@@ -0,0 +1,133 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package announcer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+	"d7y.io/dragonfly/v2/pkg/rpc/scheduler/client/mocks"
+)
+
+var mockPeerHost = &scheduler.PeerHost{
+	Id:      "foo",
+	Ip:      "127.0.0.1",
+	RpcPort: 8003,
+}
+
+func TestAnnouncer_announce(t *testing.T) {
+	tests := []struct {
+		name   string
+		stats  *scheduler.HostStats
+		mock   func(m *mocks.MockClientMockRecorder)
+		expect func(t *testing.T, err error)
+	}{
+		{
+			name:  "announce host",
+			stats: &scheduler.HostStats{TxBandwidth: 10, UploadBandwidthLimit: 100},
+			mock: func(m *mocks.MockClientMockRecorder) {
+				m.AnnounceHost(gomock.Any(), gomock.Eq(&scheduler.AnnounceHostRequest{
+					PeerHost: mockPeerHost,
+					Stats:    &scheduler.HostStats{TxBandwidth: 10, UploadBandwidthLimit: 100},
+				})).Return(nil).Times(1)
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+			},
+		},
+		{
+			name:  "announce host failed",
+			stats: &scheduler.HostStats{},
+			mock: func(m *mocks.MockClientMockRecorder) {
+				m.AnnounceHost(gomock.Any(), gomock.Any()).Return(errors.New("foo")).Times(1)
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "foo")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			schedulerClient := mocks.NewMockClient(ctl)
+			tc.mock(schedulerClient.EXPECT())
+
+			a := New(mockPeerHost, schedulerClient, time.Minute, t.TempDir()).(*announcer)
+			tc.expect(t, a.announce(tc.stats))
+		})
+	}
+}
+
+func TestAnnouncer_collect(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	schedulerClient := mocks.NewMockClient(ctl)
+
+	assert := assert.New(t)
+	a := New(mockPeerHost, schedulerClient, time.Minute, t.TempDir(), WithUploadBandwidthLimit(100)).(*announcer)
+	stats := a.collect()
+	assert.Equal(stats.UploadBandwidthLimit, uint64(100))
+	assert.Equal(stats.RxBandwidth, uint64(0))
+	assert.Equal(stats.TxBandwidth, uint64(0))
+	assert.Greater(stats.DiskTotal, uint64(0))
+	assert.LessOrEqual(stats.DiskFree, stats.DiskTotal)
+	assert.GreaterOrEqual(stats.CpuRatio, float64(0))
+	assert.LessOrEqual(stats.CpuRatio, float64(1))
+	assert.Greater(stats.MemoryRatio, float64(0))
+	assert.LessOrEqual(stats.MemoryRatio, float64(1))
+}
+
+func TestAnnouncer_rate(t *testing.T) {
+	tests := []struct {
+		name    string
+		prev    uint64
+		cur     uint64
+		elapsed float64
+		expect  uint64
+	}{
+		{
+			name:    "calculate rate",
+			prev:    100,
+			cur:     300,
+			elapsed: 2,
+			expect:  100,
+		},
+		{
+			name:    "counter has been reset",
+			prev:    300,
+			cur:     100,
+			elapsed: 2,
+			expect:  0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(rate(tc.prev, tc.cur, tc.elapsed), tc.expect)
+		})
+	}
+}
@@ -38,10 +38,12 @@ import (
 	"google.golang.org/grpc/credentials"
 
 	"d7y.io/dragonfly/v2/client/config"
+	"d7y.io/dragonfly/v2/client/daemon/announcer"
 	"d7y.io/dragonfly/v2/client/daemon/gc"
 	"d7y.io/dragonfly/v2/client/daemon/metrics"
 	"d7y.io/dragonfly/v2/client/daemon/objectstorage"
 	"d7y.io/dragonfly/v2/client/daemon/peer"
+	"d7y.io/dragonfly/v2/client/daemon/probe"
 	"d7y.io/dragonfly/v2/client/daemon/proxy"
 	"d7y.io/dragonfly/v2/client/daemon/rpcserver"
 	"d7y.io/dragonfly/v2/client/daemon/storage"
@@ -86,6 +88,8 @@ type clientDaemon struct {
 	ProxyManager   proxy.Manager
 	StorageManager storage.Manager
 	GCManager      gc.Manager
+	Prober         probe.Prober
+	Announcer      announcer.Announcer
 
 	PeerTaskManager peer.TaskManager
 	PieceManager    peer.PieceManager
@@ -235,6 +239,11 @@ func New(opt *config.DaemonOption, d dfpath.Dfpath) (Daemon, error) {
 		}
 	}
 
+	var announcerOptions []announcer.Option
+	if opt.Upload.RateLimit.Limit != rate.Inf {
+		announcerOptions = append(announcerOptions, announcer.WithUploadBandwidthLimit(uint64(opt.Upload.RateLimit.Limit)))
+	}
+
 	return &clientDaemon{
 		once:            &sync.Once{},
 		done:            make(chan bool),
@@ -248,6 +257,8 @@ func New(opt *config.DaemonOption, d dfpath.Dfpath) (Daemon, error) {
 		ObjectStorage:   objectStorage,
 		StorageManager:  storageManager,
 		GCManager:       gc.NewManager(opt.GCInterval.Duration),
+		Prober:          probe.New(host, sched, opt.Scheduler.ProbeInterval.Duration),
+		Announcer:       announcer.New(host, sched, opt.Scheduler.AnnounceInterval.Duration, d.DataDir(), announcerOptions...),
 		dynconfig:       dynconfig,
 		dfpath:          d,
 		schedulers:      schedulers,
@@ -516,6 +527,18 @@ func (cd *clientDaemon) Serve() error {
 		})
 	}
 
+	// probe hosts sampled by scheduler
+	if cd.Option.Scheduler.ProbeInterval.Duration > 0 {
+		logger.Info("probe hosts sampled by scheduler")
+		cd.Prober.Start()
+	}
+
+	// announce host load to schedulers
+	if cd.Option.Scheduler.AnnounceInterval.Duration > 0 {
+		logger.Info("announce host to schedulers")
+		cd.Announcer.Start()
+	}
+
 	// enable seed peer mode
 	if cd.managerClient != nil && cd.Option.Scheduler.Manager.SeedPeer.Enable {
 		logger.Info("announce to manager")
@@ -640,6 +663,8 @@ func (cd *clientDaemon) Stop() {
 	cd.once.Do(func() {
 		close(cd.done)
 		cd.GCManager.Stop()
+		cd.Prober.Stop()
+		cd.Announcer.Stop()
 		cd.RPCManager.Stop()
 		if err := cd.UploadManager.Stop(); err != nil {
 			logger.Errorf("upload manager stop failed %s", err)
@@ -385,6 +385,10 @@ func (pt *peerTaskConductor) GetTaskID() string {
 	return pt.taskID
 }
 
+func (pt *peerTaskConductor) GetPriority() base.Priority {
+	return pt.request.GetUrlMeta().GetPriority()
+}
+
 func (pt *peerTaskConductor) GetStorage() storage.TaskStorageDriver {
 	return pt.storage
 }
@@ -546,6 +550,7 @@ func (pt *peerTaskConductor) pullPiecesWithP2P() {
 		peerTaskConductor: pt,
 		pieceRequestCh:    pieceRequestCh,
 		workers:           map[string]*pieceTaskSynchronizer{},
+		pieceAssignment:   newPieceAssignment(),
 	}
 	go pt.pullPiecesFromPeers(pieceRequestCh)
 	pt.receivePeerPacket(pieceRequestCh)
@@ -749,7 +754,16 @@ func (pt *peerTaskConductor) updateSynchronizer(lastNum int32, p *scheduler.Peer
 	var peers = []*scheduler.PeerPacket_DestPeer{p.MainPeer}
 	peers = append(peers, p.StealPeers...)
 
+	// piece ranges are assigned by scheduler, empty means select pieces freely
+	pt.pieceTaskSyncManager.pieceAssignment.update(p.PieceRanges)
+	if len(p.PieceRanges) > 0 {
+		pt.Infof("receive %d piece ranges assigned to peers", len(p.PieceRanges))
+	}
+
 	legacyPeers := pt.pieceTaskSyncManager.newMultiPieceTaskSynchronizer(peers, desiredPiece)
+	if len(p.PieceRanges) > 0 {
+		pt.pieceTaskSyncManager.acquireAssignedPieces()
+	}
 
 	p.MainPeer = nil
 	p.StealPeers = legacyPeers
@@ -832,13 +846,14 @@ func (pt *peerTaskConductor) pullSinglePiece() {
 	pt.SetPieceMd5Sign(digest.SHA256FromStrings(pt.singlePiece.PieceInfo.PieceMd5))
 
 	request := &DownloadPieceRequest{
-		storage: pt.GetStorage(),
-		piece:   pt.singlePiece.PieceInfo,
-		log:     pt.Log(),
-		TaskID:  pt.GetTaskID(),
-		PeerID:  pt.GetPeerID(),
-		DstPid:  pt.singlePiece.DstPid,
-		DstAddr: pt.singlePiece.DstAddr,
+		storage:  pt.GetStorage(),
+		piece:    pt.singlePiece.PieceInfo,
+		log:      pt.Log(),
+		TaskID:   pt.GetTaskID(),
+		PeerID:   pt.GetPeerID(),
+		DstPid:   pt.singlePiece.DstPid,
+		DstAddr:  pt.singlePiece.DstAddr,
+		Priority: pt.GetPriority(),
 	}
 
 	if result, err := pt.pieceManager.DownloadPiece(ctx, request); err == nil {
@@ -1072,13 +1087,14 @@ func (pt *peerTaskConductor) dispatchPieceRequest(pieceRequestCh chan *DownloadP
 		}
 		pt.requestedPiecesLock.Unlock()
 		req := &DownloadPieceRequest{
-			storage: pt.GetStorage(),
-			piece:   piece,
-			log:     pt.Log(),
-			TaskID:  pt.GetTaskID(),
-			PeerID:  pt.GetPeerID(),
-			DstPid:  piecePacket.DstPid,
-			DstAddr: piecePacket.DstAddr,
+			storage:  pt.GetStorage(),
+			piece:    piece,
+			log:      pt.Log(),
+			TaskID:   pt.GetTaskID(),
+			PeerID:   pt.GetPeerID(),
+			DstPid:   piecePacket.DstPid,
+			DstAddr:  piecePacket.DstAddr,
+			Priority: pt.GetPriority(),
 		}
 		select {
 		case pieceRequestCh <- req:
@@ -1331,6 +1347,8 @@ func (pt *peerTaskConductor) ReportPieceResult(request *DownloadPieceRequest, re
 		code = base.Code_ClientPieceNotFound
 	} else if isBackSourceError(err) {
 		code = base.Code_ClientBackSourceError
+	} else if isDigestMismatch(err) {
+		code = base.Code_ClientPieceDigestMismatch
 	}
 	pt.reportFailResult(request, result, code)
 }
@@ -55,6 +55,18 @@ func (d *dummySchedulerClient) AnnounceTask(ctx context.Context, request *schedu
 	panic("should not call this function")
 }
 
+func (d *dummySchedulerClient) SyncProbes(ctx context.Context, request *scheduler.SyncProbesRequest, option ...grpc.CallOption) (*scheduler.SyncProbesResponse, error) {
+	panic("should not call this function")
+}
+
+func (d *dummySchedulerClient) FindTaskPeers(ctx context.Context, request *scheduler.FindTaskPeersRequest, option ...grpc.CallOption) (*scheduler.FindTaskPeersResponse, error) {
+	panic("should not call this function")
+}
+
+func (d *dummySchedulerClient) AnnounceHost(ctx context.Context, request *scheduler.AnnounceHostRequest, option ...grpc.CallOption) error {
+	panic("should not call this function")
+}
+
 func (d *dummySchedulerClient) Close() error {
 	return nil
 }
@@ -83,6 +83,7 @@ type Task interface {
 
 	GetPeerID() string
 	GetTaskID() string
+	GetPriority() base.Priority
 
 	GetTotalPieces() int32
 	SetTotalPieces(int32)
@@ -266,6 +266,20 @@ func (mr *MockTaskMockRecorder) GetPieceMd5Sign() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPieceMd5Sign", reflect.TypeOf((*MockTask)(nil).GetPieceMd5Sign))
 }
 
+// GetPriority mocks base method.
+func (m *MockTask) GetPriority() base.Priority {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriority")
+	ret0, _ := ret[0].(base.Priority)
+	return ret0
+}
+
+// GetPriority indicates an expected call of GetPriority.
+func (mr *MockTaskMockRecorder) GetPriority() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriority", reflect.TypeOf((*MockTask)(nil).GetPriority))
+}
+
 // GetStorage mocks base method.
 func (m *MockTask) GetStorage() storage.TaskStorageDriver {
 	m.ctrl.T.Helper()
@@ -45,6 +45,7 @@ type pieceTaskSyncManager struct {
 	pieceRequestCh    chan *DownloadPieceRequest
 	workers           map[string]*pieceTaskSynchronizer
 	watchdog          *synchronizerWatchdog
+	pieceAssignment   *pieceAssignment
 }
 
 type pieceTaskSynchronizer struct {
@@ -55,6 +56,7 @@ type pieceTaskSynchronizer struct {
 	error             atomic.Value
 	peerTaskConductor *peerTaskConductor
 	pieceRequestCh    chan *DownloadPieceRequest
+	syncManager       *pieceTaskSyncManager
 }
 
 type synchronizerWatchdog struct {
@@ -68,6 +70,64 @@ type pieceTaskSynchronizerError struct {
 	err error
 }
 
+// pieceAssignment saves the piece ranges assigned to dest peers by scheduler
+type pieceAssignment struct {
+	sync.RWMutex
+	pieceRanges []*scheduler.PeerPacket_PieceRange
+	// released pieces are not restricted by assignment, they failed to download from the assigned peer
+	released map[int32]bool
+}
+
+func newPieceAssignment() *pieceAssignment {
+	return &pieceAssignment{
+		released: map[int32]bool{},
+	}
+}
+
+// update replaces the piece ranges with the latest ones from scheduler
+func (a *pieceAssignment) update(pieceRanges []*scheduler.PeerPacket_PieceRange) {
+	a.Lock()
+	defer a.Unlock()
+	a.pieceRanges = pieceRanges
+	a.released = map[int32]bool{}
+}
+
+// release lets the piece be downloaded from any dest peer
+func (a *pieceAssignment) release(num int32) {
+	a.Lock()
+	defer a.Unlock()
+	if len(a.pieceRanges) > 0 {
+		a.released[num] = true
+	}
+}
+
+// assignedPeer returns the dest peer which the piece is assigned to
+func (a *pieceAssignment) assignedPeer(num int32) (string, bool) {
+	a.RLock()
+	defer a.RUnlock()
+	if a.released[num] {
+		return "", false
+	}
+	for _, r := range a.pieceRanges {
+		if num >= r.StartNum && num < r.EndNum {
+			return r.PeerId, true
+		}
+	}
+	return "", false
+}
+
+// assignedRanges returns the piece ranges assigned to the dest peer, empty peer id means all dest peers
+func (a *pieceAssignment) assignedRanges(peerID string) (pieceRanges []*scheduler.PeerPacket_PieceRange) {
+	a.RLock()
+	defer a.RUnlock()
+	for _, r := range a.pieceRanges {
+		if peerID == "" || r.PeerId == peerID {
+			pieceRanges = append(pieceRanges, r)
+		}
+	}
+	return
+}
+
 // FIXME for compatibility, sync will be called after the dfclient.GetPieceTasks deprecated and the pieceTaskPoller removed
 func (s *pieceTaskSyncManager) sync(pp *scheduler.PeerPacket, desiredPiece int32) error {
 	var (
@@ -116,6 +176,65 @@ func (s *pieceTaskSyncManager) sync(pp *scheduler.PeerPacket, desiredPiece int32
 	return nil
 }
 
+// shouldDispatch checks whether the piece from dest peer should be dispatched,
+// the piece assigned to other peer is skipped, unless the synchronizer of the assigned peer is not available
+func (s *pieceTaskSyncManager) shouldDispatch(num int32, dstPid string) bool {
+	if s.pieceAssignment == nil {
+		return true
+	}
+	peerID, ok := s.pieceAssignment.assignedPeer(num)
+	if !ok || peerID == dstPid {
+		return true
+	}
+
+	s.RLock()
+	worker, ok := s.workers[peerID]
+	s.RUnlock()
+	if !ok || worker.error.Load() != nil {
+		s.peerTaskConductor.Debugf("piece %d is assigned to unavailable peer %s, fallback to peer %s", num, peerID, dstPid)
+		return true
+	}
+	return false
+}
+
+// acquireAssignedPieces requests the assigned pieces from the assigned peers,
+// the pieces may be skipped by the former synchronizers before they are assigned
+func (s *pieceTaskSyncManager) acquireAssignedPieces() {
+	s.RLock()
+	defer s.RUnlock()
+	for _, r := range s.pieceAssignment.assignedRanges("") {
+		worker, ok := s.workers[r.PeerId]
+		if !ok {
+			continue
+		}
+		_ = worker.acquire(&base.PieceTaskRequest{
+			TaskId:   s.peerTaskConductor.taskID,
+			SrcPid:   s.peerTaskConductor.peerID,
+			StartNum: uint32(r.StartNum),
+			Limit:    uint32(r.EndNum - r.StartNum),
+		})
+	}
+}
+
+// reacquireAssignedPieces requests the pieces assigned to the failed peer from other peers
+func (s *pieceTaskSyncManager) reacquireAssignedPieces(failedPeerID string) {
+	s.RLock()
+	defer s.RUnlock()
+	for _, r := range s.pieceAssignment.assignedRanges(failedPeerID) {
+		for peerID, worker := range s.workers {
+			if peerID == failedPeerID || worker.error.Load() != nil {
+				continue
+			}
+			_ = worker.acquire(&base.PieceTaskRequest{
+				TaskId:   s.peerTaskConductor.taskID,
+				SrcPid:   s.peerTaskConductor.peerID,
+				StartNum: uint32(r.StartNum),
+				Limit:    uint32(r.EndNum - r.StartNum),
+			})
+		}
+	}
+}
+
 func (s *pieceTaskSyncManager) cleanStaleWorker(destPeers []*scheduler.PeerPacket_DestPeer) {
 	var (
 		peers = map[string]bool{}
@@ -186,6 +305,7 @@ func (s *pieceTaskSyncManager) newPieceTaskSynchronizer(
 		span:                span,
 		peerTaskConductor:   s.peerTaskConductor,
 		pieceRequestCh:      s.pieceRequestCh,
+		syncManager:         s,
 		client:              client,
 		dstPeer:             dstPeer,
 		error:               atomic.Value{},
@@ -278,6 +398,10 @@ func (s *pieceTaskSyncManager) reportInvalidPeer(destPeer *scheduler.PeerPacket_
 
 // acquire send the target piece to other peers
 func (s *pieceTaskSyncManager) acquire(request *base.PieceTaskRequest) (attempt int, success int) {
+	// the failed piece can be downloaded from any peer
+	if s.pieceAssignment != nil {
+		s.pieceAssignment.release(int32(request.StartNum))
+	}
 	s.RLock()
 	for _, p := range s.workers {
 		attempt++
@@ -322,6 +446,10 @@ func (s *pieceTaskSynchronizer) dispatchPieceRequest(piecePacket *base.PiecePack
 		return
 	}
 	for _, piece := range piecePacket.PieceInfos {
+		if s.syncManager != nil && !s.syncManager.shouldDispatch(piece.PieceNum, s.dstPeer.PeerId) {
+			s.Debugf("piece %d is assigned to other peer, skip dispatch from %s", piece.PieceNum, s.dstPeer.PeerId)
+			continue
+		}
 		s.Infof("got piece %d from %s/%s, digest: %s, start: %d, size: %d",
 			piece.PieceNum, piecePacket.DstAddr, piecePacket.DstPid, piece.PieceMd5, piece.RangeStart, piece.RangeSize)
 		// FIXME when set total piece but no total digest, fetch again
@@ -370,6 +498,10 @@ func (s *pieceTaskSynchronizer) receive(piecePacket *base.PiecePacket) {
 		s.error.Store(&pieceTaskSynchronizerError{err})
 		s.reportError(err)
 		s.Errorf("synchronizer receives with error: %s", err)
+		// fallback to other peers for the pieces assigned to the dest peer
+		if s.syncManager != nil {
+			s.syncManager.reacquireAssignedPieces(s.dstPeer.PeerId)
+		}
 	}
 }
 
@@ -17,6 +17,7 @@
 package peer
 
 import (
+	"io"
 	"sync"
 	"testing"
 	"time"
@@ -89,3 +90,125 @@ func Test_watchdog(t *testing.T) {
 		})
 	}
 }
+
+func Test_pieceAssignment(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	var testCases = []struct {
+		name        string
+		pieceRanges []*scheduler.PeerPacket_PieceRange
+		released    []int32
+		num         int32
+		peerID      string
+		assigned    bool
+	}{
+		{
+			name:     "no piece ranges",
+			num:      0,
+			assigned: false,
+		},
+		{
+			name: "piece is assigned",
+			pieceRanges: []*scheduler.PeerPacket_PieceRange{
+				{PeerId: "foo", StartNum: 0, EndNum: 2},
+				{PeerId: "bar", StartNum: 2, EndNum: 4},
+			},
+			num:      2,
+			peerID:   "bar",
+			assigned: true,
+		},
+		{
+			name: "piece is out of ranges",
+			pieceRanges: []*scheduler.PeerPacket_PieceRange{
+				{PeerId: "foo", StartNum: 0, EndNum: 2},
+			},
+			num:      2,
+			assigned: false,
+		},
+		{
+			name: "piece is released",
+			pieceRanges: []*scheduler.PeerPacket_PieceRange{
+				{PeerId: "foo", StartNum: 0, EndNum: 2},
+			},
+			released: []int32{1},
+			num:      1,
+			assigned: false,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newPieceAssignment()
+			a.update(tt.pieceRanges)
+			for _, num := range tt.released {
+				a.release(num)
+			}
+			peerID, ok := a.assignedPeer(tt.num)
+			assert.Equal(tt.assigned, ok)
+			assert.Equal(tt.peerID, peerID)
+		})
+	}
+}
+
+func Test_pieceTaskSyncManager_shouldDispatch(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	var testCases = []struct {
+		name     string
+		workers  map[string]*pieceTaskSynchronizer
+		dstPid   string
+		dispatch bool
+	}{
+		{
+			name:     "piece is assigned to dest peer",
+			workers:  map[string]*pieceTaskSynchronizer{},
+			dstPid:   "foo",
+			dispatch: true,
+		},
+		{
+			name: "piece is assigned to other peer",
+			workers: map[string]*pieceTaskSynchronizer{
+				"foo": {},
+			},
+			dstPid:   "bar",
+			dispatch: false,
+		},
+		{
+			name:     "piece is assigned to other peer without synchronizer",
+			workers:  map[string]*pieceTaskSynchronizer{},
+			dstPid:   "bar",
+			dispatch: true,
+		},
+		{
+			name: "piece is assigned to other peer with failed synchronizer",
+			workers: map[string]*pieceTaskSynchronizer{
+				"foo": func() *pieceTaskSynchronizer {
+					s := &pieceTaskSynchronizer{}
+					s.error.Store(&pieceTaskSynchronizerError{err: io.EOF})
+					return s
+				}(),
+			},
+			dstPid:   "bar",
+			dispatch: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &pieceTaskSyncManager{
+				peerTaskConductor: &peerTaskConductor{
+					SugaredLoggerOnWith: logger.With(
+						"peer", "test",
+						"task", "test",
+						"component", "PeerTask"),
+				},
+				workers:         tt.workers,
+				pieceAssignment: newPieceAssignment(),
+			}
+			s.pieceAssignment.update([]*scheduler.PeerPacket_PieceRange{
+				{PeerId: "foo", StartNum: 0, EndNum: 2},
+			})
+			assert.Equal(tt.dispatch, s.shouldDispatch(1, tt.dstPid))
+		})
+	}
+}
@@ -20,6 +20,7 @@ package peer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -45,6 +46,9 @@ type DownloadPieceRequest struct {
 	DstPid     string
 	DstAddr    string
 	CalcDigest bool
+	// Priority is the download priority of task, pieces of
+	// higher priority tasks are downloaded first.
+	Priority base.Priority
 }
 
 type DownloadPieceResult struct {
@@ -102,6 +106,10 @@ func isBackSourceError(err error) bool {
 	return false
 }
 
+func isDigestMismatch(err error) bool {
+	return errors.Is(err, digest.ErrDigestNotMatch)
+}
+
 func (e *pieceDownloadError) Error() string {
 	if e.connectionError {
 		return fmt.Sprintf("connect with %s with error: %s", e.target, e.err)
@@ -56,7 +56,7 @@ type PieceManager interface {
 }
 
 type pieceManager struct {
-	*rate.Limiter
+	limiter          *priorityLimiter
 	pieceDownloader  PieceDownloader
 	computePieceSize func(contentLength int64) uint32
 	calculateDigest  bool
@@ -89,7 +89,7 @@ func WithCalculateDigest(enable bool) func(*pieceManager) {
 // WithLimiter sets upload rate limiter, the burst size must be bigger than piece size
 func WithLimiter(limiter *rate.Limiter) func(*pieceManager) {
 	return func(manager *pieceManager) {
-		manager.Limiter = limiter
+		manager.limiter = newPriorityLimiter(limiter)
 	}
 }
 
@@ -156,8 +156,8 @@ func (pm *pieceManager) DownloadPiece(ctx context.Context, request *DownloadPiec
 	// prepare trace and limit
 	ctx, span := tracer.Start(ctx, config.SpanWritePiece)
 	defer span.End()
-	if pm.Limiter != nil {
-		if err := pm.Limiter.WaitN(ctx, int(request.piece.RangeSize)); err != nil {
+	if pm.limiter != nil {
+		if err := pm.limiter.WaitPriorityN(ctx, request.Priority, int(request.piece.RangeSize)); err != nil {
 			result.FinishTime = time.Now().UnixNano()
 			request.log.Errorf("require rate limit access error: %s", err)
 			return result, err
@@ -223,8 +223,8 @@ func (pm *pieceManager) processPieceFromSource(pt Task,
 		unknownLength = contentLength == -1
 	)
 
-	if pm.Limiter != nil {
-		if err = pm.Limiter.WaitN(pt.Context(), int(pieceSize)); err != nil {
+	if pm.limiter != nil {
+		if err = pm.limiter.WaitPriorityN(pt.Context(), pt.GetPriority(), int(pieceSize)); err != nil {
 			result.FinishTime = time.Now().UnixNano()
 			pt.Log().Errorf("require rate limit access error: %s", err)
 			return
@@ -0,0 +1,111 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package peer
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/time/rate"
+
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+)
+
+// priorityRanks is the order of priorities, the higher rank waits first.
+var priorityRanks = map[base.Priority]int{
+	base.Priority_LOW_PRIORITY:     0,
+	base.Priority_DEFAULT_PRIORITY: 1,
+	base.Priority_HIGH_PRIORITY:    2,
+}
+
+// priorityLimiter orders the waiters of rate limiter by priority, the pieces of
+// lower priority tasks wait until no piece of higher priority tasks is waiting.
+type priorityLimiter struct {
+	*rate.Limiter
+
+	// mu guards waiting and changed.
+	mu sync.Mutex
+
+	// waiting is the count of waiters by priority rank.
+	waiting []int
+
+	// changed is closed when a waiter leaves.
+	changed chan struct{}
+}
+
+// newPriorityLimiter returns a new priorityLimiter.
+func newPriorityLimiter(limiter *rate.Limiter) *priorityLimiter {
+	return &priorityLimiter{
+		Limiter: limiter,
+		waiting: make([]int, len(priorityRanks)),
+		changed: make(chan struct{}),
+	}
+}
+
+// WaitPriorityN blocks until the waiters of higher priority leave and
+// the limiter permits n events to happen.
+func (l *priorityLimiter) WaitPriorityN(ctx context.Context, priority base.Priority, n int) error {
+	rank, ok := priorityRanks[priority]
+	if !ok {
+		rank = priorityRanks[base.Priority_DEFAULT_PRIORITY]
+	}
+
+	l.mu.Lock()
+	l.waiting[rank]++
+	l.mu.Unlock()
+	defer l.leave(rank)
+
+	for {
+		changed, ok := l.higherWaiting(rank)
+		if !ok {
+			break
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return l.WaitN(ctx, n)
+}
+
+// higherWaiting returns whether there are waiters of higher priority,
+// and the channel closed when a waiter leaves.
+func (l *priorityLimiter) higherWaiting(rank int) (<-chan struct{}, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i := rank + 1; i < len(l.waiting); i++ {
+		if l.waiting[i] > 0 {
+			return l.changed, true
+		}
+	}
+
+	return nil, false
+}
+
+// leave removes the waiter and wakes up other waiters.
+func (l *priorityLimiter) leave(rank int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.waiting[rank]--
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
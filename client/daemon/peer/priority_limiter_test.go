@@ -0,0 +1,99 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package peer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+)
+
+func TestPriorityLimiter_WaitPriorityN(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect func(t *testing.T, l *priorityLimiter)
+	}{
+		{
+			name: "limiter is not contended",
+			expect: func(t *testing.T, l *priorityLimiter) {
+				assert := assert.New(t)
+				assert.NoError(l.WaitPriorityN(context.Background(), base.Priority_LOW_PRIORITY, 1))
+				assert.Equal(l.waiting, []int{0, 0, 0})
+			},
+		},
+		{
+			name: "higher priority waiter goes first",
+			expect: func(t *testing.T, l *priorityLimiter) {
+				assert := assert.New(t)
+				// Drain the tokens so that the waiters queue up.
+				assert.NoError(l.WaitN(context.Background(), 1))
+
+				var (
+					mu    sync.Mutex
+					order []base.Priority
+					wg    sync.WaitGroup
+				)
+				wait := func(priority base.Priority) {
+					defer wg.Done()
+					assert.NoError(l.WaitPriorityN(context.Background(), priority, 1))
+					mu.Lock()
+					order = append(order, priority)
+					mu.Unlock()
+				}
+
+				wg.Add(1)
+				go wait(base.Priority_HIGH_PRIORITY)
+				assert.Eventually(func() bool {
+					l.mu.Lock()
+					defer l.mu.Unlock()
+					return l.waiting[priorityRanks[base.Priority_HIGH_PRIORITY]] == 1
+				}, time.Second, time.Millisecond)
+
+				wg.Add(1)
+				go wait(base.Priority_LOW_PRIORITY)
+				wg.Wait()
+
+				assert.Equal(order, []base.Priority{base.Priority_HIGH_PRIORITY, base.Priority_LOW_PRIORITY})
+				assert.Equal(l.waiting, []int{0, 0, 0})
+			},
+		},
+		{
+			name: "context is canceled while waiting for higher priority waiter",
+			expect: func(t *testing.T, l *priorityLimiter) {
+				assert := assert.New(t)
+				l.waiting[priorityRanks[base.Priority_HIGH_PRIORITY]]++
+
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+				defer cancel()
+				assert.ErrorIs(l.WaitPriorityN(ctx, base.Priority_DEFAULT_PRIORITY, 1), context.DeadlineExceeded)
+				assert.Equal(l.waiting, []int{0, 0, 1})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expect(t, newPriorityLimiter(rate.NewLimiter(rate.Limit(20), 1)))
+		})
+	}
+}
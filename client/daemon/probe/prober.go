@@ -0,0 +1,186 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package probe
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	logger "d7y.io/dragonfly/v2/internal/dflog"
+	"d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+	schedulerclient "d7y.io/dragonfly/v2/pkg/rpc/scheduler/client"
+)
+
+const (
+	// DefaultCount is the default number of dials for probing a host.
+	DefaultCount = 3
+
+	// DefaultTimeout is the default timeout of a dial.
+	DefaultTimeout = 1 * time.Second
+)
+
+// Prober probes the hosts sampled by scheduler periodically,
+// and reports the probe results to scheduler.
+type Prober interface {
+	// Start starts probing.
+	Start()
+
+	// Stop stops probing.
+	Stop()
+}
+
+// Option is a functional option for configuring the prober.
+type Option func(p *prober)
+
+// WithCount sets the number of dials for probing a host.
+func WithCount(count int) Option {
+	return func(p *prober) {
+		p.count = count
+	}
+}
+
+// WithTimeout sets the timeout of a dial.
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *prober) {
+		p.timeout = timeout
+	}
+}
+
+type prober struct {
+	host            *scheduler.PeerHost
+	schedulerClient schedulerclient.Client
+	interval        time.Duration
+	count           int
+	timeout         time.Duration
+	done            chan bool
+}
+
+var _ Prober = (*prober)(nil)
+
+// New returns a new Prober.
+func New(host *scheduler.PeerHost, schedulerClient schedulerclient.Client, interval time.Duration, options ...Option) Prober {
+	p := &prober{
+		host:            host,
+		schedulerClient: schedulerClient,
+		interval:        interval,
+		count:           DefaultCount,
+		timeout:         DefaultTimeout,
+		done:            make(chan bool),
+	}
+
+	for _, opt := range options {
+		opt(p)
+	}
+
+	return p
+}
+
+// Start starts probing, the hosts to be probed are received
+// from scheduler when the probe results are reported.
+func (p *prober) Start() {
+	go func() {
+		targets := p.sync(nil)
+
+		tick := time.NewTicker(p.interval)
+		defer tick.Stop()
+		for {
+			select {
+			case <-tick.C:
+				targets = p.sync(p.probeTargets(targets))
+			case <-p.done:
+				logger.Infof("prober exited")
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops probing.
+func (p *prober) Stop() {
+	close(p.done)
+}
+
+// sync reports the probe results and returns the hosts to be probed.
+func (p *prober) sync(probes []*scheduler.Probe) []*scheduler.ProbeTarget {
+	resp, err := p.schedulerClient.SyncProbes(context.Background(), &scheduler.SyncProbesRequest{
+		PeerHost: p.host,
+		Probes:   probes,
+	})
+	if err != nil {
+		logger.Errorf("sync probes failed: %s", err.Error())
+		return nil
+	}
+
+	return resp.Targets
+}
+
+// probeTargets probes the targets concurrently.
+func (p *prober) probeTargets(targets []*scheduler.ProbeTarget) []*scheduler.Probe {
+	probes := make([]*scheduler.Probe, len(targets))
+
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target *scheduler.ProbeTarget) {
+			defer wg.Done()
+			probes[i] = p.probe(target)
+		}(i, target)
+	}
+	wg.Wait()
+
+	return probes
+}
+
+// probe measures the round-trip time by the duration of tcp handshakes to the target,
+// and the packet loss rate by the ratio of failed handshakes.
+func (p *prober) probe(target *scheduler.ProbeTarget) *scheduler.Probe {
+	addr := net.JoinHostPort(target.Ip, strconv.Itoa(int(target.RpcPort)))
+
+	var (
+		rtt      time.Duration
+		received int
+	)
+	for i := 0; i < p.count; i++ {
+		start := time.Now()
+		conn, err := net.DialTimeout("tcp", addr, p.timeout)
+		if err != nil {
+			logger.Debugf("probe host %s %s failed: %s", target.HostId, addr, err.Error())
+			continue
+		}
+
+		rtt += time.Since(start)
+		received++
+		conn.Close()
+	}
+
+	probe := &scheduler.Probe{
+		HostId: target.HostId,
+		Loss:   float32(p.count-received) / float32(p.count),
+	}
+	if received > 0 {
+		probe.Rtt = int64(rtt) / int64(received)
+	} else {
+		// Unreachable host is considered as far as the dial timeout,
+		// otherwise the smoothed round-trip time decreases.
+		probe.Rtt = int64(p.timeout)
+	}
+
+	return probe
+}
@@ -0,0 +1,160 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package probe
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+	"d7y.io/dragonfly/v2/pkg/rpc/scheduler/client/mocks"
+)
+
+var mockPeerHost = &scheduler.PeerHost{
+	Id:      "foo",
+	Ip:      "127.0.0.1",
+	RpcPort: 8003,
+}
+
+func TestProber_probe(t *testing.T) {
+	tests := []struct {
+		name   string
+		target func(t *testing.T) *scheduler.ProbeTarget
+		expect func(t *testing.T, probe *scheduler.Probe)
+	}{
+		{
+			name: "probe reachable host",
+			target: func(t *testing.T) *scheduler.ProbeTarget {
+				ln, err := net.Listen("tcp", "127.0.0.1:0")
+				if err != nil {
+					t.Fatal(err)
+				}
+				t.Cleanup(func() { ln.Close() })
+
+				go func() {
+					for {
+						conn, err := ln.Accept()
+						if err != nil {
+							return
+						}
+						conn.Close()
+					}
+				}()
+
+				return &scheduler.ProbeTarget{
+					HostId:  "bar",
+					Ip:      "127.0.0.1",
+					RpcPort: int32(ln.Addr().(*net.TCPAddr).Port),
+				}
+			},
+			expect: func(t *testing.T, probe *scheduler.Probe) {
+				assert := assert.New(t)
+				assert.Equal(probe.HostId, "bar")
+				assert.Greater(probe.Rtt, int64(0))
+				assert.Equal(probe.Loss, float32(0))
+			},
+		},
+		{
+			name: "probe unreachable host",
+			target: func(t *testing.T) *scheduler.ProbeTarget {
+				ln, err := net.Listen("tcp", "127.0.0.1:0")
+				if err != nil {
+					t.Fatal(err)
+				}
+				port := ln.Addr().(*net.TCPAddr).Port
+				ln.Close()
+
+				return &scheduler.ProbeTarget{
+					HostId:  "bar",
+					Ip:      "127.0.0.1",
+					RpcPort: int32(port),
+				}
+			},
+			expect: func(t *testing.T, probe *scheduler.Probe) {
+				assert := assert.New(t)
+				assert.Equal(probe.HostId, "bar")
+				assert.Equal(probe.Rtt, int64(100*time.Millisecond))
+				assert.Equal(probe.Loss, float32(1))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			schedulerClient := mocks.NewMockClient(ctl)
+
+			p := New(mockPeerHost, schedulerClient, time.Minute, WithCount(2), WithTimeout(100*time.Millisecond)).(*prober)
+			tc.expect(t, p.probe(tc.target(t)))
+		})
+	}
+}
+
+func TestProber_sync(t *testing.T) {
+	tests := []struct {
+		name   string
+		probes []*scheduler.Probe
+		mock   func(m *mocks.MockClientMockRecorder)
+		expect func(t *testing.T, targets []*scheduler.ProbeTarget)
+	}{
+		{
+			name:   "sync probes",
+			probes: []*scheduler.Probe{{HostId: "bar", Rtt: int64(time.Millisecond)}},
+			mock: func(m *mocks.MockClientMockRecorder) {
+				m.SyncProbes(gomock.Any(), gomock.Eq(&scheduler.SyncProbesRequest{
+					PeerHost: mockPeerHost,
+					Probes:   []*scheduler.Probe{{HostId: "bar", Rtt: int64(time.Millisecond)}},
+				})).Return(&scheduler.SyncProbesResponse{
+					Targets: []*scheduler.ProbeTarget{{HostId: "baz", Ip: "127.0.0.1", RpcPort: 8003}},
+				}, nil).Times(1)
+			},
+			expect: func(t *testing.T, targets []*scheduler.ProbeTarget) {
+				assert := assert.New(t)
+				assert.Equal(len(targets), 1)
+				assert.Equal(targets[0].HostId, "baz")
+			},
+		},
+		{
+			name: "sync probes failed",
+			mock: func(m *mocks.MockClientMockRecorder) {
+				m.SyncProbes(gomock.Any(), gomock.Any()).Return(nil, errors.New("foo")).Times(1)
+			},
+			expect: func(t *testing.T, targets []*scheduler.ProbeTarget) {
+				assert := assert.New(t)
+				assert.Equal(len(targets), 0)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			schedulerClient := mocks.NewMockClient(ctl)
+			tc.mock(schedulerClient.EXPECT())
+
+			p := New(mockPeerHost, schedulerClient, time.Minute).(*prober)
+			tc.expect(t, p.sync(tc.probes))
+		})
+	}
+}
@@ -627,21 +627,25 @@ func (s *server) DeleteTask(ctx context.Context, req *dfdaemongrpc.DeleteTaskReq
 	log := logger.With("function", "DeleteTask", "URL", req.Url, "Tag", req.UrlMeta.Tag, "taskID", taskID)
 
 	log.Info("new delete task request")
-	task := s.storageManager.FindCompletedTask(taskID)
-	if task == nil {
+	// The running and partial completed peer tasks are deleted too, otherwise the
+	// corrupted data of task dropped by scheduler is still served to other peers.
+	peerTasks := s.storageManager.FindPeerTasks(taskID)
+	if len(peerTasks) == 0 {
 		log.Info("task not found, skip delete")
 		return nil
 	}
 
 	// Unregister task
-	unregReq := storage.CommonTaskRequest{
-		PeerID: task.PeerID,
-		TaskID: taskID,
-	}
-	if err := s.storageManager.UnregisterTask(ctx, unregReq); err != nil {
-		msg := fmt.Sprintf("failed to UnregisterTask: %s", err)
-		log.Errorf(msg)
-		return errors.New(msg)
+	for _, peerTask := range peerTasks {
+		unregReq := storage.CommonTaskRequest{
+			PeerID: peerTask.PeerID,
+			TaskID: taskID,
+		}
+		if err := s.storageManager.UnregisterTask(ctx, unregReq); err != nil {
+			msg := fmt.Sprintf("failed to UnregisterTask: %s", err)
+			log.Errorf(msg)
+			return errors.New(msg)
+		}
 	}
 	return nil
 }
@@ -581,3 +581,67 @@ func setupPeerServerAndClient(t *testing.T, srv *server, assert *testifyassert.A
 	assert.Nil(err, "grpc dial should be ok")
 	return port, client
 }
+
+func Test_DeleteTask(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(taskID string, ms *mocks.MockManagerMockRecorder)
+		expect func(t *testing.T, err error)
+	}{
+		{
+			name: "delete running task",
+			mock: func(taskID string, ms *mocks.MockManagerMockRecorder) {
+				gomock.InOrder(
+					ms.FindPeerTasks(gomock.Eq(taskID)).Return([]storage.PeerTaskMetadata{{PeerID: "foo", TaskID: taskID}}).Times(1),
+					ms.UnregisterTask(gomock.Any(), gomock.Eq(storage.CommonTaskRequest{PeerID: "foo", TaskID: taskID})).Return(nil).Times(1),
+				)
+			},
+			expect: func(t *testing.T, err error) {
+				assert := testifyassert.New(t)
+				assert.NoError(err)
+			},
+		},
+		{
+			name: "task not found",
+			mock: func(taskID string, ms *mocks.MockManagerMockRecorder) {
+				ms.FindPeerTasks(gomock.Eq(taskID)).Return(nil).Times(1)
+			},
+			expect: func(t *testing.T, err error) {
+				assert := testifyassert.New(t)
+				assert.NoError(err)
+			},
+		},
+		{
+			name: "unregister task failed",
+			mock: func(taskID string, ms *mocks.MockManagerMockRecorder) {
+				gomock.InOrder(
+					ms.FindPeerTasks(gomock.Eq(taskID)).Return([]storage.PeerTaskMetadata{{PeerID: "foo", TaskID: taskID}}).Times(1),
+					ms.UnregisterTask(gomock.Any(), gomock.Any()).Return(fmt.Errorf("bar")).Times(1),
+				)
+			},
+			expect: func(t *testing.T, err error) {
+				assert := testifyassert.New(t)
+				assert.EqualError(err, "failed to UnregisterTask: bar")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := &dfdaemongrpc.DeleteTaskRequest{
+				Url:     "http://localhost/test",
+				UrlMeta: &base.UrlMeta{Tag: "unit test"},
+			}
+			mockStorageManger := mocks.NewMockManager(ctrl)
+			tc.mock(idgen.TaskID(req.Url, req.UrlMeta), mockStorageManger.EXPECT())
+			s := &server{
+				KeepAlive:      util.NewKeepAlive("test"),
+				storageManager: mockStorageManger,
+			}
+			tc.expect(t, s.DeleteTask(context.Background(), req))
+		})
+	}
+}
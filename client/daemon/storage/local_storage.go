@@ -158,6 +158,12 @@ func (t *localTaskStore) WritePiece(ctx context.Context, req *WritePieceRequest)
 		}
 	}
 
+	// the piece downloaded from other peers is not recorded when its digest does not match
+	if err := validatePieceDigest(req); err != nil {
+		t.Errorf("validate digest of piece %d failed: %s", req.Num, err)
+		return n, err
+	}
+
 	// when Md5 is empty, try to get md5 from reader, it's useful for back source
 	if req.PieceMetadata.Md5 == "" {
 		t.Debugf("piece md5 not found in metadata, read from reader")
@@ -709,3 +715,28 @@ func hardlink(log *logger.SugaredLoggerOnWith, dst, src string) error {
 	err = fmt.Errorf("target file %q exists, with different inode with underlay data %q", dst, src)
 	return err
 }
+
+// validatePieceDigest validates the digest calculated by reader with the md5 of piece,
+// the reader is limited by the range length and may not reach EOF, so the digest reader
+// can not validate by itself.
+func validatePieceDigest(req *WritePieceRequest) error {
+	if req.PieceMetadata.Md5 == "" {
+		return nil
+	}
+
+	reader, ok := req.Reader.(digest.Reader)
+	if !ok {
+		return nil
+	}
+
+	d, err := digest.Parse(req.PieceMetadata.Md5)
+	if err != nil {
+		return err
+	}
+
+	if reader.Encoded() != d.Encoded {
+		return digest.ErrDigestNotMatch
+	}
+
+	return nil
+}
@@ -106,6 +106,12 @@ func (t *localSubTaskStore) WritePiece(ctx context.Context, req *WritePieceReque
 		}
 	}
 
+	// the piece downloaded from other peers is not recorded when its digest does not match
+	if err := validatePieceDigest(req); err != nil {
+		t.Errorf("validate digest of piece %d failed: %s", req.Num, err)
+		return n, err
+	}
+
 	// when Md5 is empty, try to get md5 from reader, it's useful for back source
 	if req.PieceMetadata.Md5 == "" {
 		t.Debugf("piece md5 not found in metadata, read from reader")
@@ -540,3 +540,63 @@ func TestLocalTaskStore_partialCompleted(t *testing.T) {
 		})
 	}
 }
+
+func Test_validatePieceDigest(t *testing.T) {
+	data := []byte("hello world")
+	hash := md5.Sum(data)
+	md5Sum := hex.EncodeToString(hash[:])
+
+	testCases := []struct {
+		name   string
+		md5    string
+		reader func() io.Reader
+		err    error
+	}{
+		{
+			name: "digest matches",
+			md5:  md5Sum,
+			reader: func() io.Reader {
+				reader, _ := digest.NewReader(bytes.NewBuffer(data))
+				return reader
+			},
+		},
+		{
+			name: "digest does not match",
+			md5:  md5Sum,
+			reader: func() io.Reader {
+				reader, _ := digest.NewReader(bytes.NewBuffer([]byte("hello dragonfly")))
+				return reader
+			},
+			err: digest.ErrDigestNotMatch,
+		},
+		{
+			name: "md5 is empty",
+			md5:  "",
+			reader: func() io.Reader {
+				reader, _ := digest.NewReader(bytes.NewBuffer([]byte("hello dragonfly")))
+				return reader
+			},
+		},
+		{
+			name: "reader is not digest reader",
+			md5:  md5Sum,
+			reader: func() io.Reader {
+				return bytes.NewBuffer([]byte("hello dragonfly"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := testifyassert.New(t)
+			req := &WritePieceRequest{
+				PieceMetadata: PieceMetadata{Md5: tc.md5},
+				Reader:        tc.reader(),
+			}
+			// read the content without reaching EOF like limited reader in WritePiece
+			_, err := req.Reader.Read(make([]byte, 64))
+			assert.Nil(err)
+			assert.Equal(tc.err, validatePieceDigest(req))
+		})
+	}
+}
@@ -341,6 +341,20 @@ func (mr *MockManagerMockRecorder) FindPartialCompletedTask(taskID, rg interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPartialCompletedTask", reflect.TypeOf((*MockManager)(nil).FindPartialCompletedTask), taskID, rg)
 }
 
+// FindPeerTasks mocks base method.
+func (m *MockManager) FindPeerTasks(taskID string) []storage.PeerTaskMetadata {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPeerTasks", taskID)
+	ret0, _ := ret[0].([]storage.PeerTaskMetadata)
+	return ret0
+}
+
+// FindPeerTasks indicates an expected call of FindPeerTasks.
+func (mr *MockManagerMockRecorder) FindPeerTasks(taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPeerTasks", reflect.TypeOf((*MockManager)(nil).FindPeerTasks), taskID)
+}
+
 // GetExtendAttribute mocks base method.
 func (m *MockManager) GetExtendAttribute(ctx context.Context, req *storage.PeerTaskMetadata) (*base.ExtendAttribute, error) {
 	m.ctrl.T.Helper()
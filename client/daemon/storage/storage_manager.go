@@ -100,6 +100,8 @@ type Manager interface {
 	FindCompletedSubTask(taskID string) *ReusePeerTask
 	// FindPartialCompletedTask try to find a partial completed task for fast path
 	FindPartialCompletedTask(taskID string, rg *util.Range) *ReusePeerTask
+	// FindPeerTasks finds all peer tasks of the task, including the running and partial completed ones
+	FindPeerTasks(taskID string) []PeerTaskMetadata
 	// CleanUp cleans all storage data
 	CleanUp()
 }
@@ -549,6 +551,24 @@ func (s *storageManager) FindPartialCompletedTask(taskID string, rg *util.Range)
 	return nil
 }
 
+func (s *storageManager) FindPeerTasks(taskID string) []PeerTaskMetadata {
+	s.indexRWMutex.RLock()
+	defer s.indexRWMutex.RUnlock()
+	ts, ok := s.indexTask2PeerTask[taskID]
+	if !ok {
+		return nil
+	}
+
+	var metas []PeerTaskMetadata
+	for _, t := range ts {
+		metas = append(metas, PeerTaskMetadata{
+			PeerID: t.PeerID,
+			TaskID: taskID,
+		})
+	}
+	return metas
+}
+
 func (s *storageManager) FindCompletedSubTask(taskID string) *ReusePeerTask {
 	s.subIndexRWMutex.RLock()
 	defer s.subIndexRWMutex.RUnlock()
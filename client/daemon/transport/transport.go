@@ -238,6 +238,8 @@ func (rt *transport) download(ctx context.Context, req *http.Request) (*http.Res
 	// Pick header's parameters
 	filter := nethttp.PickHeader(req.Header, config.HeaderDragonflyFilter, rt.defaultFilter)
 	tag := nethttp.PickHeader(req.Header, config.HeaderDragonflyTag, rt.defaultTag)
+	priority := nethttp.PickHeader(req.Header, config.HeaderDragonflyPriority, "")
+	application := nethttp.PickHeader(req.Header, config.HeaderDragonflyApplication, "")
 
 	// Delete hop-by-hop headers
 	delHopHeaders(req.Header)
@@ -245,6 +247,8 @@ func (rt *transport) download(ctx context.Context, req *http.Request) (*http.Res
 	meta.Header = nethttp.HeaderToMap(req.Header)
 	meta.Tag = tag
 	meta.Filter = filter
+	meta.Priority = config.ConvertPriority(priority)
+	meta.Application = application
 
 	body, attr, err := rt.peerTaskManager.StartStreamTask(
 		ctx,
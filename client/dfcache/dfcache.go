@@ -164,7 +164,8 @@ func newImportRequest(cfg *config.DfcacheConfig) *dfdaemon.ImportTaskRequest {
 		Url:  newCid(cfg.Cid),
 		Path: cfg.Path,
 		UrlMeta: &base.UrlMeta{
-			Tag: cfg.Tag,
+			Tag:      cfg.Tag,
+			Priority: base.Priority_LOW_PRIORITY,
 		},
 	}
 }
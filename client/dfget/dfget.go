@@ -234,11 +234,13 @@ func newDownRequest(cfg *config.DfgetConfig, hdr map[string]string) *dfdaemon.Do
 		Limit:             float64(cfg.RateLimit.Limit),
 		DisableBackSource: cfg.DisableBackSource,
 		UrlMeta: &base.UrlMeta{
-			Digest: cfg.Digest,
-			Tag:    cfg.Tag,
-			Range:  rg,
-			Filter: cfg.Filter,
-			Header: hdr,
+			Digest:      cfg.Digest,
+			Tag:         cfg.Tag,
+			Range:       rg,
+			Filter:      cfg.Filter,
+			Header:      hdr,
+			Priority:    config.ConvertPriority(cfg.Priority),
+			Application: cfg.Application,
 		},
 		Pattern:            cfg.Pattern,
 		Callsystem:         cfg.CallSystem,
@@ -159,6 +159,10 @@ func init() {
 
 	flagSet.StringP("pattern", "p", dfgetConfig.Pattern, "The downloading pattern: p2p/seed-peer/source")
 
+	flagSet.String("priority", dfgetConfig.Priority, "The downloading priority: low/default/high, high priority download is scheduled first")
+
+	flagSet.String("application", dfgetConfig.Application, "The application name which the download belongs to, it is used to filter scheduler events")
+
 	flagSet.BoolP("show-progress", "b", dfgetConfig.ShowProgress, "Show progress bar, it conflicts with --console")
 
 	flagSet.String("callsystem", dfgetConfig.CallSystem, "The caller name which is mainly used for statistics and access control")
@@ -0,0 +1,181 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"d7y.io/dragonfly/v2/manager/types"
+	"d7y.io/dragonfly/v2/scheduler/scheduler"
+	"d7y.io/dragonfly/v2/scheduler/scheduler/evaluator"
+	"d7y.io/dragonfly/v2/scheduler/simulator"
+	"d7y.io/dragonfly/v2/scheduler/storage"
+)
+
+var simulateDescription = "simulate the scheduling with the download records in the data directory, and report the back-to-source ratio, tree depth, host upload load and completion time."
+
+var (
+	// simulateDataDir is the directory of download records.
+	simulateDataDir string
+
+	// simulateAlgorithm is the scheduling algorithm to be simulated.
+	simulateAlgorithm string
+
+	// simulatePluginDir is the directory of evaluator plugin.
+	simulatePluginDir string
+
+	// simulateModelFile is the model file of machine learning algorithm.
+	simulateModelFile string
+
+	// simulateProfile is the weight profile of evaluator.
+	simulateProfile string
+
+	// simulateBandwidth is the upload bandwidth of host in bytes per second.
+	simulateBandwidth int64
+
+	// simulateSourceBandwidth is the bandwidth of downloading back-to-source in bytes per second.
+	simulateSourceBandwidth int64
+
+	// simulateTop is the number of hosts with the highest upload load to be reported.
+	simulateTop int
+
+	// simulateJSON reports the result in json format.
+	simulateJSON bool
+)
+
+// simulateCmd represents to replay the download records against the evaluator offline.
+var simulateCmd = &cobra.Command{
+	Use:               "simulate [flags]",
+	Short:             simulateDescription,
+	Long:              simulateDescription,
+	Args:              cobra.NoArgs,
+	DisableAutoGenTag: true,
+	SilenceUsage:      true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Initialize dfpath
+		d, err := initDfpath(cfg.Server)
+		if err != nil {
+			return err
+		}
+
+		dataDir := simulateDataDir
+		if dataDir == "" {
+			dataDir = d.DataDir()
+		}
+
+		pluginDir := simulatePluginDir
+		if pluginDir == "" {
+			pluginDir = d.PluginDir()
+		}
+
+		schedulerConfig := *cfg.Scheduler
+		if simulateAlgorithm != "" {
+			schedulerConfig.Algorithm = simulateAlgorithm
+		}
+		if simulateModelFile != "" {
+			schedulerConfig.ModelFile = simulateModelFile
+		}
+		if schedulerConfig.ModelFile == "" {
+			schedulerConfig.ModelFile = filepath.Join(d.DataDir(), evaluator.DefaultModelFilename)
+		}
+
+		records, err := storage.ListRecords(dataDir)
+		if err != nil {
+			return fmt.Errorf("list records in %s: %w", dataDir, err)
+		}
+
+		dynconfig := simulator.NewDynconfig(types.SchedulerClusterConfig{EvaluatorProfile: simulateProfile})
+		result, err := simulator.New(scheduler.New(&schedulerConfig, dynconfig, pluginDir),
+			simulator.WithBandwidth(simulateBandwidth),
+			simulator.WithSourceBandwidth(simulateSourceBandwidth),
+		).Simulate(records)
+		if err != nil {
+			return err
+		}
+
+		if simulateTop >= 0 && len(result.HostUploads) > simulateTop {
+			result.HostUploads = result.HostUploads[:simulateTop]
+		}
+
+		if simulateJSON {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(result)
+		}
+
+		printSimulateResult(schedulerConfig.Algorithm, result)
+		return nil
+	},
+}
+
+// printSimulateResult prints the result of simulation in text format.
+func printSimulateResult(algorithm string, result *simulator.Result) {
+	fmt.Printf("algorithm %s is simulated with %d peers of %d tasks, %d peers are seed peers\n",
+		algorithm, result.PeerCount, result.TaskCount, result.SeedPeerCount)
+	fmt.Printf("back-to-source: %d peers, ratio is %.4f, recorded ratio is %.4f\n",
+		result.BackToSourceCount, result.BackToSourceRatio, result.RecordedBackToSourceRatio)
+
+	depths := make([]int, 0, len(result.DepthDistribution))
+	for depth := range result.DepthDistribution {
+		depths = append(depths, depth)
+	}
+	sort.Ints(depths)
+
+	fmt.Println("depth distribution:")
+	for _, depth := range depths {
+		fmt.Printf("  %d: %d\n", depth, result.DepthDistribution[depth])
+	}
+
+	fmt.Println("host upload load:")
+	for _, upload := range result.HostUploads {
+		fmt.Printf("  %s(%s): %d uploads, max concurrent uploads is %d\n",
+			upload.Hostname, upload.IP, upload.UploadCount, upload.MaxConcurrentUploadCount)
+	}
+
+	for _, c := range []struct {
+		name         string
+		distribution simulator.Distribution
+	}{
+		{"estimated completion time", result.CompletionTime},
+		{"recorded completion time", result.RecordedCompletionTime},
+	} {
+		fmt.Printf("%s: mean %s, p50 %s, p90 %s, p99 %s, max %s\n", c.name,
+			c.distribution.Mean, c.distribution.P50, c.distribution.P90, c.distribution.P99, c.distribution.Max)
+	}
+}
+
+func init() {
+	flags := simulateCmd.Flags()
+	flags.StringVar(&simulateDataDir, "data-dir", "", "directory of download records, default is the data directory of scheduler")
+	flags.StringVar(&simulateAlgorithm, "algorithm", "", "scheduling algorithm to be simulated, default is the algorithm of scheduler configuration")
+	flags.StringVar(&simulatePluginDir, "plugin-dir", "", "directory of evaluator plugin, default is the plugin directory of scheduler")
+	flags.StringVar(&simulateModelFile, "model-file", "", "model file of machine learning algorithm, default is the modelFile of scheduler configuration")
+	flags.StringVar(&simulateProfile, "profile", "", "weight profile of evaluator")
+	flags.Int64Var(&simulateBandwidth, "bandwidth", simulator.DefaultBandwidth, "upload bandwidth of host in bytes per second")
+	flags.Int64Var(&simulateSourceBandwidth, "source-bandwidth", simulator.DefaultSourceBandwidth, "bandwidth of downloading back-to-source in bytes per second")
+	flags.IntVar(&simulateTop, "top", 10, "number of hosts with the highest upload load to be reported, negative value reports all hosts")
+	flags.BoolVar(&simulateJSON, "json", false, "report the result in json format")
+
+	// Add sub command.
+	rootCmd.AddCommand(simulateCmd)
+}
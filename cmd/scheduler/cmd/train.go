@@ -0,0 +1,105 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"d7y.io/dragonfly/v2/scheduler/scheduler/evaluator"
+	"d7y.io/dragonfly/v2/scheduler/storage"
+)
+
+var trainDescription = "train the model of machine learning algorithm with the download records in the data directory."
+
+var (
+	// trainDataDir is the directory of download records.
+	trainDataDir string
+
+	// trainOutput is the model file to write.
+	trainOutput string
+
+	// trainIterations is the number of gradient descent iterations.
+	trainIterations int
+
+	// trainLearningRate is the learning rate of gradient descent.
+	trainLearningRate float64
+)
+
+// trainCmd represents to train the model offline.
+var trainCmd = &cobra.Command{
+	Use:               "train [flags]",
+	Short:             trainDescription,
+	Long:              trainDescription,
+	Args:              cobra.NoArgs,
+	DisableAutoGenTag: true,
+	SilenceUsage:      true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Initialize dfpath
+		d, err := initDfpath(cfg.Server)
+		if err != nil {
+			return err
+		}
+
+		dataDir := trainDataDir
+		if dataDir == "" {
+			dataDir = d.DataDir()
+		}
+
+		output := trainOutput
+		if output == "" {
+			output = cfg.Scheduler.ModelFile
+		}
+		if output == "" {
+			output = filepath.Join(d.DataDir(), evaluator.DefaultModelFilename)
+		}
+
+		records, err := storage.ListRecords(dataDir)
+		if err != nil {
+			return fmt.Errorf("list records in %s: %w", dataDir, err)
+		}
+
+		model, err := evaluator.Train(records,
+			evaluator.WithTrainIterations(trainIterations),
+			evaluator.WithTrainLearningRate(trainLearningRate),
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := model.Save(output); err != nil {
+			return err
+		}
+
+		fmt.Printf("model %s is trained with %d samples, accuracy is %.4f, %d bad hosts, saved to %s\n",
+			model.Version, model.SampleCount, model.Accuracy, len(model.BadHosts), output)
+		return nil
+	},
+}
+
+func init() {
+	flags := trainCmd.Flags()
+	flags.StringVar(&trainDataDir, "data-dir", "", "directory of download records, default is the data directory of scheduler")
+	flags.StringVarP(&trainOutput, "output", "o", "", "model file to write, default is the modelFile of scheduler configuration")
+	flags.IntVar(&trainIterations, "iterations", evaluator.DefaultTrainIterations, "number of gradient descent iterations")
+	flags.Float64Var(&trainLearningRate, "learning-rate", evaluator.DefaultTrainLearningRate, "learning rate of gradient descent")
+
+	// Add sub command.
+	rootCmd.AddCommand(trainCmd)
+}
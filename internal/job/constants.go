@@ -24,5 +24,20 @@ const (
 
 // Job Name
 const (
-	PreheatJob = "preheat"
+	PreheatJob      = "preheat"
+	CordonHostJob   = "cordon_host"
+	UncordonHostJob = "uncordon_host"
+	DrainHostJob    = "drain_host"
+	PurgeTaskJob    = "purge_task"
+	GetTaskJob      = "get_task"
 )
+
+// Job State
+const (
+	// StateCanceled is the state of job canceled by user,
+	// machinery has no state for canceled jobs.
+	StateCanceled = "CANCELED"
+)
+
+// groupJobKeyPrefix is the prefix of redis keys of group job.
+const groupJobKeyPrefix = "dragonfly:group_job"
@@ -47,6 +47,7 @@ type Job struct {
 	Server *machinery.Server
 	Worker *machinery.Worker
 	Queue  Queue
+	rdb    *redis.Client
 }
 
 func New(cfg *Config, queue Queue) (*Job, error) {
@@ -63,11 +64,12 @@ func New(cfg *Config, queue Queue) (*Job, error) {
 	}
 
 	backend := fmt.Sprintf("redis://%s@%s:%d/%d", cfg.Password, cfg.Host, cfg.Port, cfg.BackendDB)
-	if err := ping(&redis.Options{
+	backendOptions := &redis.Options{
 		Addr:     fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
 		Password: cfg.Password,
 		DB:       cfg.BackendDB,
-	}); err != nil {
+	}
+	if err := ping(backendOptions); err != nil {
 		return nil, err
 	}
 
@@ -86,14 +88,21 @@ func New(cfg *Config, queue Queue) (*Job, error) {
 	return &Job{
 		Server: server,
 		Queue:  queue,
+		rdb:    redis.NewClient(backendOptions),
 	}, nil
 }
 
 func ping(options *redis.Options) error {
 	client := redis.NewClient(options)
+	defer client.Close()
 	return client.Ping(context.Background()).Err()
 }
 
+// Close closes the redis client of job backend.
+func (t *Job) Close() error {
+	return t.rdb.Close()
+}
+
 func (t *Job) RegisterJob(namedJobFuncs map[string]any) error {
 	return t.Server.RegisterTasks(namedJobFuncs)
 }
@@ -146,6 +155,67 @@ func (t *Job) GetGroupJobState(groupUUID string) (*GroupJobState, error) {
 	}, nil
 }
 
+// SetJobProgress stores the progress of job in the group, the progress
+// expires with the results of job.
+func (t *Job) SetJobProgress(ctx context.Context, groupUUID, jobUUID string, progress any) error {
+	b, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+
+	key := groupJobProgressKey(groupUUID)
+	if err := t.rdb.HSet(ctx, key, jobUUID, b).Err(); err != nil {
+		return err
+	}
+
+	return t.rdb.Expire(ctx, key, DefaultResultsExpireIn*time.Second).Err()
+}
+
+// GetGroupJobProgress returns the progresses of jobs in the group,
+// the key of map is job uuid.
+func (t *Job) GetGroupJobProgress(ctx context.Context, groupUUID string) (map[string]any, error) {
+	values, err := t.rdb.HGetAll(ctx, groupJobProgressKey(groupUUID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	progresses := make(map[string]any, len(values))
+	for jobUUID, value := range values {
+		var progress map[string]any
+		if err := json.Unmarshal([]byte(value), &progress); err != nil {
+			return nil, err
+		}
+
+		progresses[jobUUID] = progress
+	}
+
+	return progresses, nil
+}
+
+// CancelGroupJob marks the jobs in the group as canceled,
+// the running jobs are stopped by workers when they find the mark.
+func (t *Job) CancelGroupJob(ctx context.Context, groupUUID string) error {
+	return t.rdb.Set(ctx, groupJobCanceledKey(groupUUID), StateCanceled, DefaultResultsExpireIn*time.Second).Err()
+}
+
+// IsGroupJobCanceled returns whether the jobs in the group have been canceled.
+func (t *Job) IsGroupJobCanceled(ctx context.Context, groupUUID string) (bool, error) {
+	n, err := t.rdb.Exists(ctx, groupJobCanceledKey(groupUUID)).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+func groupJobProgressKey(groupUUID string) string {
+	return fmt.Sprintf("%s:%s:progress", groupJobKeyPrefix, groupUUID)
+}
+
+func groupJobCanceledKey(groupUUID string) string {
+	return fmt.Sprintf("%s:%s:canceled", groupJobKeyPrefix, groupUUID)
+}
+
 func MarshalRequest(v any) ([]machineryv1tasks.Arg, error) {
 	b, err := json.Marshal(v)
 	if err != nil {
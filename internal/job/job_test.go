@@ -17,10 +17,14 @@
 package job
 
 import (
+	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	machineryv1tasks "github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -293,3 +297,207 @@ func TestUnmarshalRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupJobKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		groupUUID string
+		expect    func(t *testing.T, progressKey, canceledKey string)
+	}{
+		{
+			name:      "group job keys",
+			groupUUID: "foo",
+			expect: func(t *testing.T, progressKey, canceledKey string) {
+				assert := assert.New(t)
+				assert.Equal("dragonfly:group_job:foo:progress", progressKey)
+				assert.Equal("dragonfly:group_job:foo:canceled", canceledKey)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expect(t, groupJobProgressKey(tc.groupUUID), groupJobCanceledKey(tc.groupUUID))
+		})
+	}
+}
+
+// newMockJob returns the job with the backend of miniredis.
+func newMockJob(t *testing.T) (*Job, *miniredis.Miniredis) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(mr.Close)
+
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+
+	return &Job{rdb: rdb}, mr
+}
+
+func TestJob_GroupJobProgress(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(t *testing.T, j *Job, mr *miniredis.Miniredis)
+		expect func(t *testing.T, mr *miniredis.Miniredis, progresses map[string]any, err error)
+	}{
+		{
+			name: "progresses of jobs in group",
+			mock: func(t *testing.T, j *Job, mr *miniredis.Miniredis) {
+				if err := j.SetJobProgress(context.Background(), "foo", "bar", PreheatProgress{FinishedPieceCount: 1, TotalPieceCount: 2}); err != nil {
+					t.Fatal(err)
+				}
+
+				if err := j.SetJobProgress(context.Background(), "foo", "baz", PreheatProgress{FinishedPieceCount: 2, TotalPieceCount: 2, Done: true}); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, progresses map[string]any, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Len(progresses, 2)
+				assert.EqualValues(progresses["bar"].(map[string]any)["finished_piece_count"], 1)
+				assert.Equal(progresses["baz"].(map[string]any)["done"], true)
+				assert.Equal(mr.TTL(groupJobProgressKey("foo")), DefaultResultsExpireIn*time.Second)
+			},
+		},
+		{
+			name: "progress is overwritten",
+			mock: func(t *testing.T, j *Job, mr *miniredis.Miniredis) {
+				if err := j.SetJobProgress(context.Background(), "foo", "bar", PreheatProgress{TotalPieceCount: 2}); err != nil {
+					t.Fatal(err)
+				}
+
+				if err := j.SetJobProgress(context.Background(), "foo", "bar", PreheatProgress{FinishedPieceCount: 2, TotalPieceCount: 2, Done: true}); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, progresses map[string]any, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Len(progresses, 1)
+				assert.EqualValues(progresses["bar"].(map[string]any)["finished_piece_count"], 2)
+				assert.Equal(progresses["bar"].(map[string]any)["done"], true)
+			},
+		},
+		{
+			name: "group has no progress",
+			mock: func(t *testing.T, j *Job, mr *miniredis.Miniredis) {},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, progresses map[string]any, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Empty(progresses)
+			},
+		},
+		{
+			name: "progress is invalid",
+			mock: func(t *testing.T, j *Job, mr *miniredis.Miniredis) {
+				mr.HSet(groupJobProgressKey("foo"), "bar", "baz")
+			},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, progresses map[string]any, err error) {
+				assert := assert.New(t)
+				assert.Error(err)
+			},
+		},
+		{
+			name: "redis is unavailable",
+			mock: func(t *testing.T, j *Job, mr *miniredis.Miniredis) {
+				mr.SetError("unavailable")
+			},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, progresses map[string]any, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "unavailable")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			j, mr := newMockJob(t)
+			tc.mock(t, j, mr)
+			progresses, err := j.GetGroupJobProgress(context.Background(), "foo")
+			tc.expect(t, mr, progresses, err)
+		})
+	}
+}
+
+func TestJob_CancelGroupJob(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(t *testing.T, j *Job, mr *miniredis.Miniredis)
+		expect func(t *testing.T, mr *miniredis.Miniredis, canceled bool, err error)
+	}{
+		{
+			name: "group job is canceled",
+			mock: func(t *testing.T, j *Job, mr *miniredis.Miniredis) {
+				if err := j.CancelGroupJob(context.Background(), "foo"); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, canceled bool, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.True(canceled)
+				mr.CheckGet(t, groupJobCanceledKey("foo"), StateCanceled)
+				assert.Equal(mr.TTL(groupJobCanceledKey("foo")), DefaultResultsExpireIn*time.Second)
+			},
+		},
+		{
+			name: "other group job is canceled",
+			mock: func(t *testing.T, j *Job, mr *miniredis.Miniredis) {
+				if err := j.CancelGroupJob(context.Background(), "bar"); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, canceled bool, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.False(canceled)
+			},
+		},
+		{
+			name: "canceled mark expires",
+			mock: func(t *testing.T, j *Job, mr *miniredis.Miniredis) {
+				if err := j.CancelGroupJob(context.Background(), "foo"); err != nil {
+					t.Fatal(err)
+				}
+				mr.FastForward(DefaultResultsExpireIn * time.Second)
+			},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, canceled bool, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.False(canceled)
+			},
+		},
+		{
+			name: "redis is unavailable",
+			mock: func(t *testing.T, j *Job, mr *miniredis.Miniredis) {
+				mr.SetError("unavailable")
+			},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, canceled bool, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "unavailable")
+				assert.False(canceled)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			j, mr := newMockJob(t)
+			tc.mock(t, j, mr)
+			canceled, err := j.IsGroupJobCanceled(context.Background(), "foo")
+			tc.expect(t, mr, canceled, err)
+		})
+	}
+}
+
+func TestJob_Close(t *testing.T) {
+	assert := assert.New(t)
+	j, _ := newMockJob(t)
+	assert.NoError(j.Close())
+
+	_, err := j.IsGroupJobCanceled(context.Background(), "foo")
+	assert.ErrorIs(err, redis.ErrClosed)
+}
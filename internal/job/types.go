@@ -16,6 +16,8 @@
 
 package job
 
+import "time"
+
 type PreheatRequest struct {
 	URL     string            `json:"url" validate:"required,url"`
 	Tag     string            `json:"tag" validate:"omitempty"`
@@ -26,3 +28,151 @@ type PreheatRequest struct {
 
 type PreheatResponse struct {
 }
+
+// PreheatProgress is the progress of preheat reported by scheduler.
+type PreheatProgress struct {
+	// SeedPeerID is the id of seed peer downloading the file.
+	SeedPeerID string `json:"seed_peer_id"`
+
+	// SeedPeerHostID is the host id of seed peer downloading the file.
+	SeedPeerHostID string `json:"seed_peer_host_id"`
+
+	// FinishedPieceCount is the count of finished pieces.
+	FinishedPieceCount int32 `json:"finished_piece_count"`
+
+	// TotalPieceCount is the total piece count, -1 represents it is unknown.
+	TotalPieceCount int32 `json:"total_piece_count"`
+
+	// CompletedLength is the completed length of the file.
+	CompletedLength int64 `json:"completed_length"`
+
+	// ContentLength is the content length of the file, -1 represents it is unknown.
+	ContentLength int64 `json:"content_length"`
+
+	// Done is whether the file has been downloaded.
+	Done bool `json:"done"`
+
+	// UpdatedAt is the update time of progress.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// HostRequest is the request of cordon_host, uncordon_host and drain_host jobs.
+type HostRequest struct {
+	HostID string `json:"host_id" validate:"required"`
+}
+
+// DrainHostProgress is the progress of draining host reported by scheduler.
+type DrainHostProgress struct {
+	// HostID is the id of host draining.
+	HostID string `json:"host_id"`
+
+	// ChildCount is the count of peers still downloading from the host.
+	ChildCount int `json:"child_count"`
+
+	// Done is whether the host has no more children.
+	Done bool `json:"done"`
+
+	// UpdatedAt is the update time of progress.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TaskRequest is the request of purge_task and get_task jobs, the task is
+// identified by task id or generated by url, tag, filter and digest.
+type TaskRequest struct {
+	TaskID  string            `json:"task_id" validate:"required_without=URL"`
+	URL     string            `json:"url" validate:"required_without=TaskID"`
+	Tag     string            `json:"tag" validate:"omitempty"`
+	Digest  string            `json:"digest" validate:"omitempty"`
+	Filter  string            `json:"filter" validate:"omitempty"`
+	Headers map[string]string `json:"headers" validate:"omitempty"`
+}
+
+// PurgeTaskProgress is the progress of purging task reported by scheduler.
+type PurgeTaskProgress struct {
+	// TaskID is the id of task purging.
+	TaskID string `json:"task_id"`
+
+	// Hosts is the purge results of hosts holding the task.
+	Hosts []PurgeTaskHostResult `json:"hosts"`
+
+	// Done is whether all hosts have been purged.
+	Done bool `json:"done"`
+
+	// UpdatedAt is the update time of progress.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// PurgeTaskHostResult is the purge result of the host holding the task.
+type PurgeTaskHostResult struct {
+	// HostID is the id of host.
+	HostID string `json:"host_id"`
+
+	// Hostname is the hostname of host.
+	Hostname string `json:"hostname"`
+
+	// IP is the ip of host.
+	IP string `json:"ip"`
+
+	// Success is whether the task has been deleted from the host.
+	Success bool `json:"success"`
+
+	// Error is the error message of purging failed.
+	Error string `json:"error,omitempty"`
+}
+
+// GetTaskProgress is the task and the peers holding it reported by scheduler.
+type GetTaskProgress struct {
+	// TaskID is the id of task.
+	TaskID string `json:"task_id"`
+
+	// Found is whether the task is found in scheduler.
+	Found bool `json:"found"`
+
+	// State is the state of task.
+	State string `json:"state"`
+
+	// ContentLength is the content length of task, -1 represents it is unknown.
+	ContentLength int64 `json:"content_length"`
+
+	// TotalPieceCount is the total piece count of task.
+	TotalPieceCount int32 `json:"total_piece_count"`
+
+	// Peers is the peers of task.
+	Peers []GetTaskPeer `json:"peers"`
+
+	// Done is whether the task has been got.
+	Done bool `json:"done"`
+
+	// UpdatedAt is the update time of progress.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetTaskPeer is the peer of task and its host.
+type GetTaskPeer struct {
+	// ID is the id of peer.
+	ID string `json:"id"`
+
+	// State is the state of peer.
+	State string `json:"state"`
+
+	// FinishedPieceCount is the count of finished pieces of peer.
+	FinishedPieceCount int32 `json:"finished_piece_count"`
+
+	// HostID is the id of host.
+	HostID string `json:"host_id"`
+
+	// HostType is the type of host, it is normal, super, strong or weak.
+	HostType string `json:"host_type"`
+
+	// Hostname is the hostname of host.
+	Hostname string `json:"hostname"`
+
+	// IP is the ip of host.
+	IP string `json:"ip"`
+
+	// IDC is the idc of host.
+	IDC string `json:"idc"`
+
+	// Location is the location of host.
+	Location string `json:"location"`
+}
@@ -0,0 +1,259 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cronjob
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/atomic"
+	"gorm.io/gorm"
+
+	logger "d7y.io/dragonfly/v2/internal/dflog"
+	"d7y.io/dragonfly/v2/manager/cache"
+	"d7y.io/dragonfly/v2/manager/database"
+	"d7y.io/dragonfly/v2/manager/model"
+	"d7y.io/dragonfly/v2/manager/service"
+)
+
+const (
+	// leaderNamespace is the namespace of the cache key of leader.
+	leaderNamespace = "cron-job"
+
+	// leaderTTL is the ttl of leader lease, the lease is renewed
+	// by the leader and taken over by others when it expires.
+	leaderTTL = 30 * time.Second
+
+	// syncInterval is the interval of renewing leader lease and
+	// syncing cron jobs from database.
+	syncInterval = 10 * time.Second
+
+	// triggerTimeout is the timeout of creating the job of cron job.
+	triggerTimeout = 1 * time.Minute
+)
+
+// renewScript renews the lease when the lease is held by the instance.
+var renewScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("pexpire", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript releases the lease when the lease is held by the instance.
+var releaseScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0
+`)
+
+// CronJob fires the enabled cron jobs stored in database. Every manager instance
+// schedules the cron jobs, but only the leader elected by the lease in redis
+// creates the jobs, so the job is created once when the cron job is fired.
+type CronJob interface {
+	// Serve starts firing cron jobs.
+	Serve()
+
+	// Stop stops firing cron jobs and releases the leader lease.
+	Stop()
+}
+
+// entry is the scheduled cron job.
+type entry struct {
+	// id is the entry id of cron.
+	id cron.EntryID
+
+	// spec is the cron expression of entry.
+	spec string
+}
+
+type cronJob struct {
+	// db is the database client.
+	db *gorm.DB
+
+	// rdb is the redis client for electing leader.
+	rdb *redis.Client
+
+	// service creates the jobs of cron jobs.
+	service service.Service
+
+	// cron fires the cron jobs.
+	cron *cron.Cron
+
+	// entries is the map of cron job id and scheduled entry.
+	entries map[uint]entry
+
+	// identity is the value of leader lease held by the instance.
+	identity string
+
+	// leader is whether the instance is the leader.
+	leader *atomic.Bool
+
+	// done is closed when the cron job is stopped.
+	done chan struct{}
+
+	// once guards closing done.
+	once sync.Once
+}
+
+// New returns a new CronJob.
+func New(db *database.Database, service service.Service) CronJob {
+	hostname, _ := os.Hostname()
+	return &cronJob{
+		db:       db.DB,
+		rdb:      db.RDB,
+		service:  service,
+		cron:     cron.New(),
+		entries:  map[uint]entry{},
+		identity: fmt.Sprintf("%s-%s", hostname, uuid.NewString()),
+		leader:   atomic.NewBool(false),
+		done:     make(chan struct{}),
+	}
+}
+
+// Serve starts firing cron jobs.
+func (c *cronJob) Serve() {
+	c.cron.Start()
+	c.elect()
+	c.sync()
+
+	tick := time.NewTicker(syncInterval)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-tick.C:
+			c.elect()
+			c.sync()
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// Stop stops firing cron jobs and releases the leader lease.
+func (c *cronJob) Stop() {
+	c.once.Do(func() {
+		close(c.done)
+		<-c.cron.Stop().Done()
+
+		if c.leader.Load() {
+			if err := releaseScript.Run(context.Background(), c.rdb, []string{leaderKey()}, c.identity).Err(); err != nil {
+				logger.Errorf("release cron job leader failed: %s", err.Error())
+			}
+			c.leader.Store(false)
+		}
+	})
+}
+
+// elect acquires or renews the leader lease.
+func (c *cronJob) elect() {
+	ctx, cancel := context.WithTimeout(context.Background(), syncInterval)
+	defer cancel()
+
+	if c.leader.Load() {
+		renewed, err := renewScript.Run(ctx, c.rdb, []string{leaderKey()}, c.identity, leaderTTL.Milliseconds()).Int()
+		if err != nil || renewed == 0 {
+			logger.Warnf("cron job leader %s is lost: %v", c.identity, err)
+			c.leader.Store(false)
+		}
+
+		return
+	}
+
+	acquired, err := c.rdb.SetNX(ctx, leaderKey(), c.identity, leaderTTL).Result()
+	if err != nil {
+		logger.Errorf("acquire cron job leader failed: %s", err.Error())
+		return
+	}
+
+	if acquired {
+		logger.Infof("cron job leader is %s", c.identity)
+		c.leader.Store(true)
+	}
+}
+
+// sync schedules the enabled cron jobs in database, the entries
+// of disabled, deleted and changed cron jobs are removed.
+func (c *cronJob) sync() {
+	var cronJobs []model.CronJob
+	if err := c.db.Where(&model.CronJob{Enable: true}).Find(&cronJobs).Error; err != nil {
+		logger.Errorf("sync cron jobs failed: %s", err.Error())
+		return
+	}
+
+	enabled := map[uint]struct{}{}
+	for _, cronJob := range cronJobs {
+		enabled[cronJob.ID] = struct{}{}
+		if e, ok := c.entries[cronJob.ID]; ok {
+			if e.spec == cronJob.Cron {
+				continue
+			}
+
+			c.cron.Remove(e.id)
+			delete(c.entries, cronJob.ID)
+		}
+
+		id := cronJob.ID
+		entryID, err := c.cron.AddFunc(cronJob.Cron, func() { c.trigger(id) })
+		if err != nil {
+			logger.Errorf("schedule cron job %d failed: %s", cronJob.ID, err.Error())
+			continue
+		}
+
+		c.entries[cronJob.ID] = entry{id: entryID, spec: cronJob.Cron}
+		logger.Infof("schedule cron job %d with %s", cronJob.ID, cronJob.Cron)
+	}
+
+	for id, e := range c.entries {
+		if _, ok := enabled[id]; !ok {
+			c.cron.Remove(e.id)
+			delete(c.entries, id)
+			logger.Infof("unschedule cron job %d", id)
+		}
+	}
+}
+
+// trigger creates the job of cron job when the instance is the leader.
+func (c *cronJob) trigger(id uint) {
+	if !c.leader.Load() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), triggerTimeout)
+	defer cancel()
+
+	job, err := c.service.TriggerCronJob(ctx, id)
+	if err != nil {
+		logger.Errorf("trigger cron job %d failed: %s", id, err.Error())
+		return
+	}
+
+	logger.Infof("trigger cron job %d and create job %d", id, job.ID)
+}
+
+// leaderKey returns the cache key of leader lease.
+func leaderKey() string {
+	return cache.MakeCacheKey(leaderNamespace, "leader")
+}
@@ -0,0 +1,459 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cronjob
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/mock/gomock"
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"d7y.io/dragonfly/v2/manager/model"
+	"d7y.io/dragonfly/v2/manager/service/mocks"
+)
+
+var (
+	mockIdentity      = "foo"
+	mockOtherIdentity = "bar"
+	mockCronJobQuery  = regexp.QuoteMeta("SELECT * FROM `cron_job` WHERE `cron_job`.`enable` = ? AND `cron_job`.`is_del` = ?")
+)
+
+// newMockCronJob returns the cron job with redis, database and service mocks.
+func newMockCronJob(t *testing.T, ctl *gomock.Controller) (*cronJob, *miniredis.Miniredis, sqlmock.Sqlmock, *mocks.MockService) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(mr.Close)
+
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+
+	sqlDB, mockDB, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{
+		NamingStrategy: schema.NamingStrategy{
+			SingularTable: true,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := mocks.NewMockService(ctl)
+	return &cronJob{
+		db:       db,
+		rdb:      rdb,
+		service:  svc,
+		cron:     cron.New(),
+		entries:  map[uint]entry{},
+		identity: mockIdentity,
+		leader:   atomic.NewBool(false),
+		done:     make(chan struct{}),
+	}, mr, mockDB, svc
+}
+
+// mockCronJobRows returns the rows of cron jobs.
+func mockCronJobRows(cronJobs ...model.CronJob) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "name", "type", "cron", "enable"})
+	for _, cronJob := range cronJobs {
+		rows.AddRow(cronJob.ID, cronJob.Name, cronJob.Type, cronJob.Cron, cronJob.Enable)
+	}
+
+	return rows
+}
+
+func TestCronJob_elect(t *testing.T) {
+	tests := []struct {
+		name   string
+		leader bool
+		mock   func(mr *miniredis.Miniredis)
+		expect func(t *testing.T, c *cronJob, mr *miniredis.Miniredis)
+	}{
+		{
+			name:   "acquire leader lease",
+			leader: false,
+			mock:   func(mr *miniredis.Miniredis) {},
+			expect: func(t *testing.T, c *cronJob, mr *miniredis.Miniredis) {
+				assert := assert.New(t)
+				assert.True(c.leader.Load())
+				mr.CheckGet(t, leaderKey(), mockIdentity)
+				assert.Equal(mr.TTL(leaderKey()), leaderTTL)
+			},
+		},
+		{
+			name:   "leader lease is held by others",
+			leader: false,
+			mock: func(mr *miniredis.Miniredis) {
+				if err := mr.Set(leaderKey(), mockOtherIdentity); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(t *testing.T, c *cronJob, mr *miniredis.Miniredis) {
+				assert := assert.New(t)
+				assert.False(c.leader.Load())
+				mr.CheckGet(t, leaderKey(), mockOtherIdentity)
+			},
+		},
+		{
+			name:   "renew leader lease",
+			leader: true,
+			mock: func(mr *miniredis.Miniredis) {
+				if err := mr.Set(leaderKey(), mockIdentity); err != nil {
+					t.Fatal(err)
+				}
+				mr.SetTTL(leaderKey(), time.Second)
+			},
+			expect: func(t *testing.T, c *cronJob, mr *miniredis.Miniredis) {
+				assert := assert.New(t)
+				assert.True(c.leader.Load())
+				assert.Equal(mr.TTL(leaderKey()), leaderTTL)
+			},
+		},
+		{
+			name:   "leader lease expires",
+			leader: true,
+			mock:   func(mr *miniredis.Miniredis) {},
+			expect: func(t *testing.T, c *cronJob, mr *miniredis.Miniredis) {
+				assert := assert.New(t)
+				assert.False(c.leader.Load())
+				assert.False(mr.Exists(leaderKey()))
+			},
+		},
+		{
+			name:   "leader lease is taken over by others",
+			leader: true,
+			mock: func(mr *miniredis.Miniredis) {
+				if err := mr.Set(leaderKey(), mockOtherIdentity); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(t *testing.T, c *cronJob, mr *miniredis.Miniredis) {
+				assert := assert.New(t)
+				assert.False(c.leader.Load())
+				mr.CheckGet(t, leaderKey(), mockOtherIdentity)
+			},
+		},
+		{
+			name:   "redis is unavailable",
+			leader: true,
+			mock: func(mr *miniredis.Miniredis) {
+				mr.SetError("unavailable")
+			},
+			expect: func(t *testing.T, c *cronJob, mr *miniredis.Miniredis) {
+				assert := assert.New(t)
+				assert.False(c.leader.Load())
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+
+			c, mr, _, _ := newMockCronJob(t, ctl)
+			c.leader.Store(tc.leader)
+			tc.mock(mr)
+			c.elect()
+			mr.SetError("")
+			tc.expect(t, c, mr)
+		})
+	}
+}
+
+func TestCronJob_Stop(t *testing.T) {
+	tests := []struct {
+		name   string
+		leader bool
+		lease  string
+		expect func(t *testing.T, c *cronJob, mr *miniredis.Miniredis)
+	}{
+		{
+			name:   "release leader lease",
+			leader: true,
+			lease:  mockIdentity,
+			expect: func(t *testing.T, c *cronJob, mr *miniredis.Miniredis) {
+				assert := assert.New(t)
+				assert.False(c.leader.Load())
+				assert.False(mr.Exists(leaderKey()))
+			},
+		},
+		{
+			name:   "leader lease is held by others",
+			leader: true,
+			lease:  mockOtherIdentity,
+			expect: func(t *testing.T, c *cronJob, mr *miniredis.Miniredis) {
+				assert := assert.New(t)
+				assert.False(c.leader.Load())
+				mr.CheckGet(t, leaderKey(), mockOtherIdentity)
+			},
+		},
+		{
+			name:   "instance is not leader",
+			leader: false,
+			lease:  mockOtherIdentity,
+			expect: func(t *testing.T, c *cronJob, mr *miniredis.Miniredis) {
+				assert := assert.New(t)
+				assert.False(c.leader.Load())
+				mr.CheckGet(t, leaderKey(), mockOtherIdentity)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+
+			c, mr, _, _ := newMockCronJob(t, ctl)
+			c.leader.Store(tc.leader)
+			if err := mr.Set(leaderKey(), tc.lease); err != nil {
+				t.Fatal(err)
+			}
+
+			c.cron.Start()
+			c.Stop()
+			c.Stop()
+			tc.expect(t, c, mr)
+
+			select {
+			case <-c.done:
+			default:
+				t.Fatal("cron job is not stopped")
+			}
+		})
+	}
+}
+
+func TestCronJob_sync(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(c *cronJob, mockDB sqlmock.Sqlmock)
+		expect func(t *testing.T, c *cronJob)
+	}{
+		{
+			name: "schedule enabled cron jobs",
+			mock: func(c *cronJob, mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(mockCronJobQuery).WillReturnRows(mockCronJobRows(
+					model.CronJob{Model: model.Model{ID: 1}, Cron: "*/5 * * * *", Enable: true},
+					model.CronJob{Model: model.Model{ID: 2}, Cron: "@hourly", Enable: true},
+				))
+			},
+			expect: func(t *testing.T, c *cronJob) {
+				assert := assert.New(t)
+				assert.Len(c.entries, 2)
+				assert.Equal(c.entries[1].spec, "*/5 * * * *")
+				assert.Equal(c.entries[2].spec, "@hourly")
+				assert.Len(c.cron.Entries(), 2)
+			},
+		},
+		{
+			name: "unchanged cron job keeps entry",
+			mock: func(c *cronJob, mockDB sqlmock.Sqlmock) {
+				id, err := c.cron.AddFunc("@hourly", func() {})
+				if err != nil {
+					t.Fatal(err)
+				}
+				c.entries[1] = entry{id: id, spec: "@hourly"}
+
+				mockDB.ExpectQuery(mockCronJobQuery).WillReturnRows(mockCronJobRows(
+					model.CronJob{Model: model.Model{ID: 1}, Cron: "@hourly", Enable: true},
+				))
+			},
+			expect: func(t *testing.T, c *cronJob) {
+				assert := assert.New(t)
+				assert.Len(c.entries, 1)
+				assert.Equal(c.entries[1].id, cron.EntryID(1))
+				assert.Len(c.cron.Entries(), 1)
+			},
+		},
+		{
+			name: "changed cron job is rescheduled",
+			mock: func(c *cronJob, mockDB sqlmock.Sqlmock) {
+				id, err := c.cron.AddFunc("@hourly", func() {})
+				if err != nil {
+					t.Fatal(err)
+				}
+				c.entries[1] = entry{id: id, spec: "@hourly"}
+
+				mockDB.ExpectQuery(mockCronJobQuery).WillReturnRows(mockCronJobRows(
+					model.CronJob{Model: model.Model{ID: 1}, Cron: "@daily", Enable: true},
+				))
+			},
+			expect: func(t *testing.T, c *cronJob) {
+				assert := assert.New(t)
+				assert.Len(c.entries, 1)
+				assert.Equal(c.entries[1].spec, "@daily")
+				assert.NotEqual(c.entries[1].id, cron.EntryID(1))
+				assert.Len(c.cron.Entries(), 1)
+			},
+		},
+		{
+			name: "disabled cron job is unscheduled",
+			mock: func(c *cronJob, mockDB sqlmock.Sqlmock) {
+				id, err := c.cron.AddFunc("@hourly", func() {})
+				if err != nil {
+					t.Fatal(err)
+				}
+				c.entries[1] = entry{id: id, spec: "@hourly"}
+
+				mockDB.ExpectQuery(mockCronJobQuery).WillReturnRows(mockCronJobRows())
+			},
+			expect: func(t *testing.T, c *cronJob) {
+				assert := assert.New(t)
+				assert.Empty(c.entries)
+				assert.Empty(c.cron.Entries())
+			},
+		},
+		{
+			name: "invalid cron expression is skipped",
+			mock: func(c *cronJob, mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(mockCronJobQuery).WillReturnRows(mockCronJobRows(
+					model.CronJob{Model: model.Model{ID: 1}, Cron: "foo", Enable: true},
+					model.CronJob{Model: model.Model{ID: 2}, Cron: "@hourly", Enable: true},
+				))
+			},
+			expect: func(t *testing.T, c *cronJob) {
+				assert := assert.New(t)
+				assert.Len(c.entries, 1)
+				assert.Equal(c.entries[2].spec, "@hourly")
+			},
+		},
+		{
+			name: "database error keeps entries",
+			mock: func(c *cronJob, mockDB sqlmock.Sqlmock) {
+				id, err := c.cron.AddFunc("@hourly", func() {})
+				if err != nil {
+					t.Fatal(err)
+				}
+				c.entries[1] = entry{id: id, spec: "@hourly"}
+
+				mockDB.ExpectQuery(mockCronJobQuery).WillReturnError(errors.New("foo"))
+			},
+			expect: func(t *testing.T, c *cronJob) {
+				assert := assert.New(t)
+				assert.Len(c.entries, 1)
+				assert.Len(c.cron.Entries(), 1)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+
+			c, _, mockDB, _ := newMockCronJob(t, ctl)
+			tc.mock(c, mockDB)
+			c.sync()
+			tc.expect(t, c)
+
+			if err := mockDB.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestCronJob_trigger(t *testing.T) {
+	tests := []struct {
+		name   string
+		leader bool
+		mock   func(ms *mocks.MockServiceMockRecorder)
+	}{
+		{
+			name:   "leader creates preheat job",
+			leader: true,
+			mock: func(ms *mocks.MockServiceMockRecorder) {
+				ms.TriggerCronJob(gomock.Any(), uint(1)).Return(&model.Job{Model: model.Model{ID: 2}, Type: "preheat"}, nil).Times(1)
+			},
+		},
+		{
+			name:   "leader creates job failed",
+			leader: true,
+			mock: func(ms *mocks.MockServiceMockRecorder) {
+				ms.TriggerCronJob(gomock.Any(), uint(1)).Return(nil, errors.New("foo")).Times(1)
+			},
+		},
+		{
+			name:   "instance is not leader",
+			leader: false,
+			mock: func(ms *mocks.MockServiceMockRecorder) {
+				ms.TriggerCronJob(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+
+			c, _, mockDB, svc := newMockCronJob(t, ctl)
+			c.leader.Store(tc.leader)
+			tc.mock(svc.EXPECT())
+
+			// Fire the entry scheduled by sync.
+			mockDB.ExpectQuery(mockCronJobQuery).WillReturnRows(mockCronJobRows(
+				model.CronJob{Model: model.Model{ID: 1}, Type: "preheat", Cron: "@every 1h", Enable: true},
+			))
+			c.sync()
+			c.cron.Entry(c.entries[1].id).Job.Run()
+		})
+	}
+}
+
+func TestCronJob_Serve(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	c, mr, mockDB, _ := newMockCronJob(t, ctl)
+	mockDB.ExpectQuery(mockCronJobQuery).WillReturnRows(mockCronJobRows(
+		model.CronJob{Model: model.Model{ID: 1}, Cron: "@hourly", Enable: true},
+	))
+
+	done := make(chan struct{})
+	go func() {
+		c.Serve()
+		close(done)
+	}()
+
+	assert := assert.New(t)
+	assert.Eventually(func() bool {
+		return mockDB.ExpectationsWereMet() == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	c.Stop()
+	<-done
+	assert.False(mr.Exists(leaderKey()))
+}
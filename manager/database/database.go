@@ -74,9 +74,12 @@ func New(cfg *config.Config) (*Database, error) {
 func migrate(db *gorm.DB) error {
 	return db.AutoMigrate(
 		&model.Job{},
+		&model.CronJob{},
+		&model.WebhookRule{},
 		&model.SeedPeerCluster{},
 		&model.SeedPeer{},
 		&model.SchedulerCluster{},
+		&model.SchedulerClusterFederation{},
 		&model.Scheduler{},
 		&model.SecurityRule{},
 		&model.SecurityGroup{},
@@ -100,7 +103,8 @@ func seed(cfg *config.Config, db *gorm.DB) error {
 			},
 			Name: DefaultSchedulerClusterName,
 			Config: map[string]any{
-				"filter_parent_limit": schedulerconfig.DefaultSchedulerFilterParentLimit,
+				"filter_parent_limit":    schedulerconfig.DefaultSchedulerFilterParentLimit,
+				"candidate_parent_limit": schedulerconfig.DefaultSchedulerCandidateParentLimit,
 			},
 			ClientConfig: map[string]any{
 				"load_limit":     schedulerconfig.DefaultClientLoadLimit,
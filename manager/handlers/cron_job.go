@@ -0,0 +1,197 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	_ "d7y.io/dragonfly/v2/manager/model" // nolint
+	"d7y.io/dragonfly/v2/manager/types"
+)
+
+// @Summary Create CronJob
+// @Description Create by json config, the job defined by cron job is created when the cron expression is fired
+// @Tags CronJob
+// @Accept json
+// @Produce json
+// @Param CronJob body types.CreateCronJobRequest true "CronJob"
+// @Success 200 {object} model.CronJob
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /cron-jobs [post]
+func (h *Handlers) CreateCronJob(ctx *gin.Context) {
+	var json types.CreateCronJobRequest
+	if err := ctx.ShouldBindJSON(&json); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	cronJob, err := h.service.CreateCronJob(ctx.Request.Context(), json)
+	if err != nil {
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	ctx.JSON(http.StatusOK, cronJob)
+}
+
+// @Summary Destroy CronJob
+// @Description Destroy by id
+// @Tags CronJob
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /cron-jobs/{id} [delete]
+func (h *Handlers) DestroyCronJob(ctx *gin.Context) {
+	var params types.CronJobParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	if err := h.service.DestroyCronJob(ctx.Request.Context(), params.ID); err != nil {
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// @Summary Update CronJob
+// @Description Update by json config
+// @Tags CronJob
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param CronJob body types.UpdateCronJobRequest true "CronJob"
+// @Success 200 {object} model.CronJob
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /cron-jobs/{id} [patch]
+func (h *Handlers) UpdateCronJob(ctx *gin.Context) {
+	var params types.CronJobParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	var json types.UpdateCronJobRequest
+	if err := ctx.ShouldBindJSON(&json); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	cronJob, err := h.service.UpdateCronJob(ctx.Request.Context(), params.ID, json)
+	if err != nil {
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	ctx.JSON(http.StatusOK, cronJob)
+}
+
+// @Summary Get CronJob
+// @Description Get CronJob by id
+// @Tags CronJob
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200 {object} model.CronJob
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /cron-jobs/{id} [get]
+func (h *Handlers) GetCronJob(ctx *gin.Context) {
+	var params types.CronJobParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	cronJob, err := h.service.GetCronJob(ctx.Request.Context(), params.ID)
+	if err != nil {
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	ctx.JSON(http.StatusOK, cronJob)
+}
+
+// @Summary Get CronJobs
+// @Description Get CronJobs
+// @Tags CronJob
+// @Accept json
+// @Produce json
+// @Param page query int true "current page" default(0)
+// @Param per_page query int true "return max item count, default 10, max 50" default(10) minimum(2) maximum(50)
+// @Success 200 {object} []model.CronJob
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /cron-jobs [get]
+func (h *Handlers) GetCronJobs(ctx *gin.Context) {
+	var query types.GetCronJobsQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	h.setPaginationDefault(&query.Page, &query.PerPage)
+	cronJobs, count, err := h.service.GetCronJobs(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	h.setPaginationLinkHeader(ctx, query.Page, query.PerPage, int(count))
+	ctx.JSON(http.StatusOK, cronJobs)
+}
+
+// @Summary Trigger CronJob
+// @Description Create the job defined by cron job immediately
+// @Tags CronJob
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200 {object} model.Job
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /cron-jobs/{id}/trigger [post]
+func (h *Handlers) TriggerCronJob(ctx *gin.Context) {
+	var params types.CronJobParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	job, err := h.service.TriggerCronJob(ctx.Request.Context(), params.ID)
+	if err != nil {
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	ctx.JSON(http.StatusOK, job)
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -8,6 +9,7 @@ import (
 
 	"d7y.io/dragonfly/v2/internal/job"
 	_ "d7y.io/dragonfly/v2/manager/model" // nolint
+	"d7y.io/dragonfly/v2/manager/service"
 	"d7y.io/dragonfly/v2/manager/types"
 )
 
@@ -43,6 +45,34 @@ func (h *Handlers) CreateJob(ctx *gin.Context) {
 			return
 		}
 
+		ctx.JSON(http.StatusOK, job)
+	case job.CordonHostJob, job.UncordonHostJob, job.DrainHostJob:
+		var json types.CreateHostJobRequest
+		if err := ctx.ShouldBindBodyWith(&json, binding.JSON); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+			return
+		}
+
+		job, err := h.service.CreateHostJob(ctx.Request.Context(), json)
+		if err != nil {
+			ctx.Error(err) // nolint: errcheck
+			return
+		}
+
+		ctx.JSON(http.StatusOK, job)
+	case job.PurgeTaskJob, job.GetTaskJob:
+		var json types.CreateTaskJobRequest
+		if err := ctx.ShouldBindBodyWith(&json, binding.JSON); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+			return
+		}
+
+		job, err := h.service.CreateTaskJob(ctx.Request.Context(), json)
+		if err != nil {
+			ctx.Error(err) // nolint: errcheck
+			return
+		}
+
 		ctx.JSON(http.StatusOK, job)
 	default:
 		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": "Unknow type"})
@@ -75,6 +105,39 @@ func (h *Handlers) DestroyJob(ctx *gin.Context) {
 	ctx.Status(http.StatusOK)
 }
 
+// @Summary Cancel Job
+// @Description Cancel by id
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200 {object} model.Job
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 500
+// @Router /jobs/{id}/cancel [post]
+func (h *Handlers) CancelJob(ctx *gin.Context) {
+	var params types.JobParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	job, err := h.service.CancelJob(ctx.Request.Context(), params.ID)
+	if err != nil {
+		if errors.Is(err, service.ErrJobFinished) {
+			ctx.JSON(http.StatusConflict, gin.H{"errors": err.Error()})
+			return
+		}
+
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	ctx.JSON(http.StatusOK, job)
+}
+
 // @Summary Update Job
 // @Description Update by json config
 // @Tags Job
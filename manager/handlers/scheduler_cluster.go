@@ -197,3 +197,92 @@ func (h *Handlers) AddSchedulerToSchedulerCluster(ctx *gin.Context) {
 
 	ctx.Status(http.StatusOK)
 }
+
+// @Summary Get federated SchedulerClusters
+// @Description Get federated SchedulerClusters of schedulerCluster
+// @Tags SchedulerCluster
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200 {object} []model.SchedulerClusterFederation
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /scheduler-clusters/{id}/federated-scheduler-clusters [get]
+func (h *Handlers) GetFederatedSchedulerClusters(ctx *gin.Context) {
+	var params types.SchedulerClusterParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	federations, err := h.service.GetFederatedSchedulerClusters(ctx.Request.Context(), params.ID)
+	if err != nil {
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	ctx.JSON(http.StatusOK, federations)
+}
+
+// @Summary Add federated SchedulerCluster to schedulerCluster
+// @Description Add federated SchedulerCluster to schedulerCluster, schedulers of schedulerCluster can find the peers of tasks in federated schedulerCluster
+// @Tags SchedulerCluster
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param federated_scheduler_cluster_id path string true "federated scheduler cluster id"
+// @Param FederatedSchedulerCluster body types.AddFederatedSchedulerClusterToSchedulerClusterRequest true "FederatedSchedulerCluster"
+// @Success 200 {object} model.SchedulerClusterFederation
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /scheduler-clusters/{id}/federated-scheduler-clusters/{federated_scheduler_cluster_id} [put]
+func (h *Handlers) AddFederatedSchedulerClusterToSchedulerCluster(ctx *gin.Context) {
+	var params types.AddFederatedSchedulerClusterToSchedulerClusterParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	var json types.AddFederatedSchedulerClusterToSchedulerClusterRequest
+	if err := ctx.ShouldBindJSON(&json); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	federation, err := h.service.AddFederatedSchedulerClusterToSchedulerCluster(ctx.Request.Context(), params.ID, params.FederatedSchedulerClusterID, json)
+	if err != nil {
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	ctx.JSON(http.StatusOK, federation)
+}
+
+// @Summary Delete federated SchedulerCluster to schedulerCluster
+// @Description Delete federated SchedulerCluster to schedulerCluster
+// @Tags SchedulerCluster
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param federated_scheduler_cluster_id path string true "federated scheduler cluster id"
+// @Success 200
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /scheduler-clusters/{id}/federated-scheduler-clusters/{federated_scheduler_cluster_id} [delete]
+func (h *Handlers) DeleteFederatedSchedulerClusterToSchedulerCluster(ctx *gin.Context) {
+	var params types.DeleteFederatedSchedulerClusterToSchedulerClusterParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	if err := h.service.DeleteFederatedSchedulerClusterToSchedulerCluster(ctx.Request.Context(), params.ID, params.FederatedSchedulerClusterID); err != nil {
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
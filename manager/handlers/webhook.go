@@ -0,0 +1,214 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	_ "d7y.io/dragonfly/v2/manager/model" // nolint
+	"d7y.io/dragonfly/v2/manager/service"
+	"d7y.io/dragonfly/v2/manager/types"
+)
+
+// webhookBodyLimit is the max size of registry webhook body.
+const webhookBodyLimit = 4 * 1024 * 1024
+
+// @Summary Create WebhookRule
+// @Description Create by json config, preheat jobs are created for the images pushed to registry matching the webhook rule
+// @Tags WebhookRule
+// @Accept json
+// @Produce json
+// @Param WebhookRule body types.CreateWebhookRuleRequest true "WebhookRule"
+// @Success 200 {object} model.WebhookRule
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /webhook-rules [post]
+func (h *Handlers) CreateWebhookRule(ctx *gin.Context) {
+	var json types.CreateWebhookRuleRequest
+	if err := ctx.ShouldBindJSON(&json); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	webhookRule, err := h.service.CreateWebhookRule(ctx.Request.Context(), json)
+	if err != nil {
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	ctx.JSON(http.StatusOK, webhookRule)
+}
+
+// @Summary Destroy WebhookRule
+// @Description Destroy by id
+// @Tags WebhookRule
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /webhook-rules/{id} [delete]
+func (h *Handlers) DestroyWebhookRule(ctx *gin.Context) {
+	var params types.WebhookRuleParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	if err := h.service.DestroyWebhookRule(ctx.Request.Context(), params.ID); err != nil {
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// @Summary Update WebhookRule
+// @Description Update by json config
+// @Tags WebhookRule
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param WebhookRule body types.UpdateWebhookRuleRequest true "WebhookRule"
+// @Success 200 {object} model.WebhookRule
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /webhook-rules/{id} [patch]
+func (h *Handlers) UpdateWebhookRule(ctx *gin.Context) {
+	var params types.WebhookRuleParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	var json types.UpdateWebhookRuleRequest
+	if err := ctx.ShouldBindJSON(&json); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	webhookRule, err := h.service.UpdateWebhookRule(ctx.Request.Context(), params.ID, json)
+	if err != nil {
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	ctx.JSON(http.StatusOK, webhookRule)
+}
+
+// @Summary Get WebhookRule
+// @Description Get WebhookRule by id
+// @Tags WebhookRule
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200 {object} model.WebhookRule
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /webhook-rules/{id} [get]
+func (h *Handlers) GetWebhookRule(ctx *gin.Context) {
+	var params types.WebhookRuleParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	webhookRule, err := h.service.GetWebhookRule(ctx.Request.Context(), params.ID)
+	if err != nil {
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	ctx.JSON(http.StatusOK, webhookRule)
+}
+
+// @Summary Get WebhookRules
+// @Description Get WebhookRules
+// @Tags WebhookRule
+// @Accept json
+// @Produce json
+// @Param page query int true "current page" default(0)
+// @Param per_page query int true "return max item count, default 10, max 50" default(10) minimum(2) maximum(50)
+// @Success 200 {object} []model.WebhookRule
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /webhook-rules [get]
+func (h *Handlers) GetWebhookRules(ctx *gin.Context) {
+	var query types.GetWebhookRulesQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	h.setPaginationDefault(&query.Page, &query.PerPage)
+	webhookRules, count, err := h.service.GetWebhookRules(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	h.setPaginationLinkHeader(ctx, query.Page, query.PerPage, int(count))
+	ctx.JSON(http.StatusOK, webhookRules)
+}
+
+// @Summary Create Registry Webhook Jobs
+// @Description Receive the push event of Docker Distribution or Harbor, and create preheat jobs for the images matching the webhook rules of secret
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "secret of webhook rule"
+// @Success 200 {object} []model.Job
+// @Failure 400
+// @Failure 401
+// @Failure 500
+// @Router /webhooks/registry [post]
+func (h *Handlers) CreateRegistryWebhookJobs(ctx *gin.Context) {
+	// Webhook is not authenticated by jwt, the body is limited
+	// before the secret is checked.
+	body, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, webhookBodyLimit))
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	// Docker Distribution sends the configured headers and Harbor sends the
+	// auth header as the value of authorization header.
+	secret := strings.TrimPrefix(ctx.GetHeader("Authorization"), "Bearer ")
+	jobs, err := h.service.CreateRegistryWebhookJobs(ctx.Request.Context(), secret, body)
+	if err != nil {
+		if errors.Is(err, service.ErrWebhookUnauthorized) {
+			ctx.JSON(http.StatusUnauthorized, gin.H{"errors": err.Error()})
+			return
+		}
+
+		ctx.Error(err) // nolint: errcheck
+		return
+	}
+
+	ctx.JSON(http.StatusOK, jobs)
+}
@@ -0,0 +1,83 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+//go:generate mockgen -destination mocks/host_mock.go -source host.go -package mocks
+
+package job
+
+import (
+	"context"
+	"time"
+
+	machineryv1tasks "github.com/RichardKnop/machinery/v1/tasks"
+
+	logger "d7y.io/dragonfly/v2/internal/dflog"
+	internaljob "d7y.io/dragonfly/v2/internal/job"
+	"d7y.io/dragonfly/v2/manager/model"
+	"d7y.io/dragonfly/v2/manager/types"
+)
+
+// Host is the maintenance jobs of host, the jobs are sent to
+// every scheduler because each scheduler schedules the host separately.
+type Host interface {
+	// CreateHostJob creates the cordon_host, uncordon_host or drain_host job.
+	CreateHostJob(context.Context, string, []model.Scheduler, types.HostArgs) (*internaljob.GroupJobState, error)
+}
+
+type host struct {
+	job *internaljob.Job
+}
+
+func newHost(job *internaljob.Job) Host {
+	return &host{
+		job: job,
+	}
+}
+
+func (h *host) CreateHostJob(ctx context.Context, name string, schedulers []model.Scheduler, json types.HostArgs) (*internaljob.GroupJobState, error) {
+	args, err := internaljob.MarshalRequest(&internaljob.HostRequest{
+		HostID: json.HostID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var signatures []*machineryv1tasks.Signature
+	for _, queue := range getSchedulerQueues(schedulers) {
+		signatures = append(signatures, &machineryv1tasks.Signature{
+			Name:       name,
+			RoutingKey: queue.String(),
+			Args:       args,
+		})
+	}
+
+	group, err := machineryv1tasks.NewGroup(signatures...)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.job.Server.SendGroupWithContext(ctx, group, 0); err != nil {
+		logger.Errorf("create %s group job failed: %s", name, err.Error())
+		return nil, err
+	}
+
+	logger.Infof("create %s group job successfully, group uuid: %s, host id: %s", name, group.GroupUUID, json.HostID)
+	return &internaljob.GroupJobState{
+		GroupUUID: group.GroupUUID,
+		State:     machineryv1tasks.StatePending,
+		CreatedAt: time.Now(),
+	}, nil
+}
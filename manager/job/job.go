@@ -24,6 +24,8 @@ import (
 type Job struct {
 	*internaljob.Job
 	Preheat
+	Host
+	Task
 }
 
 func New(cfg *config.Config) (*Job, error) {
@@ -46,6 +48,8 @@ func New(cfg *config.Config) (*Job, error) {
 	return &Job{
 		Job:     j,
 		Preheat: p,
+		Host:    newHost(j),
+		Task:    newTask(j),
 	}, nil
 }
 
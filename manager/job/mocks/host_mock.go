@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: host.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	job "d7y.io/dragonfly/v2/internal/job"
+	model "d7y.io/dragonfly/v2/manager/model"
+	types "d7y.io/dragonfly/v2/manager/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockHost is a mock of Host interface.
+type MockHost struct {
+	ctrl     *gomock.Controller
+	recorder *MockHostMockRecorder
+}
+
+// MockHostMockRecorder is the mock recorder for MockHost.
+type MockHostMockRecorder struct {
+	mock *MockHost
+}
+
+// NewMockHost creates a new mock instance.
+func NewMockHost(ctrl *gomock.Controller) *MockHost {
+	mock := &MockHost{ctrl: ctrl}
+	mock.recorder = &MockHostMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHost) EXPECT() *MockHostMockRecorder {
+	return m.recorder
+}
+
+// CreateHostJob mocks base method.
+func (m *MockHost) CreateHostJob(arg0 context.Context, arg1 string, arg2 []model.Scheduler, arg3 types.HostArgs) (*job.GroupJobState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHostJob", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*job.GroupJobState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHostJob indicates an expected call of CreateHostJob.
+func (mr *MockHostMockRecorder) CreateHostJob(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHostJob", reflect.TypeOf((*MockHost)(nil).CreateHostJob), arg0, arg1, arg2, arg3)
+}
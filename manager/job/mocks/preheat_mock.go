@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	job "d7y.io/dragonfly/v2/internal/job"
+	job0 "d7y.io/dragonfly/v2/manager/job"
 	model "d7y.io/dragonfly/v2/manager/model"
 	types "d7y.io/dragonfly/v2/manager/types"
 	gomock "github.com/golang/mock/gomock"
@@ -38,12 +39,13 @@ func (m *MockPreheat) EXPECT() *MockPreheatMockRecorder {
 }
 
 // CreatePreheat mocks base method.
-func (m *MockPreheat) CreatePreheat(arg0 context.Context, arg1 []model.Scheduler, arg2 types.PreheatArgs) (*job.GroupJobState, error) {
+func (m *MockPreheat) CreatePreheat(arg0 context.Context, arg1 []model.Scheduler, arg2 types.PreheatArgs) (*job.GroupJobState, *job0.PreheatResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreatePreheat", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*job.GroupJobState)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*job0.PreheatResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // CreatePreheat indicates an expected call of CreatePreheat.
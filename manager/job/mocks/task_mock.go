@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: task.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	job "d7y.io/dragonfly/v2/internal/job"
+	model "d7y.io/dragonfly/v2/manager/model"
+	types "d7y.io/dragonfly/v2/manager/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTask is a mock of Task interface.
+type MockTask struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskMockRecorder
+}
+
+// MockTaskMockRecorder is the mock recorder for MockTask.
+type MockTaskMockRecorder struct {
+	mock *MockTask
+}
+
+// NewMockTask creates a new mock instance.
+func NewMockTask(ctrl *gomock.Controller) *MockTask {
+	mock := &MockTask{ctrl: ctrl}
+	mock.recorder = &MockTaskMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTask) EXPECT() *MockTaskMockRecorder {
+	return m.recorder
+}
+
+// CreateTaskJob mocks base method.
+func (m *MockTask) CreateTaskJob(arg0 context.Context, arg1 string, arg2 []model.Scheduler, arg3 types.TaskArgs) (*job.GroupJobState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTaskJob", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*job.GroupJobState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTaskJob indicates an expected call of CreateTaskJob.
+func (mr *MockTaskMockRecorder) CreateTaskJob(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaskJob", reflect.TypeOf((*MockTask)(nil).CreateTaskJob), arg0, arg1, arg2, arg3)
+}
@@ -32,7 +32,10 @@ import (
 
 	machineryv1tasks "github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/distribution/distribution/v3"
+	"github.com/distribution/distribution/v3/manifest/manifestlist"
+	_ "github.com/distribution/distribution/v3/manifest/ocischema"
 	"github.com/distribution/distribution/v3/manifest/schema2"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 
@@ -61,10 +64,52 @@ const (
 	timeout = 1 * time.Minute
 )
 
+const (
+	// defaultPlatformOS is the os of platform preheated when platforms are not specified.
+	defaultPlatformOS = "linux"
+
+	// defaultPlatformArchitecture is the architecture of platform preheated when platforms are not specified.
+	defaultPlatformArchitecture = "amd64"
+)
+
 var accessURLPattern, _ = regexp.Compile("^(.*)://(.*)/v2/(.*)/manifests/(.*)")
 
+// manifestMediaTypes is the media types of manifests accepted from registry.
+var manifestMediaTypes = []string{
+	schema2.MediaTypeManifest,
+	manifestlist.MediaTypeManifestList,
+	v1.MediaTypeImageManifest,
+	v1.MediaTypeImageIndex,
+}
+
 type Preheat interface {
-	CreatePreheat(context.Context, []model.Scheduler, types.PreheatArgs) (*internaljob.GroupJobState, error)
+	CreatePreheat(context.Context, []model.Scheduler, types.PreheatArgs) (*internaljob.GroupJobState, *PreheatResult, error)
+}
+
+// PreheatResult is the manifests and files enqueued by preheat job.
+type PreheatResult struct {
+	// Manifests is the image manifests resolved for the selected platforms.
+	Manifests []PreheatManifest `json:"manifests,omitempty"`
+
+	// URLs is the urls of files enqueued.
+	URLs []string `json:"urls"`
+}
+
+// PreheatManifest is the image manifest resolved by preheat job.
+type PreheatManifest struct {
+	// Digest is the digest of manifest, it is empty when
+	// the manifest is fetched by tag.
+	Digest string `json:"digest,omitempty"`
+
+	// MediaType is the media type of manifest.
+	MediaType string `json:"media_type"`
+
+	// Platform is the platform of manifest in os/architecture[/variant] format,
+	// it is empty when the manifest is not referenced by manifest list or image index.
+	Platform string `json:"platform,omitempty"`
+
+	// Layers is the count of layers in manifest.
+	Layers int `json:"layers"`
 }
 
 type preheat struct {
@@ -84,7 +129,7 @@ func newPreheat(job *internaljob.Job) (Preheat, error) {
 	}, nil
 }
 
-func (p *preheat) CreatePreheat(ctx context.Context, schedulers []model.Scheduler, json types.PreheatArgs) (*internaljob.GroupJobState, error) {
+func (p *preheat) CreatePreheat(ctx context.Context, schedulers []model.Scheduler, json types.PreheatArgs) (*internaljob.GroupJobState, *PreheatResult, error) {
 	var span trace.Span
 	ctx, span = tracer.Start(ctx, config.SpanPreheat, trace.WithSpanKind(trace.SpanKindProducer))
 	span.SetAttributes(config.AttributePreheatType.String(json.Type))
@@ -100,18 +145,21 @@ func (p *preheat) CreatePreheat(ctx context.Context, schedulers []model.Schedule
 	queues := getSchedulerQueues(schedulers)
 
 	// Generate download files
-	var files []*internaljob.PreheatRequest
+	var (
+		files     []*internaljob.PreheatRequest
+		manifests []PreheatManifest
+	)
 	switch PreheatType(json.Type) {
 	case PreheatImageType:
 		// Parse image manifest url
 		image, err := parseAccessURL(url)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
-		files, err = p.getLayers(ctx, url, tag, filter, nethttp.MapToHeader(rawheader), image)
+		files, manifests, err = p.getLayers(ctx, url, tag, filter, nethttp.MapToHeader(rawheader), image, json.Platforms, json.AllPlatforms)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	case PreheatFileType:
 		files = []*internaljob.PreheatRequest{
@@ -123,14 +171,21 @@ func (p *preheat) CreatePreheat(ctx context.Context, schedulers []model.Schedule
 			},
 		}
 	default:
-		return nil, errors.New("unknow preheat type")
+		return nil, nil, errors.New("unknow preheat type")
 	}
 
+	result := &PreheatResult{Manifests: manifests}
 	for _, f := range files {
 		logger.Infof("preheat %s file url: %v queues: %v", json.URL, f.URL, queues)
+		result.URLs = append(result.URLs, f.URL)
+	}
+
+	groupJobState, err := p.createGroupJob(ctx, files, queues)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return p.createGroupJob(ctx, files, queues)
+	return groupJobState, result, nil
 }
 
 func (p *preheat) createGroupJob(ctx context.Context, files []*internaljob.PreheatRequest, queues []internaljob.Queue) (*internaljob.GroupJobState, error) {
@@ -173,13 +228,88 @@ func (p *preheat) createGroupJob(ctx context.Context, files []*internaljob.Prehe
 	}, nil
 }
 
-func (p *preheat) getLayers(ctx context.Context, url, tag, filter string, header http.Header, image *preheatImage) ([]*internaljob.PreheatRequest, error) {
+// getLayers resolves the manifests of image for the platforms and returns the layers of them,
+// the manifest list and image index are resolved to the manifests of the matched platforms.
+func (p *preheat) getLayers(ctx context.Context, url, tag, filter string, header http.Header, image *preheatImage,
+	platforms []types.PreheatPlatform, allPlatforms bool) ([]*internaljob.PreheatRequest, []PreheatManifest, error) {
 	ctx, span := tracer.Start(ctx, config.SpanGetLayers, trace.WithSpanKind(trace.SpanKindProducer))
 	defer span.End()
 
+	manifest, mediaType, err := p.getManifest(ctx, url, header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		layers    []*internaljob.PreheatRequest
+		manifests []PreheatManifest
+		visited   = map[string]struct{}{}
+	)
+	appendLayers := func(manifest distribution.Manifest, preheatManifest PreheatManifest) {
+		for _, v := range manifest.References() {
+			digest := v.Digest.String()
+			if _, ok := visited[digest]; ok {
+				continue
+			}
+			visited[digest] = struct{}{}
+
+			layers = append(layers, &internaljob.PreheatRequest{
+				URL:     layerURL(image.protocol, image.domain, image.name, digest),
+				Tag:     tag,
+				Filter:  filter,
+				Headers: nethttp.HeaderToMap(header),
+			})
+		}
+
+		preheatManifest.Layers = len(manifest.References())
+		manifests = append(manifests, preheatManifest)
+	}
+
+	manifestList, ok := manifest.(*manifestlist.DeserializedManifestList)
+	if !ok {
+		appendLayers(manifest, PreheatManifest{MediaType: mediaType})
+		return layers, manifests, nil
+	}
+
+	if !allPlatforms && len(platforms) == 0 {
+		platforms = []types.PreheatPlatform{{OS: defaultPlatformOS, Architecture: defaultPlatformArchitecture}}
+	}
+
+	for _, desc := range manifestList.Manifests {
+		if !allPlatforms && !matchPlatforms(desc.Platform, platforms) {
+			continue
+		}
+
+		digest := desc.Digest.String()
+		manifest, mediaType, err := p.getManifest(ctx, manifestURL(image.protocol, image.domain, image.name, digest), header)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if _, ok := manifest.(*manifestlist.DeserializedManifestList); ok {
+			return nil, nil, fmt.Errorf("manifest %s is nested manifest list", digest)
+		}
+
+		appendLayers(manifest, PreheatManifest{
+			Digest:    digest,
+			MediaType: mediaType,
+			Platform:  platformString(desc.Platform),
+		})
+	}
+
+	if len(manifests) == 0 {
+		return nil, nil, errors.New("no manifest matches the platforms")
+	}
+
+	return layers, manifests, nil
+}
+
+// getManifest fetches and unmarshals the manifest, the auth token is
+// added to header when registry requires authentication.
+func (p *preheat) getManifest(ctx context.Context, url string, header http.Header) (distribution.Manifest, string, error) {
 	resp, err := p.getManifests(ctx, url, header)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer resp.Body.Close()
 
@@ -187,27 +317,27 @@ func (p *preheat) getLayers(ctx context.Context, url, tag, filter string, header
 		if resp.StatusCode == http.StatusUnauthorized {
 			token, err := getAuthToken(ctx, resp.Header)
 			if err != nil {
-				return nil, err
+				return nil, "", err
 			}
 
 			bearer := "Bearer " + token
-			header.Add("Authorization", bearer)
+			header.Set("Authorization", bearer)
 
 			resp, err = p.getManifests(ctx, url, header)
 			if err != nil {
-				return nil, err
+				return nil, "", err
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode/100 != 2 {
+				return nil, "", fmt.Errorf("request registry %d", resp.StatusCode)
 			}
 		} else {
-			return nil, fmt.Errorf("request registry %d", resp.StatusCode)
+			return nil, "", fmt.Errorf("request registry %d", resp.StatusCode)
 		}
 	}
 
-	layers, err := p.parseLayers(resp, url, tag, filter, header, image)
-	if err != nil {
-		return nil, err
-	}
-
-	return layers, nil
+	return p.parseManifest(resp)
 }
 
 func (p *preheat) getManifests(ctx context.Context, url string, header http.Header) (*http.Response, error) {
@@ -216,8 +346,8 @@ func (p *preheat) getManifests(ctx context.Context, url string, header http.Head
 		return nil, err
 	}
 
-	req.Header = header
-	req.Header.Add("Accept", schema2.MediaTypeManifest)
+	req.Header = header.Clone()
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
 
 	client := &http.Client{
 		Timeout: timeout,
@@ -234,31 +364,22 @@ func (p *preheat) getManifests(ctx context.Context, url string, header http.Head
 	return resp, nil
 }
 
-func (p *preheat) parseLayers(resp *http.Response, url, tag, filter string, header http.Header, image *preheatImage) ([]*internaljob.PreheatRequest, error) {
+// parseManifest unmarshals the manifest by the media type of response, the media type in
+// manifest is used when registry responds with a generic content type, and the manifest is
+// regarded as docker image manifest v2 schema 2 when neither of them is known.
+func (p *preheat) parseManifest(resp *http.Response) (distribution.Manifest, string, error) {
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	manifest, _, err := distribution.UnmarshalManifest(schema2.MediaTypeManifest, body)
+	mediaType := manifestMediaType(resp.Header.Get("Content-Type"), body)
+	manifest, _, err := distribution.UnmarshalManifest(mediaType, body)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	var layers []*internaljob.PreheatRequest
-	for _, v := range manifest.References() {
-		digest := v.Digest.String()
-		layer := &internaljob.PreheatRequest{
-			URL:     layerURL(image.protocol, image.domain, image.name, digest),
-			Tag:     tag,
-			Filter:  filter,
-			Headers: nethttp.HeaderToMap(header),
-		}
-
-		layers = append(layers, layer)
-	}
-
-	return layers, nil
+	return manifest, mediaType, nil
 }
 
 func getAuthToken(ctx context.Context, header http.Header) (string, error) {
@@ -318,6 +439,75 @@ func authURL(wwwAuth []string) string {
 	return fmt.Sprintf("%s?%s", host, query)
 }
 
+// manifestMediaType returns the media type of manifest by the content type of response and manifest.
+func manifestMediaType(contentType string, body []byte) string {
+	if i := strings.Index(contentType, ";"); i != -1 {
+		contentType = contentType[:i]
+	}
+
+	contentType = strings.TrimSpace(contentType)
+	for _, mediaType := range manifestMediaTypes {
+		if contentType == mediaType {
+			return mediaType
+		}
+	}
+
+	var versioned struct {
+		MediaType string            `json:"mediaType"`
+		Manifests []json.RawMessage `json:"manifests"`
+		Layers    []json.RawMessage `json:"layers"`
+	}
+	if err := json.Unmarshal(body, &versioned); err == nil {
+		for _, mediaType := range manifestMediaTypes {
+			if versioned.MediaType == mediaType {
+				return mediaType
+			}
+		}
+
+		// The media type is optional in OCI image index and manifest.
+		if versioned.MediaType == "" && versioned.Manifests != nil {
+			return v1.MediaTypeImageIndex
+		}
+
+		if versioned.MediaType == "" && versioned.Layers != nil {
+			return v1.MediaTypeImageManifest
+		}
+	}
+
+	return schema2.MediaTypeManifest
+}
+
+// matchPlatforms determines whether the platform matches one of the platforms,
+// the variant is matched only when it is specified.
+func matchPlatforms(platform manifestlist.PlatformSpec, platforms []types.PreheatPlatform) bool {
+	for _, p := range platforms {
+		if p.OS != platform.OS || p.Architecture != platform.Architecture {
+			continue
+		}
+
+		if p.Variant != "" && p.Variant != platform.Variant {
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// platformString returns the platform in os/architecture[/variant] format.
+func platformString(platform manifestlist.PlatformSpec) string {
+	if platform.Variant == "" {
+		return fmt.Sprintf("%s/%s", platform.OS, platform.Architecture)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", platform.OS, platform.Architecture, platform.Variant)
+}
+
+func manifestURL(protocol string, domain string, name string, reference string) string {
+	return fmt.Sprintf("%s://%s/v2/%s/manifests/%s", protocol, domain, name, reference)
+}
+
 func layerURL(protocol string, domain string, name string, digest string) string {
 	return fmt.Sprintf("%s://%s/v2/%s/blobs/%s", protocol, domain, name, digest)
 }
@@ -0,0 +1,343 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package job
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/distribution/distribution/v3/manifest/manifestlist"
+	"github.com/distribution/distribution/v3/manifest/schema2"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+
+	internaljob "d7y.io/dragonfly/v2/internal/job"
+	"d7y.io/dragonfly/v2/manager/types"
+)
+
+// mockDigest returns the sha256 digest of s.
+func mockDigest(s string) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(s)))
+}
+
+var (
+	mockAMD64ManifestDigest = mockDigest("amd64")
+	mockARM64ManifestDigest = mockDigest("arm64")
+	mockARMv7ManifestDigest = mockDigest("armv7")
+	mockConfigDigest        = mockDigest("config")
+	mockSharedLayerDigest   = mockDigest("shared")
+	mockAMD64LayerDigest    = mockDigest("amd64-layer")
+	mockARM64LayerDigest    = mockDigest("arm64-layer")
+	mockARMv7LayerDigest    = mockDigest("armv7-layer")
+)
+
+// mockImageManifest returns the image manifest with the config and layers.
+func mockImageManifest(mediaType, configMediaType, layerMediaType string, layers ...string) string {
+	var descriptors []string
+	for _, layer := range layers {
+		descriptors = append(descriptors, fmt.Sprintf(`{"mediaType":%q,"size":1,"digest":%q}`, layerMediaType, layer))
+	}
+
+	return fmt.Sprintf(`{"schemaVersion":2,"mediaType":%q,"config":{"mediaType":%q,"size":1,"digest":%q},"layers":[%s]}`,
+		mediaType, configMediaType, mockConfigDigest, strings.Join(descriptors, ","))
+}
+
+// mockManifestList returns the manifest list or image index of platforms.
+func mockManifestList(mediaType, manifestMediaType string) string {
+	return fmt.Sprintf(`{"schemaVersion":2,"mediaType":%q,"manifests":[`+
+		`{"mediaType":%q,"size":1,"digest":%q,"platform":{"architecture":"amd64","os":"linux"}},`+
+		`{"mediaType":%q,"size":1,"digest":%q,"platform":{"architecture":"arm64","os":"linux","variant":"v8"}},`+
+		`{"mediaType":%q,"size":1,"digest":%q,"platform":{"architecture":"arm","os":"linux","variant":"v7"}}]}`,
+		mediaType, manifestMediaType, mockAMD64ManifestDigest, manifestMediaType, mockARM64ManifestDigest, manifestMediaType, mockARMv7ManifestDigest)
+}
+
+// mockRegistry returns the registry serving the manifests of image library/nginx,
+// the tag latest refers to the manifest of tag.
+func mockRegistry(t *testing.T, tagMediaType, tagManifest, manifestMediaType, configMediaType, layerMediaType string) *httptest.Server {
+	manifests := map[string][2]string{
+		"latest":                {tagMediaType, tagManifest},
+		mockAMD64ManifestDigest: {manifestMediaType, mockImageManifest(manifestMediaType, configMediaType, layerMediaType, mockSharedLayerDigest, mockAMD64LayerDigest)},
+		mockARM64ManifestDigest: {manifestMediaType, mockImageManifest(manifestMediaType, configMediaType, layerMediaType, mockSharedLayerDigest, mockARM64LayerDigest)},
+		mockARMv7ManifestDigest: {manifestMediaType, mockImageManifest(manifestMediaType, configMediaType, layerMediaType, mockSharedLayerDigest, mockARMv7LayerDigest)},
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reference := strings.TrimPrefix(r.URL.Path, "/v2/library/nginx/manifests/")
+		manifest, ok := manifests[reference]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", manifest[0])
+		if _, err := w.Write([]byte(manifest[1])); err != nil {
+			t.Error(err)
+		}
+	}))
+}
+
+func TestPreheat_getLayers(t *testing.T) {
+	schema2List := mockManifestList(manifestlist.MediaTypeManifestList, schema2.MediaTypeManifest)
+	ociIndex := mockManifestList(v1.MediaTypeImageIndex, v1.MediaTypeImageManifest)
+
+	tests := []struct {
+		name         string
+		registry     func(t *testing.T) *httptest.Server
+		platforms    []types.PreheatPlatform
+		allPlatforms bool
+		expect       func(t *testing.T, url string, layers []*internaljob.PreheatRequest, manifests []PreheatManifest, err error)
+	}{
+		{
+			name: "single manifest",
+			registry: func(t *testing.T) *httptest.Server {
+				return mockRegistry(t, schema2.MediaTypeManifest, mockImageManifest(schema2.MediaTypeManifest, schema2.MediaTypeImageConfig, schema2.MediaTypeLayer, mockAMD64LayerDigest),
+					schema2.MediaTypeManifest, schema2.MediaTypeImageConfig, schema2.MediaTypeLayer)
+			},
+			expect: func(t *testing.T, url string, layers []*internaljob.PreheatRequest, manifests []PreheatManifest, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(layerURLs(layers), []string{
+					fmt.Sprintf("%s/v2/library/nginx/blobs/%s", url, mockConfigDigest),
+					fmt.Sprintf("%s/v2/library/nginx/blobs/%s", url, mockAMD64LayerDigest),
+				})
+				assert.Equal(manifests, []PreheatManifest{{MediaType: schema2.MediaTypeManifest, Layers: 2}})
+			},
+		},
+		{
+			name: "manifest list with default platform",
+			registry: func(t *testing.T) *httptest.Server {
+				return mockRegistry(t, manifestlist.MediaTypeManifestList, schema2List, schema2.MediaTypeManifest, schema2.MediaTypeImageConfig, schema2.MediaTypeLayer)
+			},
+			expect: func(t *testing.T, url string, layers []*internaljob.PreheatRequest, manifests []PreheatManifest, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(layerURLs(layers), []string{
+					fmt.Sprintf("%s/v2/library/nginx/blobs/%s", url, mockConfigDigest),
+					fmt.Sprintf("%s/v2/library/nginx/blobs/%s", url, mockSharedLayerDigest),
+					fmt.Sprintf("%s/v2/library/nginx/blobs/%s", url, mockAMD64LayerDigest),
+				})
+				assert.Equal(manifests, []PreheatManifest{{
+					Digest:    mockAMD64ManifestDigest,
+					MediaType: schema2.MediaTypeManifest,
+					Platform:  "linux/amd64",
+					Layers:    3,
+				}})
+			},
+		},
+		{
+			name: "manifest list with all platforms",
+			registry: func(t *testing.T) *httptest.Server {
+				return mockRegistry(t, manifestlist.MediaTypeManifestList, schema2List, schema2.MediaTypeManifest, schema2.MediaTypeImageConfig, schema2.MediaTypeLayer)
+			},
+			platforms:    []types.PreheatPlatform{{OS: "linux", Architecture: "amd64"}},
+			allPlatforms: true,
+			expect: func(t *testing.T, url string, layers []*internaljob.PreheatRequest, manifests []PreheatManifest, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				// Shared config and layer are preheated once.
+				assert.Len(layers, 5)
+				assert.Len(manifests, 3)
+				assert.Equal(manifests[1].Platform, "linux/arm64/v8")
+				assert.Equal(manifests[2].Platform, "linux/arm/v7")
+			},
+		},
+		{
+			name: "oci index with platform and variant",
+			registry: func(t *testing.T) *httptest.Server {
+				return mockRegistry(t, v1.MediaTypeImageIndex, ociIndex, v1.MediaTypeImageManifest, v1.MediaTypeImageConfig, v1.MediaTypeImageLayerGzip)
+			},
+			platforms: []types.PreheatPlatform{{OS: "linux", Architecture: "arm", Variant: "v7"}, {OS: "linux", Architecture: "arm64"}},
+			expect: func(t *testing.T, url string, layers []*internaljob.PreheatRequest, manifests []PreheatManifest, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(layerURLs(layers), []string{
+					fmt.Sprintf("%s/v2/library/nginx/blobs/%s", url, mockConfigDigest),
+					fmt.Sprintf("%s/v2/library/nginx/blobs/%s", url, mockSharedLayerDigest),
+					fmt.Sprintf("%s/v2/library/nginx/blobs/%s", url, mockARM64LayerDigest),
+					fmt.Sprintf("%s/v2/library/nginx/blobs/%s", url, mockARMv7LayerDigest),
+				})
+				assert.Equal(manifests, []PreheatManifest{
+					{Digest: mockARM64ManifestDigest, MediaType: v1.MediaTypeImageManifest, Platform: "linux/arm64/v8", Layers: 3},
+					{Digest: mockARMv7ManifestDigest, MediaType: v1.MediaTypeImageManifest, Platform: "linux/arm/v7", Layers: 3},
+				})
+			},
+		},
+		{
+			name: "oci index served with generic content type",
+			registry: func(t *testing.T) *httptest.Server {
+				return mockRegistry(t, "application/json", ociIndex, v1.MediaTypeImageManifest, v1.MediaTypeImageConfig, v1.MediaTypeImageLayerGzip)
+			},
+			expect: func(t *testing.T, url string, layers []*internaljob.PreheatRequest, manifests []PreheatManifest, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(manifests, []PreheatManifest{
+					{Digest: mockAMD64ManifestDigest, MediaType: v1.MediaTypeImageManifest, Platform: "linux/amd64", Layers: 3},
+				})
+			},
+		},
+		{
+			name: "platform does not match",
+			registry: func(t *testing.T) *httptest.Server {
+				return mockRegistry(t, manifestlist.MediaTypeManifestList, schema2List, schema2.MediaTypeManifest, schema2.MediaTypeImageConfig, schema2.MediaTypeLayer)
+			},
+			platforms: []types.PreheatPlatform{{OS: "windows", Architecture: "amd64"}, {OS: "linux", Architecture: "arm64", Variant: "v9"}},
+			expect: func(t *testing.T, url string, layers []*internaljob.PreheatRequest, manifests []PreheatManifest, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "no manifest matches the platforms")
+			},
+		},
+		{
+			name: "nested manifest list",
+			registry: func(t *testing.T) *httptest.Server {
+				return mockRegistry(t, manifestlist.MediaTypeManifestList, schema2List, manifestlist.MediaTypeManifestList, schema2.MediaTypeImageConfig, schema2.MediaTypeLayer)
+			},
+			expect: func(t *testing.T, url string, layers []*internaljob.PreheatRequest, manifests []PreheatManifest, err error) {
+				assert := assert.New(t)
+				assert.Error(err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := tc.registry(t)
+			defer server.Close()
+
+			url := fmt.Sprintf("%s/v2/library/nginx/manifests/latest", server.URL)
+			image, err := parseAccessURL(url)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p := &preheat{}
+			layers, manifests, err := p.getLayers(context.Background(), url, "", "", http.Header{}, image, tc.platforms, tc.allPlatforms)
+			tc.expect(t, server.URL, layers, manifests, err)
+		})
+	}
+}
+
+// layerURLs returns the urls of layers.
+func layerURLs(layers []*internaljob.PreheatRequest) []string {
+	var urls []string
+	for _, layer := range layers {
+		urls = append(urls, layer.URL)
+	}
+
+	return urls
+}
+
+func TestManifestMediaType(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		expect      string
+	}{
+		{
+			name:        "content type is manifest list with parameters",
+			contentType: manifestlist.MediaTypeManifestList + "; charset=utf-8",
+			expect:      manifestlist.MediaTypeManifestList,
+		},
+		{
+			name:        "media type in manifest",
+			contentType: "application/json",
+			body:        fmt.Sprintf(`{"mediaType":%q}`, v1.MediaTypeImageManifest),
+			expect:      v1.MediaTypeImageManifest,
+		},
+		{
+			name:        "oci index without media type",
+			contentType: "application/octet-stream",
+			body:        `{"schemaVersion":2,"manifests":[]}`,
+			expect:      v1.MediaTypeImageIndex,
+		},
+		{
+			name:        "oci manifest without media type",
+			contentType: "",
+			body:        `{"schemaVersion":2,"layers":[]}`,
+			expect:      v1.MediaTypeImageManifest,
+		},
+		{
+			name:        "unknown manifest",
+			contentType: "text/plain",
+			body:        `foo`,
+			expect:      schema2.MediaTypeManifest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, manifestMediaType(tc.contentType, []byte(tc.body)), tc.expect)
+		})
+	}
+}
+
+func TestMatchPlatforms(t *testing.T) {
+	tests := []struct {
+		name      string
+		platform  manifestlist.PlatformSpec
+		platforms []types.PreheatPlatform
+		expect    bool
+	}{
+		{
+			name:      "platforms are empty",
+			platform:  manifestlist.PlatformSpec{OS: "linux", Architecture: "amd64"},
+			platforms: nil,
+			expect:    false,
+		},
+		{
+			name:      "os and architecture match",
+			platform:  manifestlist.PlatformSpec{OS: "linux", Architecture: "arm64", Variant: "v8"},
+			platforms: []types.PreheatPlatform{{OS: "linux", Architecture: "arm64"}},
+			expect:    true,
+		},
+		{
+			name:      "variant matches",
+			platform:  manifestlist.PlatformSpec{OS: "linux", Architecture: "arm", Variant: "v7"},
+			platforms: []types.PreheatPlatform{{OS: "linux", Architecture: "arm", Variant: "v6"}, {OS: "linux", Architecture: "arm", Variant: "v7"}},
+			expect:    true,
+		},
+		{
+			name:      "variant does not match",
+			platform:  manifestlist.PlatformSpec{OS: "linux", Architecture: "arm", Variant: "v7"},
+			platforms: []types.PreheatPlatform{{OS: "linux", Architecture: "arm", Variant: "v6"}},
+			expect:    false,
+		},
+		{
+			name:      "os does not match",
+			platform:  manifestlist.PlatformSpec{OS: "windows", Architecture: "amd64"},
+			platforms: []types.PreheatPlatform{{OS: "linux", Architecture: "amd64"}},
+			expect:    false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, matchPlatforms(tc.platform, tc.platforms), tc.expect)
+		})
+	}
+}
+
+func TestPlatformString(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(platformString(manifestlist.PlatformSpec{OS: "linux", Architecture: "amd64"}), "linux/amd64")
+	assert.Equal(platformString(manifestlist.PlatformSpec{OS: "linux", Architecture: "arm", Variant: "v7"}), "linux/arm/v7")
+}
@@ -0,0 +1,88 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+//go:generate mockgen -destination mocks/task_mock.go -source task.go -package mocks
+
+package job
+
+import (
+	"context"
+	"time"
+
+	machineryv1tasks "github.com/RichardKnop/machinery/v1/tasks"
+
+	logger "d7y.io/dragonfly/v2/internal/dflog"
+	internaljob "d7y.io/dragonfly/v2/internal/job"
+	"d7y.io/dragonfly/v2/manager/model"
+	"d7y.io/dragonfly/v2/manager/types"
+)
+
+// Task is the jobs of tasks cached in the p2p network, the jobs are sent to
+// every scheduler because the task may be scheduled by any of them.
+type Task interface {
+	// CreateTaskJob creates the purge_task or get_task job.
+	CreateTaskJob(context.Context, string, []model.Scheduler, types.TaskArgs) (*internaljob.GroupJobState, error)
+}
+
+type task struct {
+	job *internaljob.Job
+}
+
+func newTask(job *internaljob.Job) Task {
+	return &task{
+		job: job,
+	}
+}
+
+func (t *task) CreateTaskJob(ctx context.Context, name string, schedulers []model.Scheduler, json types.TaskArgs) (*internaljob.GroupJobState, error) {
+	args, err := internaljob.MarshalRequest(&internaljob.TaskRequest{
+		TaskID:  json.TaskID,
+		URL:     json.URL,
+		Tag:     json.Tag,
+		Digest:  json.Digest,
+		Filter:  json.Filter,
+		Headers: json.Headers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var signatures []*machineryv1tasks.Signature
+	for _, queue := range getSchedulerQueues(schedulers) {
+		signatures = append(signatures, &machineryv1tasks.Signature{
+			Name:       name,
+			RoutingKey: queue.String(),
+			Args:       args,
+		})
+	}
+
+	group, err := machineryv1tasks.NewGroup(signatures...)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := t.job.Server.SendGroupWithContext(ctx, group, 0); err != nil {
+		logger.Errorf("create %s group job failed: %s", name, err.Error())
+		return nil, err
+	}
+
+	logger.Infof("create %s group job successfully, group uuid: %s, task id: %s, url: %s", name, group.GroupUUID, json.TaskID, json.URL)
+	return &internaljob.GroupJobState{
+		GroupUUID: group.GroupUUID,
+		State:     machineryv1tasks.StatePending,
+		CreatedAt: time.Now(),
+	}, nil
+}
@@ -27,6 +27,7 @@ import (
 	logger "d7y.io/dragonfly/v2/internal/dflog"
 	"d7y.io/dragonfly/v2/manager/cache"
 	"d7y.io/dragonfly/v2/manager/config"
+	"d7y.io/dragonfly/v2/manager/cronjob"
 	"d7y.io/dragonfly/v2/manager/database"
 	"d7y.io/dragonfly/v2/manager/job"
 	"d7y.io/dragonfly/v2/manager/metrics"
@@ -58,6 +59,12 @@ type Server struct {
 
 	// Metrics server
 	metricsServer *http.Server
+
+	// Cron job
+	cronJob cronjob.CronJob
+
+	// Async job
+	job *job.Job
 }
 
 func New(cfg *config.Config, d dfpath.Dfpath) (*Server, error) {
@@ -89,6 +96,7 @@ func New(cfg *config.Config, d dfpath.Dfpath) (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
+	s.job = job
 
 	// Initialize object storage
 	var objectStorage objectstorage.ObjectStorage
@@ -116,6 +124,9 @@ func New(cfg *config.Config, d dfpath.Dfpath) (*Server, error) {
 		Handler: router,
 	}
 
+	// Initialize cron job
+	s.cronJob = cronjob.New(db, restService)
+
 	// Initialize roles and check roles
 	err = rbac.InitRBAC(enforcer, router, db.DB)
 	if err != nil {
@@ -166,6 +177,10 @@ func (s *Server) Serve() error {
 		}()
 	}
 
+	// Started cron job
+	go s.cronJob.Serve()
+	logger.Info("started cron job")
+
 	// Generate GRPC listener
 	lis, _, err := rpc.ListenWithPortRange(s.config.Server.GRPC.Listen, s.config.Server.GRPC.PortRange.Start, s.config.Server.GRPC.PortRange.End)
 	if err != nil {
@@ -198,6 +213,10 @@ func (s *Server) Stop() {
 		logger.Info("metrics server closed under request")
 	}
 
+	// Stop cron job
+	s.cronJob.Stop()
+	logger.Info("cron job closed")
+
 	// Stop GRPC server
 	stopped := make(chan struct{})
 	go func() {
@@ -213,4 +232,10 @@ func (s *Server) Stop() {
 	case <-stopped:
 		t.Stop()
 	}
+
+	// Stop job
+	if err := s.job.Close(); err != nil {
+		logger.Errorf("job failed to stop: %+v", err)
+	}
+	logger.Info("job closed")
 }
@@ -0,0 +1,31 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+type CronJob struct {
+	Model
+	Name              string             `gorm:"column:name;type:varchar(256);index:uk_cron_job_name,unique;not null;comment:name" json:"name"`
+	BIO               string             `gorm:"column:bio;type:varchar(1024);comment:biography" json:"bio"`
+	Type              string             `gorm:"column:type;type:varchar(256);not null;comment:type" json:"type"`
+	Cron              string             `gorm:"column:cron;type:varchar(256);not null;comment:cron expression" json:"cron"`
+	Args              JSONMap            `gorm:"column:args;not null;comment:task request args" json:"args"`
+	Enable            bool               `gorm:"column:enable;not null;default:false;comment:enable cron job" json:"enable"`
+	UserID            uint               `gorm:"column:user_id;comment:user id" json:"user_id"`
+	User              User               `json:"-"`
+	SchedulerClusters []SchedulerCluster `gorm:"many2many:cron_job_scheduler_cluster;" json:"scheduler_clusters"`
+	Jobs              []Job              `json:"-"`
+}
@@ -28,4 +28,6 @@ type Job struct {
 	User              User               `json:"-"`
 	SeedPeerClusters  []SeedPeerCluster  `gorm:"many2many:job_seed_peer_cluster;" json:"seed_peer_clusters"`
 	SchedulerClusters []SchedulerCluster `gorm:"many2many:job_scheduler_cluster;" json:"scheduler_clusters"`
+	CronJobID         *uint              `gorm:"column:cron_job_id;comment:cron job id" json:"cron_job_id"`
+	CronJob           *CronJob           `json:"-"`
 }
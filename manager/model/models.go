@@ -82,6 +82,9 @@ func (m *JSONMap) Scan(val any) error {
 func (a *Array) Scan(val any) error {
 	var ba []byte
 	switch v := val.(type) {
+	case nil:
+		*a = nil
+		return nil
 	case []byte:
 		ba = v
 	case string:
@@ -31,4 +31,5 @@ type SchedulerCluster struct {
 	SecurityGroupID  uint              `gorm:"comment:security group id" json:"security_group_id"`
 	SecurityGroup    SecurityGroup     `json:"-"`
 	Jobs             []Job             `gorm:"many2many:job_scheduler_cluster;" json:"jobs"`
+	CordonedHostIDs  Array             `gorm:"column:cordoned_host_ids;comment:cordoned host ids" json:"cordoned_host_ids"`
 }
@@ -0,0 +1,27 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+// SchedulerClusterFederation permits the schedulers of scheduler cluster
+// to find the peers of tasks in the federated scheduler cluster.
+type SchedulerClusterFederation struct {
+	Model
+	SchedulerClusterID          uint             `gorm:"index:uk_scheduler_cluster_federation,unique;not null;comment:scheduler cluster id" json:"scheduler_cluster_id"`
+	FederatedSchedulerClusterID uint             `gorm:"index:uk_scheduler_cluster_federation,unique;not null;comment:federated scheduler cluster id" json:"federated_scheduler_cluster_id"`
+	FederatedSchedulerCluster   SchedulerCluster `json:"-"`
+	Cost                        uint             `gorm:"column:cost;not null;default:0;comment:cost of traffic from federated scheduler cluster" json:"cost"`
+}
@@ -142,6 +142,9 @@ func Init(cfg *config.Config, logDir string, service service.Service, enforcer *
 	sc.GET(":id", h.GetSchedulerCluster)
 	sc.GET("", h.GetSchedulerClusters)
 	sc.PUT(":id/schedulers/:scheduler_id", h.AddSchedulerToSchedulerCluster)
+	sc.GET(":id/federated-scheduler-clusters", h.GetFederatedSchedulerClusters)
+	sc.PUT(":id/federated-scheduler-clusters/:federated_scheduler_cluster_id", h.AddFederatedSchedulerClusterToSchedulerCluster)
+	sc.DELETE(":id/federated-scheduler-clusters/:federated_scheduler_cluster_id", h.DeleteFederatedSchedulerClusterToSchedulerCluster)
 
 	// Scheduler
 	s := apiv1.Group("/schedulers", jwt.MiddlewareFunc(), rbac)
@@ -223,6 +226,28 @@ func Init(cfg *config.Config, logDir string, service service.Service, enforcer *
 	job.PATCH(":id", h.UpdateJob)
 	job.GET(":id", h.GetJob)
 	job.GET("", h.GetJobs)
+	job.POST(":id/cancel", h.CancelJob)
+
+	// Cron Job
+	cj := apiv1.Group("/cron-jobs")
+	cj.POST("", h.CreateCronJob)
+	cj.DELETE(":id", h.DestroyCronJob)
+	cj.PATCH(":id", h.UpdateCronJob)
+	cj.GET(":id", h.GetCronJob)
+	cj.GET("", h.GetCronJobs)
+	cj.POST(":id/trigger", h.TriggerCronJob)
+
+	// Webhook Rule
+	wr := apiv1.Group("/webhook-rules", jwt.MiddlewareFunc(), rbac)
+	wr.POST("", h.CreateWebhookRule)
+	wr.DELETE(":id", h.DestroyWebhookRule)
+	wr.PATCH(":id", h.UpdateWebhookRule)
+	wr.GET(":id", h.GetWebhookRule)
+	wr.GET("", h.GetWebhookRules)
+
+	// Webhook is authenticated by the secret of webhook rule.
+	wh := apiv1.Group("/webhooks")
+	wh.POST("registry", h.CreateRegistryWebhookJobs)
 
 	// Compatible with the V1 preheat.
 	pv1 := r.Group("/preheats")
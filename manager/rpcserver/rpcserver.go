@@ -296,6 +296,8 @@ func (s *Server) GetScheduler(ctx context.Context, req *manager.GetSchedulerRequ
 	scheduler := model.Scheduler{}
 	if err := s.db.WithContext(ctx).Preload("SchedulerCluster").Preload("SchedulerCluster.SeedPeerClusters.SeedPeers", &model.SeedPeer{
 		State: model.SeedPeerStateActive,
+	}).Preload("SchedulerCluster.Schedulers", &model.Scheduler{
+		State: model.SchedulerStateActive,
 	}).First(&scheduler, &model.Scheduler{
 		HostName:           req.HostName,
 		SchedulerClusterID: uint(req.SchedulerClusterId),
@@ -347,6 +349,57 @@ func (s *Server) GetScheduler(ctx context.Context, req *manager.GetSchedulerRequ
 		}
 	}
 
+	// Construct active schedulers of the cluster.
+	var pbSchedulers []*manager.Scheduler
+	for _, s := range scheduler.SchedulerCluster.Schedulers {
+		pbSchedulers = append(pbSchedulers, &manager.Scheduler{
+			Id:                 uint64(s.ID),
+			HostName:           s.HostName,
+			Idc:                s.IDC,
+			NetTopology:        s.NetTopology,
+			Location:           s.Location,
+			Ip:                 s.IP,
+			Port:               s.Port,
+			State:              s.State,
+			SchedulerClusterId: uint64(s.SchedulerClusterID),
+		})
+	}
+
+	// Construct federated scheduler clusters with their active schedulers.
+	var federations []model.SchedulerClusterFederation
+	if err := s.db.WithContext(ctx).Preload("FederatedSchedulerCluster.Schedulers", &model.Scheduler{
+		State: model.SchedulerStateActive,
+	}).Find(&federations, &model.SchedulerClusterFederation{
+		SchedulerClusterID: scheduler.SchedulerClusterID,
+	}).Error; err != nil {
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	var pbFederatedSchedulerClusters []*manager.FederatedSchedulerCluster
+	for _, federation := range federations {
+		var pbFederatedSchedulers []*manager.Scheduler
+		for _, s := range federation.FederatedSchedulerCluster.Schedulers {
+			pbFederatedSchedulers = append(pbFederatedSchedulers, &manager.Scheduler{
+				Id:                 uint64(s.ID),
+				HostName:           s.HostName,
+				Idc:                s.IDC,
+				NetTopology:        s.NetTopology,
+				Location:           s.Location,
+				Ip:                 s.IP,
+				Port:               s.Port,
+				State:              s.State,
+				SchedulerClusterId: uint64(s.SchedulerClusterID),
+			})
+		}
+
+		pbFederatedSchedulerClusters = append(pbFederatedSchedulerClusters, &manager.FederatedSchedulerCluster{
+			Id:         uint64(federation.FederatedSchedulerCluster.ID),
+			Name:       federation.FederatedSchedulerCluster.Name,
+			Cost:       uint64(federation.Cost),
+			Schedulers: pbFederatedSchedulers,
+		})
+	}
+
 	// Construct scheduler.
 	pbScheduler = manager.Scheduler{
 		Id:                 uint64(scheduler.ID),
@@ -359,13 +412,16 @@ func (s *Server) GetScheduler(ctx context.Context, req *manager.GetSchedulerRequ
 		State:              scheduler.State,
 		SchedulerClusterId: uint64(scheduler.SchedulerClusterID),
 		SchedulerCluster: &manager.SchedulerCluster{
-			Id:           uint64(scheduler.SchedulerCluster.ID),
-			Name:         scheduler.SchedulerCluster.Name,
-			Bio:          scheduler.SchedulerCluster.BIO,
-			Config:       schedulerClusterConfig,
-			ClientConfig: schedulerClusterClientConfig,
+			Id:              uint64(scheduler.SchedulerCluster.ID),
+			Name:            scheduler.SchedulerCluster.Name,
+			Bio:             scheduler.SchedulerCluster.BIO,
+			Config:          schedulerClusterConfig,
+			ClientConfig:    schedulerClusterClientConfig,
+			CordonedHostIds: scheduler.SchedulerCluster.CordonedHostIDs,
 		},
-		SeedPeers: pbSeedPeers,
+		SeedPeers:                  pbSeedPeers,
+		Schedulers:                 pbSchedulers,
+		FederatedSchedulerClusters: pbFederatedSchedulerClusters,
 	}
 
 	// Cache data.
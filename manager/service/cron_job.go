@@ -0,0 +1,198 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+
+	internaljob "d7y.io/dragonfly/v2/internal/job"
+	"d7y.io/dragonfly/v2/manager/model"
+	"d7y.io/dragonfly/v2/manager/types"
+	"d7y.io/dragonfly/v2/pkg/structure"
+)
+
+func (s *service) CreateCronJob(ctx context.Context, json types.CreateCronJobRequest) (*model.CronJob, error) {
+	if _, err := cron.ParseStandard(json.Cron); err != nil {
+		return nil, fmt.Errorf("invalid cron expression: %w", err)
+	}
+
+	args, err := structure.StructToMap(json.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedulerClusters []model.SchedulerCluster
+	if len(json.SchedulerClusterIDs) != 0 {
+		if err := s.db.WithContext(ctx).Find(&schedulerClusters, json.SchedulerClusterIDs).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	cronJob := model.CronJob{
+		Name:              json.Name,
+		BIO:               json.BIO,
+		Type:              json.Type,
+		Cron:              json.Cron,
+		Args:              args,
+		Enable:            json.Enable,
+		UserID:            json.UserID,
+		SchedulerClusters: schedulerClusters,
+	}
+
+	if err := s.db.WithContext(ctx).Create(&cronJob).Error; err != nil {
+		return nil, err
+	}
+
+	return &cronJob, nil
+}
+
+func (s *service) DestroyCronJob(ctx context.Context, id uint) error {
+	cronJob := model.CronJob{}
+	if err := s.db.WithContext(ctx).First(&cronJob, id).Error; err != nil {
+		return err
+	}
+
+	if err := s.db.WithContext(ctx).Delete(&model.CronJob{}, id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) UpdateCronJob(ctx context.Context, id uint, json types.UpdateCronJobRequest) (*model.CronJob, error) {
+	if json.Cron != "" {
+		if _, err := cron.ParseStandard(json.Cron); err != nil {
+			return nil, fmt.Errorf("invalid cron expression: %w", err)
+		}
+	}
+
+	values := map[string]any{}
+	if json.BIO != "" {
+		values["bio"] = json.BIO
+	}
+
+	if json.Cron != "" {
+		values["cron"] = json.Cron
+	}
+
+	if json.Args != nil {
+		args, err := structure.StructToMap(json.Args)
+		if err != nil {
+			return nil, err
+		}
+		values["args"] = model.JSONMap(args)
+	}
+
+	// Enable is updated by map because the zero value is ignored by updating with struct.
+	if json.Enable != nil {
+		values["enable"] = *json.Enable
+	}
+
+	if json.UserID > 0 {
+		values["user_id"] = json.UserID
+	}
+
+	cronJob := model.CronJob{}
+	if err := s.db.WithContext(ctx).First(&cronJob, id).Error; err != nil {
+		return nil, err
+	}
+
+	// The loaded cron job is returned when the request has no fields to update.
+	if len(values) != 0 {
+		if err := s.db.WithContext(ctx).Model(&cronJob).Updates(values).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if len(json.SchedulerClusterIDs) != 0 {
+		var schedulerClusters []model.SchedulerCluster
+		if err := s.db.WithContext(ctx).Find(&schedulerClusters, json.SchedulerClusterIDs).Error; err != nil {
+			return nil, err
+		}
+
+		if err := s.db.WithContext(ctx).Model(&cronJob).Association("SchedulerClusters").Replace(schedulerClusters); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetCronJob(ctx, id)
+}
+
+func (s *service) GetCronJob(ctx context.Context, id uint) (*model.CronJob, error) {
+	cronJob := model.CronJob{}
+	if err := s.db.WithContext(ctx).Preload("SchedulerClusters").First(&cronJob, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &cronJob, nil
+}
+
+func (s *service) GetCronJobs(ctx context.Context, q types.GetCronJobsQuery) ([]model.CronJob, int64, error) {
+	var count int64
+	var cronJobs []model.CronJob
+	if err := s.db.WithContext(ctx).Scopes(model.Paginate(q.Page, q.PerPage)).Where(&model.CronJob{
+		Name:   q.Name,
+		Type:   q.Type,
+		UserID: q.UserID,
+	}).Preload("SchedulerClusters").Find(&cronJobs).Limit(-1).Offset(-1).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return cronJobs, count, nil
+}
+
+// TriggerCronJob creates the job defined by cron job, the job is linked to
+// the cron job, it is called when the cron job is fired or by user manually.
+func (s *service) TriggerCronJob(ctx context.Context, id uint) (*model.Job, error) {
+	cronJob, err := s.GetCronJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cronJob.Type {
+	case internaljob.PreheatJob:
+		b, err := json.Marshal(cronJob.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		var args types.PreheatArgs
+		if err := json.Unmarshal(b, &args); err != nil {
+			return nil, err
+		}
+
+		var schedulerClusterIDs []uint
+		for _, schedulerCluster := range cronJob.SchedulerClusters {
+			schedulerClusterIDs = append(schedulerClusterIDs, schedulerCluster.ID)
+		}
+
+		return s.createPreheatJob(ctx, types.CreatePreheatJobRequest{
+			BIO:                 cronJob.BIO,
+			Type:                cronJob.Type,
+			Args:                args,
+			UserID:              cronJob.UserID,
+			SchedulerClusterIDs: schedulerClusterIDs,
+		}, &cronJob.ID)
+	default:
+		return nil, errors.New("unknow cron job type")
+	}
+}
@@ -0,0 +1,98 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"d7y.io/dragonfly/v2/manager/model"
+	"d7y.io/dragonfly/v2/manager/types"
+)
+
+var (
+	mockCronJobQuery   = "SELECT \\* FROM `cron_job` WHERE `cron_job`.`id` = \\?"
+	mockCronJobColumns = []string{"id", "name", "bio", "cron"}
+)
+
+func TestService_UpdateCronJob(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    types.UpdateCronJobRequest
+		mock   func(mockDB sqlmock.Sqlmock)
+		expect func(t *testing.T, cronJob *model.CronJob, err error)
+	}{
+		{
+			name: "update cron job",
+			req:  types.UpdateCronJobRequest{BIO: "bar"},
+			mock: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(mockCronJobQuery).WillReturnRows(sqlmock.NewRows(mockCronJobColumns).AddRow(1, "foo", "", "@daily"))
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("UPDATE `cron_job` SET").WillReturnResult(sqlmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+				mockDB.ExpectQuery(mockCronJobQuery).WillReturnRows(sqlmock.NewRows(mockCronJobColumns).AddRow(1, "foo", "bar", "@daily"))
+				mockDB.ExpectQuery("SELECT \\* FROM `cron_job_scheduler_cluster`").WillReturnRows(sqlmock.NewRows([]string{"cron_job_id", "scheduler_cluster_id"}))
+			},
+			expect: func(t *testing.T, cronJob *model.CronJob, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(cronJob.BIO, "bar")
+			},
+		},
+		{
+			name: "request has no fields to update",
+			req:  types.UpdateCronJobRequest{},
+			mock: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(mockCronJobQuery).WillReturnRows(sqlmock.NewRows(mockCronJobColumns).AddRow(1, "foo", "", "@daily"))
+				mockDB.ExpectQuery(mockCronJobQuery).WillReturnRows(sqlmock.NewRows(mockCronJobColumns).AddRow(1, "foo", "", "@daily"))
+				mockDB.ExpectQuery("SELECT \\* FROM `cron_job_scheduler_cluster`").WillReturnRows(sqlmock.NewRows([]string{"cron_job_id", "scheduler_cluster_id"}))
+			},
+			expect: func(t *testing.T, cronJob *model.CronJob, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(cronJob.Name, "foo")
+			},
+		},
+		{
+			name: "cron job not found",
+			req:  types.UpdateCronJobRequest{BIO: "bar"},
+			mock: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(mockCronJobQuery).WillReturnRows(sqlmock.NewRows(mockCronJobColumns))
+			},
+			expect: func(t *testing.T, cronJob *model.CronJob, err error) {
+				assert := assert.New(t)
+				assert.Error(err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, mockDB, _ := newMockService(t)
+			tc.mock(mockDB)
+			cronJob, err := s.UpdateCronJob(context.Background(), 1, tc.req)
+			tc.expect(t, cronJob, err)
+
+			if err := mockDB.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
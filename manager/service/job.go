@@ -18,18 +18,39 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	machineryv1tasks "github.com/RichardKnop/machinery/v1/tasks"
 
 	logger "d7y.io/dragonfly/v2/internal/dflog"
+	internaljob "d7y.io/dragonfly/v2/internal/job"
+	"d7y.io/dragonfly/v2/manager/cache"
 	"d7y.io/dragonfly/v2/manager/model"
 	"d7y.io/dragonfly/v2/manager/types"
 	"d7y.io/dragonfly/v2/pkg/retry"
 	"d7y.io/dragonfly/v2/pkg/structure"
 )
 
+const (
+	// peerStateSucceeded is the state of peer which has downloaded the task in scheduler.
+	peerStateSucceeded = "Succeeded"
+
+	// hostTypeNormalName is the type name of normal host in scheduler.
+	hostTypeNormalName = "normal"
+)
+
+// ErrJobFinished is returned when the job to be canceled has been finished.
+var ErrJobFinished = errors.New("job has been finished")
+
 func (s *service) CreatePreheatJob(ctx context.Context, json types.CreatePreheatJobRequest) (*model.Job, error) {
+	return s.createPreheatJob(ctx, json, nil)
+}
+
+// createPreheatJob creates the preheat job, cronJobID is the id of
+// the cron job firing the preheat job, it is nil when created by user.
+func (s *service) createPreheatJob(ctx context.Context, json types.CreatePreheatJobRequest, cronJobID *uint) (*model.Job, error) {
 	var schedulers []model.Scheduler
 	var schedulerClusters []model.SchedulerCluster
 
@@ -68,7 +89,57 @@ func (s *service) CreatePreheatJob(ctx context.Context, json types.CreatePreheat
 		}
 	}
 
-	groupJobState, err := s.job.CreatePreheat(ctx, schedulers, json.Args)
+	groupJobState, preheatResult, err := s.job.CreatePreheat(ctx, schedulers, json.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := structure.StructToMap(json.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := structure.StructToMap(preheatResult)
+	if err != nil {
+		return nil, err
+	}
+
+	job := model.Job{
+		TaskID:            groupJobState.GroupUUID,
+		BIO:               json.BIO,
+		Type:              json.Type,
+		State:             groupJobState.State,
+		Args:              args,
+		Result:            result,
+		UserID:            json.UserID,
+		SchedulerClusters: schedulerClusters,
+		CronJobID:         cronJobID,
+	}
+
+	if err := s.db.WithContext(ctx).Create(&job).Error; err != nil {
+		return nil, err
+	}
+
+	go s.pollingJob(context.Background(), job.ID, job.TaskID)
+
+	return &job, nil
+}
+
+// CreateHostJob creates the cordon_host, uncordon_host or drain_host job, the job is sent to
+// all active schedulers in the clusters because the host may be scheduled by any of them.
+func (s *service) CreateHostJob(ctx context.Context, json types.CreateHostJobRequest) (*model.Job, error) {
+	schedulerClusters, schedulers, err := s.findActiveSchedulers(ctx, json.SchedulerClusterIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cordoned hosts are stored in scheduler clusters and sent to schedulers by dynconfig,
+	// so the host is still cordoned after the scheduler restarts.
+	if err := s.updateCordonedHosts(ctx, schedulerClusters, schedulers, json.Type, json.Args.HostID); err != nil {
+		return nil, err
+	}
+
+	groupJobState, err := s.job.CreateHostJob(ctx, json.Type, schedulers, json.Args)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +168,110 @@ func (s *service) CreatePreheatJob(ctx context.Context, json types.CreatePreheat
 	return &job, nil
 }
 
+// updateCordonedHosts adds the host to cordoned hosts of scheduler clusters for
+// cordon_host and drain_host jobs, and removes it for uncordon_host job.
+func (s *service) updateCordonedHosts(ctx context.Context, schedulerClusters []model.SchedulerCluster, schedulers []model.Scheduler, jobType, hostID string) error {
+	for i := range schedulerClusters {
+		schedulerCluster := &schedulerClusters[i]
+
+		var cordonedHostIDs model.Array
+		for _, cordonedHostID := range schedulerCluster.CordonedHostIDs {
+			if cordonedHostID != hostID {
+				cordonedHostIDs = append(cordonedHostIDs, cordonedHostID)
+			}
+		}
+
+		if jobType != internaljob.UncordonHostJob {
+			cordonedHostIDs = append(cordonedHostIDs, hostID)
+		}
+
+		if err := s.db.WithContext(ctx).Model(schedulerCluster).Update("cordoned_host_ids", cordonedHostIDs).Error; err != nil {
+			return err
+		}
+	}
+
+	// Refresh the cached dynconfig of schedulers.
+	for _, scheduler := range schedulers {
+		if err := s.cache.Delete(ctx, cache.MakeSchedulerCacheKey(scheduler.HostName, scheduler.SchedulerClusterID)); err != nil {
+			logger.Warnf("refresh scheduler %s cache in scheduler cluster %d failed: %v", scheduler.HostName, scheduler.SchedulerClusterID, err)
+		}
+	}
+
+	return nil
+}
+
+// CreateTaskJob creates the purge_task or get_task job, the job is sent to all active schedulers
+// in the clusters because the task may be scheduled by any of them, and the results of hosts
+// holding the task are reported as the progress of job.
+func (s *service) CreateTaskJob(ctx context.Context, json types.CreateTaskJobRequest) (*model.Job, error) {
+	schedulerClusters, schedulers, err := s.findActiveSchedulers(ctx, json.SchedulerClusterIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	groupJobState, err := s.job.CreateTaskJob(ctx, json.Type, schedulers, json.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := structure.StructToMap(json.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	job := model.Job{
+		TaskID:            groupJobState.GroupUUID,
+		BIO:               json.BIO,
+		Type:              json.Type,
+		State:             groupJobState.State,
+		Args:              args,
+		UserID:            json.UserID,
+		SchedulerClusters: schedulerClusters,
+	}
+
+	if err := s.db.WithContext(ctx).Create(&job).Error; err != nil {
+		return nil, err
+	}
+
+	go s.pollingJob(context.Background(), job.ID, job.TaskID)
+
+	return &job, nil
+}
+
+// findActiveSchedulers returns the scheduler clusters and all of their active schedulers,
+// all scheduler clusters are returned when schedulerClusterIDs is empty.
+func (s *service) findActiveSchedulers(ctx context.Context, schedulerClusterIDs []uint) ([]model.SchedulerCluster, []model.Scheduler, error) {
+	var schedulerClusters []model.SchedulerCluster
+	if len(schedulerClusterIDs) != 0 {
+		if err := s.db.WithContext(ctx).Find(&schedulerClusters, schedulerClusterIDs).Error; err != nil {
+			return nil, nil, err
+		}
+	} else {
+		if err := s.db.WithContext(ctx).Find(&schedulerClusters).Error; err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var schedulers []model.Scheduler
+	for _, schedulerCluster := range schedulerClusters {
+		var clusterSchedulers []model.Scheduler
+		if err := s.db.WithContext(ctx).Find(&clusterSchedulers, model.Scheduler{
+			SchedulerClusterID: schedulerCluster.ID,
+			State:              model.SchedulerStateActive,
+		}).Error; err != nil {
+			return nil, nil, err
+		}
+
+		schedulers = append(schedulers, clusterSchedulers...)
+	}
+
+	if len(schedulers) == 0 {
+		return nil, nil, errors.New("active schedulers not found")
+	}
+
+	return schedulerClusters, schedulers, nil
+}
+
 func (s *service) pollingJob(ctx context.Context, id uint, taskID string) {
 	var job model.Job
 
@@ -107,13 +282,58 @@ func (s *service) pollingJob(ctx context.Context, id uint, taskID string) {
 			return nil, false, err
 		}
 
-		if err := s.db.WithContext(ctx).First(&job, id).Updates(model.Job{
-			State: groupJob.State,
-		}).Error; err != nil {
-			logger.Errorf("polling job %d and task %s store failed: %v", id, taskID, err)
+		if err := s.db.WithContext(ctx).First(&job, id).Error; err != nil {
+			logger.Errorf("polling job %d and task %s load failed: %v", id, taskID, err)
 			return nil, true, err
 		}
 
+		// Job has been canceled, stop polling.
+		if job.State == internaljob.StateCanceled {
+			logger.Infof("polling job %d and task %s is canceled", id, taskID)
+			return nil, true, nil
+		}
+
+		// Keep the result recorded by creating job, such as the enqueued
+		// files of preheat job, and merge the progress into it.
+		result := job.Result
+		progress, err := s.job.GetGroupJobProgress(ctx, taskID)
+		if err != nil {
+			logger.Warnf("polling job %d and task %s progress failed: %v", id, taskID, err)
+		} else if len(progress) > 0 {
+			result = model.JSONMap{}
+			for k, v := range job.Result {
+				result[k] = v
+			}
+			result["progress"] = progress
+
+			// Peers of task reported by schedulers are summarized by idc and location.
+			if job.Type == internaljob.GetTaskJob {
+				summary, err := summarizeTaskProgress(progress)
+				if err != nil {
+					logger.Warnf("polling job %d and task %s summary failed: %v", id, taskID, err)
+				} else {
+					result["summary"] = summary
+				}
+			}
+		}
+
+		// Job may be canceled after it is loaded, the canceled state is never overwritten.
+		tx := s.db.WithContext(ctx).Model(&job).Where("state <> ?", internaljob.StateCanceled).Updates(model.Job{
+			State:  groupJob.State,
+			Result: result,
+		})
+		if tx.Error != nil {
+			logger.Errorf("polling job %d and task %s store failed: %v", id, taskID, tx.Error)
+			return nil, true, tx.Error
+		}
+
+		if tx.RowsAffected == 0 {
+			logger.Infof("polling job %d and task %s is canceled", id, taskID)
+			job.State = internaljob.StateCanceled
+			return nil, true, nil
+		}
+
+		job.State = groupJob.State
 		switch job.State {
 		case machineryv1tasks.StateSuccess:
 			logger.Infof("polling job %d and task %s is finally successful", id, taskID)
@@ -129,17 +349,135 @@ func (s *service) pollingJob(ctx context.Context, id uint, taskID string) {
 	}
 
 	// Polling timeout and failed
-	if job.State != machineryv1tasks.StateSuccess && job.State != machineryv1tasks.StateFailure {
-		job := model.Job{}
-		if err := s.db.WithContext(ctx).First(&job, id).Updates(model.Job{
+	if job.State != machineryv1tasks.StateSuccess && job.State != machineryv1tasks.StateFailure && job.State != internaljob.StateCanceled {
+		tx := s.db.WithContext(ctx).Model(&model.Job{}).Where("id = ? AND state <> ?", id, internaljob.StateCanceled).Updates(model.Job{
 			State: machineryv1tasks.StateFailure,
-		}).Error; err != nil {
-			logger.Errorf("polling job %d and task %s store failed: %v", id, taskID, err)
+		})
+		if tx.Error != nil {
+			logger.Errorf("polling job %d and task %s store failed: %v", id, taskID, tx.Error)
+			return
+		}
+
+		if tx.RowsAffected == 0 {
+			logger.Infof("polling job %d and task %s is canceled", id, taskID)
+			return
 		}
 		logger.Errorf("polling job %d and task %s timeout", id, taskID)
 	}
 }
 
+// summarizeTaskProgress counts the peers of task reported by
+// get_task jobs of schedulers, grouped by idc and location.
+func summarizeTaskProgress(progress map[string]any) (*types.TaskSummary, error) {
+	b, err := json.Marshal(progress)
+	if err != nil {
+		return nil, err
+	}
+
+	var taskProgresses map[string]internaljob.GetTaskProgress
+	if err := json.Unmarshal(b, &taskProgresses); err != nil {
+		return nil, err
+	}
+
+	// The same peer may be reported by multiple schedulers,
+	// so peers are deduplicated by id and the most advanced one is kept.
+	peers := map[string]internaljob.GetTaskPeer{}
+	for _, taskProgress := range taskProgresses {
+		for _, peer := range taskProgress.Peers {
+			if reported, ok := peers[peer.ID]; ok && !isPeerMoreAdvanced(peer, reported) {
+				continue
+			}
+
+			peers[peer.ID] = peer
+		}
+	}
+
+	summary := &types.TaskSummary{
+		IDCs:      map[string]*types.TaskPeerCount{},
+		Locations: map[string]*types.TaskPeerCount{},
+	}
+	for _, peer := range peers {
+		succeeded := peer.State == peerStateSucceeded
+		summary.PeerCount++
+		if succeeded {
+			summary.SucceededPeerCount++
+		}
+
+		if peer.HostType != hostTypeNormalName {
+			summary.SeedPeerCount++
+		}
+
+		countTaskPeer(summary.IDCs, peer.IDC, succeeded)
+		countTaskPeer(summary.Locations, peer.Location, succeeded)
+	}
+
+	return summary, nil
+}
+
+// isPeerMoreAdvanced determines whether the peer is more advanced than the reported one,
+// succeeded peer is the most advanced, otherwise the peer with more finished pieces is.
+func isPeerMoreAdvanced(peer, reported internaljob.GetTaskPeer) bool {
+	succeeded := peer.State == peerStateSucceeded
+	reportedSucceeded := reported.State == peerStateSucceeded
+	if succeeded != reportedSucceeded {
+		return succeeded
+	}
+
+	return peer.FinishedPieceCount > reported.FinishedPieceCount
+}
+
+// countTaskPeer counts the peer by key, the peer with empty key is ignored.
+func countTaskPeer(counts map[string]*types.TaskPeerCount, key string, succeeded bool) {
+	if key == "" {
+		return
+	}
+
+	count, ok := counts[key]
+	if !ok {
+		count = &types.TaskPeerCount{}
+		counts[key] = count
+	}
+
+	count.PeerCount++
+	if succeeded {
+		count.SucceededPeerCount++
+	}
+}
+
+func (s *service) CancelJob(ctx context.Context, id uint) (*model.Job, error) {
+	job := model.Job{}
+	if err := s.db.WithContext(ctx).First(&job, id).Error; err != nil {
+		return nil, err
+	}
+
+	switch job.State {
+	case machineryv1tasks.StateSuccess, machineryv1tasks.StateFailure, internaljob.StateCanceled:
+		return nil, ErrJobFinished
+	}
+
+	if err := s.job.CancelGroupJob(ctx, job.TaskID); err != nil {
+		return nil, err
+	}
+
+	// Job may be finished by polling after it is loaded, the finished state is never overwritten.
+	tx := s.db.WithContext(ctx).Model(&job).Where("state NOT IN ?", []string{machineryv1tasks.StateSuccess, machineryv1tasks.StateFailure}).Updates(model.Job{
+		State: internaljob.StateCanceled,
+	})
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return nil, ErrJobFinished
+	}
+
+	if err := s.db.WithContext(ctx).Preload("SeedPeerClusters").Preload("SchedulerClusters").First(&job, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
 func (s *service) DestroyJob(ctx context.Context, id uint) error {
 	job := model.Job{}
 	if err := s.db.WithContext(ctx).First(&job, id).Error; err != nil {
@@ -175,12 +513,18 @@ func (s *service) GetJob(ctx context.Context, id uint) (*model.Job, error) {
 }
 
 func (s *service) GetJobs(ctx context.Context, q types.GetJobsQuery) ([]model.Job, int64, error) {
+	var cronJobID *uint
+	if q.CronJobID > 0 {
+		cronJobID = &q.CronJobID
+	}
+
 	var count int64
 	var jobs []model.Job
 	if err := s.db.WithContext(ctx).Scopes(model.Paginate(q.Page, q.PerPage)).Where(&model.Job{
-		Type:   q.Type,
-		State:  q.State,
-		UserID: q.UserID,
+		Type:      q.Type,
+		State:     q.State,
+		UserID:    q.UserID,
+		CronJobID: cronJobID,
 	}).Find(&jobs).Limit(-1).Offset(-1).Count(&count).Error; err != nil {
 		return nil, 0, err
 	}
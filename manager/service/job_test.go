@@ -0,0 +1,365 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	machineryv1tasks "github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	internaljob "d7y.io/dragonfly/v2/internal/job"
+	"d7y.io/dragonfly/v2/manager/job"
+	"d7y.io/dragonfly/v2/manager/model"
+	"d7y.io/dragonfly/v2/manager/types"
+)
+
+var (
+	mockJobQuery        = "SELECT \\* FROM `job` WHERE `job`.`id` = \\? AND `job`.`is_del` = \\?"
+	mockCancelJobUpdate = "UPDATE `job` SET .*`state`=\\? WHERE state NOT IN \\(\\?,\\?\\)"
+	mockJobColumns      = []string{"id", "task_id", "type", "state"}
+)
+
+// newMockService returns the service with database and job mocks.
+func newMockService(t *testing.T) (*service, sqlmock.Sqlmock, *miniredis.Miniredis) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(mr.Close)
+
+	port, err := strconv.Atoi(mr.Port())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	j, err := internaljob.New(&internaljob.Config{Host: mr.Host(), Port: port}, internaljob.GlobalQueue)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sqlDB, mockDB, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{
+		NamingStrategy: schema.NamingStrategy{
+			SingularTable: true,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &service{db: db, job: &job.Job{Job: j}}, mockDB, mr
+}
+
+func TestService_CancelJob(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(mockDB sqlmock.Sqlmock)
+		expect func(t *testing.T, mr *miniredis.Miniredis, job *model.Job, err error)
+	}{
+		{
+			name: "cancel pending job",
+			mock: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(mockJobQuery).WillReturnRows(sqlmock.NewRows(mockJobColumns).AddRow(1, "foo", "preheat", machineryv1tasks.StatePending))
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(mockCancelJobUpdate).WithArgs(sqlmock.AnyArg(), internaljob.StateCanceled, machineryv1tasks.StateSuccess, machineryv1tasks.StateFailure, 0, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+				mockDB.ExpectQuery(mockJobQuery).WillReturnRows(sqlmock.NewRows(mockJobColumns).AddRow(1, "foo", "preheat", internaljob.StateCanceled))
+				mockDB.ExpectQuery("SELECT \\* FROM `job_scheduler_cluster`").WillReturnRows(sqlmock.NewRows([]string{"job_id", "scheduler_cluster_id"}))
+				mockDB.ExpectQuery("SELECT \\* FROM `job_seed_peer_cluster`").WillReturnRows(sqlmock.NewRows([]string{"job_id", "seed_peer_cluster_id"}))
+			},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, job *model.Job, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(job.State, internaljob.StateCanceled)
+				assert.True(mr.Exists("dragonfly:group_job:foo:canceled"))
+			},
+		},
+		{
+			name: "job has been finished",
+			mock: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(mockJobQuery).WillReturnRows(sqlmock.NewRows(mockJobColumns).AddRow(1, "foo", "preheat", machineryv1tasks.StateSuccess))
+			},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, job *model.Job, err error) {
+				assert := assert.New(t)
+				assert.ErrorIs(err, ErrJobFinished)
+				assert.False(mr.Exists("dragonfly:group_job:foo:canceled"))
+			},
+		},
+		{
+			name: "job has been canceled",
+			mock: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(mockJobQuery).WillReturnRows(sqlmock.NewRows(mockJobColumns).AddRow(1, "foo", "preheat", internaljob.StateCanceled))
+			},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, job *model.Job, err error) {
+				assert := assert.New(t)
+				assert.ErrorIs(err, ErrJobFinished)
+			},
+		},
+		{
+			name: "job is finished by polling after it is loaded",
+			mock: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(mockJobQuery).WillReturnRows(sqlmock.NewRows(mockJobColumns).AddRow(1, "foo", "preheat", machineryv1tasks.StatePending))
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(mockCancelJobUpdate).WillReturnResult(sqlmock.NewResult(0, 0))
+				mockDB.ExpectCommit()
+			},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, job *model.Job, err error) {
+				assert := assert.New(t)
+				assert.ErrorIs(err, ErrJobFinished)
+			},
+		},
+		{
+			name: "job not found",
+			mock: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(mockJobQuery).WillReturnRows(sqlmock.NewRows(mockJobColumns))
+			},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, job *model.Job, err error) {
+				assert := assert.New(t)
+				assert.ErrorIs(err, gorm.ErrRecordNotFound)
+			},
+		},
+		{
+			name: "update job failed",
+			mock: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(mockJobQuery).WillReturnRows(sqlmock.NewRows(mockJobColumns).AddRow(1, "foo", "preheat", machineryv1tasks.StateStarted))
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(mockCancelJobUpdate).WillReturnError(errors.New("foo"))
+				mockDB.ExpectRollback()
+			},
+			expect: func(t *testing.T, mr *miniredis.Miniredis, job *model.Job, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "foo")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, mockDB, mr := newMockService(t)
+			tc.mock(mockDB)
+			job, err := s.CancelJob(context.Background(), 1)
+			tc.expect(t, mr, job, err)
+
+			if err := mockDB.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestSummarizeTaskProgress(t *testing.T) {
+	tests := []struct {
+		name     string
+		progress map[string]internaljob.GetTaskProgress
+		expect   func(t *testing.T, summary *types.TaskSummary, err error)
+	}{
+		{
+			name:     "progress is empty",
+			progress: map[string]internaljob.GetTaskProgress{},
+			expect: func(t *testing.T, summary *types.TaskSummary, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(summary, &types.TaskSummary{
+					IDCs:      map[string]*types.TaskPeerCount{},
+					Locations: map[string]*types.TaskPeerCount{},
+				})
+			},
+		},
+		{
+			name: "task is not found",
+			progress: map[string]internaljob.GetTaskProgress{
+				"foo": {TaskID: "bar"},
+			},
+			expect: func(t *testing.T, summary *types.TaskSummary, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(summary.PeerCount, 0)
+				assert.Empty(summary.IDCs)
+				assert.Empty(summary.Locations)
+			},
+		},
+		{
+			name: "peers are reported by schedulers",
+			progress: map[string]internaljob.GetTaskProgress{
+				"foo": {
+					Found: true,
+					Peers: []internaljob.GetTaskPeer{
+						{ID: "foo", State: peerStateSucceeded, HostType: "super", IDC: "idc-1", Location: "area|city"},
+						{ID: "bar", State: "Running", HostType: hostTypeNormalName, IDC: "idc-2", Location: "area|city"},
+					},
+				},
+				"bar": {
+					Found: true,
+					Peers: []internaljob.GetTaskPeer{
+						{ID: "baz", State: peerStateSucceeded, HostType: hostTypeNormalName, IDC: "idc-1", Location: "area"},
+					},
+				},
+			},
+			expect: func(t *testing.T, summary *types.TaskSummary, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(summary, &types.TaskSummary{
+					PeerCount:          3,
+					SeedPeerCount:      1,
+					SucceededPeerCount: 2,
+					IDCs: map[string]*types.TaskPeerCount{
+						"idc-1": {PeerCount: 2, SucceededPeerCount: 2},
+						"idc-2": {PeerCount: 1, SucceededPeerCount: 0},
+					},
+					Locations: map[string]*types.TaskPeerCount{
+						"area|city": {PeerCount: 2, SucceededPeerCount: 1},
+						"area":      {PeerCount: 1, SucceededPeerCount: 1},
+					},
+				})
+			},
+		},
+		{
+			name: "same peer is reported by schedulers",
+			progress: map[string]internaljob.GetTaskProgress{
+				"foo": {
+					Found: true,
+					Peers: []internaljob.GetTaskPeer{
+						{ID: "foo", State: "Running", FinishedPieceCount: 1, HostType: hostTypeNormalName, IDC: "idc-1", Location: "area"},
+						{ID: "bar", State: "Running", FinishedPieceCount: 2, HostType: "super", IDC: "idc-2", Location: "area"},
+					},
+				},
+				"bar": {
+					Found: true,
+					Peers: []internaljob.GetTaskPeer{
+						{ID: "foo", State: peerStateSucceeded, FinishedPieceCount: 1, HostType: hostTypeNormalName, IDC: "idc-1", Location: "area"},
+						{ID: "bar", State: "Running", FinishedPieceCount: 1, HostType: "super", IDC: "idc-2", Location: "area"},
+					},
+				},
+			},
+			expect: func(t *testing.T, summary *types.TaskSummary, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(summary, &types.TaskSummary{
+					PeerCount:          2,
+					SeedPeerCount:      1,
+					SucceededPeerCount: 1,
+					IDCs: map[string]*types.TaskPeerCount{
+						"idc-1": {PeerCount: 1, SucceededPeerCount: 1},
+						"idc-2": {PeerCount: 1, SucceededPeerCount: 0},
+					},
+					Locations: map[string]*types.TaskPeerCount{
+						"area": {PeerCount: 2, SucceededPeerCount: 1},
+					},
+				})
+			},
+		},
+		{
+			name: "hosts have no idc and location",
+			progress: map[string]internaljob.GetTaskProgress{
+				"foo": {
+					Found: true,
+					Peers: []internaljob.GetTaskPeer{
+						{ID: "foo", State: peerStateSucceeded, HostType: hostTypeNormalName},
+						{ID: "bar", State: "Failed", HostType: hostTypeNormalName, IDC: "idc-1"},
+					},
+				},
+			},
+			expect: func(t *testing.T, summary *types.TaskSummary, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(summary, &types.TaskSummary{
+					PeerCount:          2,
+					SucceededPeerCount: 1,
+					IDCs: map[string]*types.TaskPeerCount{
+						"idc-1": {PeerCount: 1, SucceededPeerCount: 0},
+					},
+					Locations: map[string]*types.TaskPeerCount{},
+				})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Progress is stored in job backend by json.
+			b, err := json.Marshal(tc.progress)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var progress map[string]any
+			if err := json.Unmarshal(b, &progress); err != nil {
+				t.Fatal(err)
+			}
+
+			summary, err := summarizeTaskProgress(progress)
+			tc.expect(t, summary, err)
+		})
+	}
+}
+
+func TestCountTaskPeer(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		succeeded bool
+		counts    map[string]*types.TaskPeerCount
+		expect    map[string]*types.TaskPeerCount
+	}{
+		{
+			name:   "key is empty",
+			key:    "",
+			counts: map[string]*types.TaskPeerCount{},
+			expect: map[string]*types.TaskPeerCount{},
+		},
+		{
+			name:      "key is new",
+			key:       "foo",
+			succeeded: true,
+			counts:    map[string]*types.TaskPeerCount{},
+			expect: map[string]*types.TaskPeerCount{
+				"foo": {PeerCount: 1, SucceededPeerCount: 1},
+			},
+		},
+		{
+			name:   "key exists",
+			key:    "foo",
+			counts: map[string]*types.TaskPeerCount{"foo": {PeerCount: 1, SucceededPeerCount: 1}},
+			expect: map[string]*types.TaskPeerCount{
+				"foo": {PeerCount: 2, SucceededPeerCount: 1},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			countTaskPeer(tc.counts, tc.key, tc.succeeded)
+			assert.Equal(t, tc.counts, tc.expect)
+		})
+	}
+}
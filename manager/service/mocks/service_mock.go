@@ -39,6 +39,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AddFederatedSchedulerClusterToSchedulerCluster mocks base method.
+func (m *MockService) AddFederatedSchedulerClusterToSchedulerCluster(arg0 context.Context, arg1, arg2 uint, arg3 types.AddFederatedSchedulerClusterToSchedulerClusterRequest) (*model.SchedulerClusterFederation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFederatedSchedulerClusterToSchedulerCluster", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.SchedulerClusterFederation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFederatedSchedulerClusterToSchedulerCluster indicates an expected call of AddFederatedSchedulerClusterToSchedulerCluster.
+func (mr *MockServiceMockRecorder) AddFederatedSchedulerClusterToSchedulerCluster(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFederatedSchedulerClusterToSchedulerCluster", reflect.TypeOf((*MockService)(nil).AddFederatedSchedulerClusterToSchedulerCluster), arg0, arg1, arg2, arg3)
+}
+
 // AddPermissionForRole mocks base method.
 func (m *MockService) AddPermissionForRole(arg0 context.Context, arg1 string, arg2 types.AddPermissionForRoleRequest) (bool, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +196,21 @@ func (mr *MockServiceMockRecorder) AddSeedPeerToSeedPeerCluster(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSeedPeerToSeedPeerCluster", reflect.TypeOf((*MockService)(nil).AddSeedPeerToSeedPeerCluster), arg0, arg1, arg2)
 }
 
+// CancelJob mocks base method.
+func (m *MockService) CancelJob(arg0 context.Context, arg1 uint) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelJob", arg0, arg1)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelJob indicates an expected call of CancelJob.
+func (mr *MockServiceMockRecorder) CancelJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJob", reflect.TypeOf((*MockService)(nil).CancelJob), arg0, arg1)
+}
+
 // CreateApplication mocks base method.
 func (m *MockService) CreateApplication(arg0 context.Context, arg1 types.CreateApplicationRequest) (*model.Application, error) {
 	m.ctrl.T.Helper()
@@ -225,6 +255,36 @@ func (mr *MockServiceMockRecorder) CreateConfig(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConfig", reflect.TypeOf((*MockService)(nil).CreateConfig), arg0, arg1)
 }
 
+// CreateCronJob mocks base method.
+func (m *MockService) CreateCronJob(arg0 context.Context, arg1 types.CreateCronJobRequest) (*model.CronJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCronJob", arg0, arg1)
+	ret0, _ := ret[0].(*model.CronJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCronJob indicates an expected call of CreateCronJob.
+func (mr *MockServiceMockRecorder) CreateCronJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCronJob", reflect.TypeOf((*MockService)(nil).CreateCronJob), arg0, arg1)
+}
+
+// CreateHostJob mocks base method.
+func (m *MockService) CreateHostJob(arg0 context.Context, arg1 types.CreateHostJobRequest) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHostJob", arg0, arg1)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHostJob indicates an expected call of CreateHostJob.
+func (mr *MockServiceMockRecorder) CreateHostJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHostJob", reflect.TypeOf((*MockService)(nil).CreateHostJob), arg0, arg1)
+}
+
 // CreateOauth mocks base method.
 func (m *MockService) CreateOauth(arg0 context.Context, arg1 types.CreateOauthRequest) (*model.Oauth, error) {
 	m.ctrl.T.Helper()
@@ -255,6 +315,21 @@ func (mr *MockServiceMockRecorder) CreatePreheatJob(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePreheatJob", reflect.TypeOf((*MockService)(nil).CreatePreheatJob), arg0, arg1)
 }
 
+// CreateRegistryWebhookJobs mocks base method.
+func (m *MockService) CreateRegistryWebhookJobs(arg0 context.Context, arg1 string, arg2 []byte) ([]model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRegistryWebhookJobs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRegistryWebhookJobs indicates an expected call of CreateRegistryWebhookJobs.
+func (mr *MockServiceMockRecorder) CreateRegistryWebhookJobs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRegistryWebhookJobs", reflect.TypeOf((*MockService)(nil).CreateRegistryWebhookJobs), arg0, arg1, arg2)
+}
+
 // CreateRole mocks base method.
 func (m *MockService) CreateRole(arg0 context.Context, arg1 types.CreateRoleRequest) error {
 	m.ctrl.T.Helper()
@@ -359,6 +434,21 @@ func (mr *MockServiceMockRecorder) CreateSeedPeerCluster(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeedPeerCluster", reflect.TypeOf((*MockService)(nil).CreateSeedPeerCluster), arg0, arg1)
 }
 
+// CreateTaskJob mocks base method.
+func (m *MockService) CreateTaskJob(arg0 context.Context, arg1 types.CreateTaskJobRequest) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTaskJob", arg0, arg1)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTaskJob indicates an expected call of CreateTaskJob.
+func (mr *MockServiceMockRecorder) CreateTaskJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaskJob", reflect.TypeOf((*MockService)(nil).CreateTaskJob), arg0, arg1)
+}
+
 // CreateV1Preheat mocks base method.
 func (m *MockService) CreateV1Preheat(arg0 context.Context, arg1 types.CreateV1PreheatRequest) (*types.CreateV1PreheatResponse, error) {
 	m.ctrl.T.Helper()
@@ -374,6 +464,35 @@ func (mr *MockServiceMockRecorder) CreateV1Preheat(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateV1Preheat", reflect.TypeOf((*MockService)(nil).CreateV1Preheat), arg0, arg1)
 }
 
+// CreateWebhookRule mocks base method.
+func (m *MockService) CreateWebhookRule(arg0 context.Context, arg1 types.CreateWebhookRuleRequest) (*model.WebhookRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookRule", arg0, arg1)
+	ret0, _ := ret[0].(*model.WebhookRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookRule indicates an expected call of CreateWebhookRule.
+func (mr *MockServiceMockRecorder) CreateWebhookRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookRule", reflect.TypeOf((*MockService)(nil).CreateWebhookRule), arg0, arg1)
+}
+
+// DeleteFederatedSchedulerClusterToSchedulerCluster mocks base method.
+func (m *MockService) DeleteFederatedSchedulerClusterToSchedulerCluster(arg0 context.Context, arg1, arg2 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFederatedSchedulerClusterToSchedulerCluster", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFederatedSchedulerClusterToSchedulerCluster indicates an expected call of DeleteFederatedSchedulerClusterToSchedulerCluster.
+func (mr *MockServiceMockRecorder) DeleteFederatedSchedulerClusterToSchedulerCluster(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFederatedSchedulerClusterToSchedulerCluster", reflect.TypeOf((*MockService)(nil).DeleteFederatedSchedulerClusterToSchedulerCluster), arg0, arg1, arg2)
+}
+
 // DeletePermissionForRole mocks base method.
 func (m *MockService) DeletePermissionForRole(arg0 context.Context, arg1 string, arg2 types.DeletePermissionForRoleRequest) (bool, error) {
 	m.ctrl.T.Helper()
@@ -474,6 +593,20 @@ func (mr *MockServiceMockRecorder) DestroyConfig(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyConfig", reflect.TypeOf((*MockService)(nil).DestroyConfig), arg0, arg1)
 }
 
+// DestroyCronJob mocks base method.
+func (m *MockService) DestroyCronJob(arg0 context.Context, arg1 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyCronJob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyCronJob indicates an expected call of DestroyCronJob.
+func (mr *MockServiceMockRecorder) DestroyCronJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyCronJob", reflect.TypeOf((*MockService)(nil).DestroyCronJob), arg0, arg1)
+}
+
 // DestroyJob mocks base method.
 func (m *MockService) DestroyJob(arg0 context.Context, arg1 uint) error {
 	m.ctrl.T.Helper()
@@ -615,6 +748,20 @@ func (mr *MockServiceMockRecorder) DestroySeedPeerCluster(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySeedPeerCluster", reflect.TypeOf((*MockService)(nil).DestroySeedPeerCluster), arg0, arg1)
 }
 
+// DestroyWebhookRule mocks base method.
+func (m *MockService) DestroyWebhookRule(arg0 context.Context, arg1 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyWebhookRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyWebhookRule indicates an expected call of DestroyWebhookRule.
+func (mr *MockServiceMockRecorder) DestroyWebhookRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyWebhookRule", reflect.TypeOf((*MockService)(nil).DestroyWebhookRule), arg0, arg1)
+}
+
 // GetApplication mocks base method.
 func (m *MockService) GetApplication(arg0 context.Context, arg1 uint) (*model.Application, error) {
 	m.ctrl.T.Helper()
@@ -707,6 +854,52 @@ func (mr *MockServiceMockRecorder) GetConfigs(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigs", reflect.TypeOf((*MockService)(nil).GetConfigs), arg0, arg1)
 }
 
+// GetCronJob mocks base method.
+func (m *MockService) GetCronJob(arg0 context.Context, arg1 uint) (*model.CronJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCronJob", arg0, arg1)
+	ret0, _ := ret[0].(*model.CronJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCronJob indicates an expected call of GetCronJob.
+func (mr *MockServiceMockRecorder) GetCronJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCronJob", reflect.TypeOf((*MockService)(nil).GetCronJob), arg0, arg1)
+}
+
+// GetCronJobs mocks base method.
+func (m *MockService) GetCronJobs(arg0 context.Context, arg1 types.GetCronJobsQuery) ([]model.CronJob, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCronJobs", arg0, arg1)
+	ret0, _ := ret[0].([]model.CronJob)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCronJobs indicates an expected call of GetCronJobs.
+func (mr *MockServiceMockRecorder) GetCronJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCronJobs", reflect.TypeOf((*MockService)(nil).GetCronJobs), arg0, arg1)
+}
+
+// GetFederatedSchedulerClusters mocks base method.
+func (m *MockService) GetFederatedSchedulerClusters(arg0 context.Context, arg1 uint) ([]model.SchedulerClusterFederation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFederatedSchedulerClusters", arg0, arg1)
+	ret0, _ := ret[0].([]model.SchedulerClusterFederation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFederatedSchedulerClusters indicates an expected call of GetFederatedSchedulerClusters.
+func (mr *MockServiceMockRecorder) GetFederatedSchedulerClusters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFederatedSchedulerClusters", reflect.TypeOf((*MockService)(nil).GetFederatedSchedulerClusters), arg0, arg1)
+}
+
 // GetJob mocks base method.
 func (m *MockService) GetJob(arg0 context.Context, arg1 uint) (*model.Job, error) {
 	m.ctrl.T.Helper()
@@ -1073,6 +1266,37 @@ func (mr *MockServiceMockRecorder) GetV1Preheat(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetV1Preheat", reflect.TypeOf((*MockService)(nil).GetV1Preheat), arg0, arg1)
 }
 
+// GetWebhookRule mocks base method.
+func (m *MockService) GetWebhookRule(arg0 context.Context, arg1 uint) (*model.WebhookRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookRule", arg0, arg1)
+	ret0, _ := ret[0].(*model.WebhookRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookRule indicates an expected call of GetWebhookRule.
+func (mr *MockServiceMockRecorder) GetWebhookRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookRule", reflect.TypeOf((*MockService)(nil).GetWebhookRule), arg0, arg1)
+}
+
+// GetWebhookRules mocks base method.
+func (m *MockService) GetWebhookRules(arg0 context.Context, arg1 types.GetWebhookRulesQuery) ([]model.WebhookRule, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookRules", arg0, arg1)
+	ret0, _ := ret[0].([]model.WebhookRule)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWebhookRules indicates an expected call of GetWebhookRules.
+func (mr *MockServiceMockRecorder) GetWebhookRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookRules", reflect.TypeOf((*MockService)(nil).GetWebhookRules), arg0, arg1)
+}
+
 // OauthSignin mocks base method.
 func (m *MockService) OauthSignin(arg0 context.Context, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -1147,6 +1371,21 @@ func (mr *MockServiceMockRecorder) SignUp(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignUp", reflect.TypeOf((*MockService)(nil).SignUp), arg0, arg1)
 }
 
+// TriggerCronJob mocks base method.
+func (m *MockService) TriggerCronJob(arg0 context.Context, arg1 uint) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerCronJob", arg0, arg1)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerCronJob indicates an expected call of TriggerCronJob.
+func (mr *MockServiceMockRecorder) TriggerCronJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerCronJob", reflect.TypeOf((*MockService)(nil).TriggerCronJob), arg0, arg1)
+}
+
 // UpdateApplication mocks base method.
 func (m *MockService) UpdateApplication(arg0 context.Context, arg1 uint, arg2 types.UpdateApplicationRequest) (*model.Application, error) {
 	m.ctrl.T.Helper()
@@ -1177,6 +1416,21 @@ func (mr *MockServiceMockRecorder) UpdateConfig(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfig", reflect.TypeOf((*MockService)(nil).UpdateConfig), arg0, arg1, arg2)
 }
 
+// UpdateCronJob mocks base method.
+func (m *MockService) UpdateCronJob(arg0 context.Context, arg1 uint, arg2 types.UpdateCronJobRequest) (*model.CronJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCronJob", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.CronJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCronJob indicates an expected call of UpdateCronJob.
+func (mr *MockServiceMockRecorder) UpdateCronJob(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCronJob", reflect.TypeOf((*MockService)(nil).UpdateCronJob), arg0, arg1, arg2)
+}
+
 // UpdateJob mocks base method.
 func (m *MockService) UpdateJob(arg0 context.Context, arg1 uint, arg2 types.UpdateJobRequest) (*model.Job, error) {
 	m.ctrl.T.Helper()
@@ -1311,3 +1565,18 @@ func (mr *MockServiceMockRecorder) UpdateUser(arg0, arg1, arg2 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockService)(nil).UpdateUser), arg0, arg1, arg2)
 }
+
+// UpdateWebhookRule mocks base method.
+func (m *MockService) UpdateWebhookRule(arg0 context.Context, arg1 uint, arg2 types.UpdateWebhookRuleRequest) (*model.WebhookRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.WebhookRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhookRule indicates an expected call of UpdateWebhookRule.
+func (mr *MockServiceMockRecorder) UpdateWebhookRule(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookRule", reflect.TypeOf((*MockService)(nil).UpdateWebhookRule), arg0, arg1, arg2)
+}
@@ -167,3 +167,63 @@ func (s *service) AddSchedulerToSchedulerCluster(ctx context.Context, id, schedu
 
 	return nil
 }
+
+func (s *service) GetFederatedSchedulerClusters(ctx context.Context, id uint) ([]model.SchedulerClusterFederation, error) {
+	schedulerCluster := model.SchedulerCluster{}
+	if err := s.db.WithContext(ctx).First(&schedulerCluster, id).Error; err != nil {
+		return nil, err
+	}
+
+	var federations []model.SchedulerClusterFederation
+	if err := s.db.WithContext(ctx).Find(&federations, &model.SchedulerClusterFederation{
+		SchedulerClusterID: schedulerCluster.ID,
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	return federations, nil
+}
+
+func (s *service) AddFederatedSchedulerClusterToSchedulerCluster(ctx context.Context, id, federatedSchedulerClusterID uint, json types.AddFederatedSchedulerClusterToSchedulerClusterRequest) (*model.SchedulerClusterFederation, error) {
+	if id == federatedSchedulerClusterID {
+		return nil, errors.New("scheduler cluster can not be federated with itself")
+	}
+
+	schedulerCluster := model.SchedulerCluster{}
+	if err := s.db.WithContext(ctx).First(&schedulerCluster, id).Error; err != nil {
+		return nil, err
+	}
+
+	federatedSchedulerCluster := model.SchedulerCluster{}
+	if err := s.db.WithContext(ctx).First(&federatedSchedulerCluster, federatedSchedulerClusterID).Error; err != nil {
+		return nil, err
+	}
+
+	federation := model.SchedulerClusterFederation{}
+	if err := s.db.WithContext(ctx).Where(&model.SchedulerClusterFederation{
+		SchedulerClusterID:          schedulerCluster.ID,
+		FederatedSchedulerClusterID: federatedSchedulerCluster.ID,
+	}).Assign(map[string]any{
+		"cost": json.Cost,
+	}).FirstOrCreate(&federation).Error; err != nil {
+		return nil, err
+	}
+
+	return &federation, nil
+}
+
+func (s *service) DeleteFederatedSchedulerClusterToSchedulerCluster(ctx context.Context, id, federatedSchedulerClusterID uint) error {
+	federation := model.SchedulerClusterFederation{}
+	if err := s.db.WithContext(ctx).First(&federation, &model.SchedulerClusterFederation{
+		SchedulerClusterID:          id,
+		FederatedSchedulerClusterID: federatedSchedulerClusterID,
+	}).Error; err != nil {
+		return err
+	}
+
+	if err := s.db.WithContext(ctx).Unscoped().Delete(&model.SchedulerClusterFederation{}, federation.ID).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -85,6 +85,9 @@ type Service interface {
 	GetSchedulerCluster(context.Context, uint) (*model.SchedulerCluster, error)
 	GetSchedulerClusters(context.Context, types.GetSchedulerClustersQuery) ([]model.SchedulerCluster, int64, error)
 	AddSchedulerToSchedulerCluster(context.Context, uint, uint) error
+	GetFederatedSchedulerClusters(context.Context, uint) ([]model.SchedulerClusterFederation, error)
+	AddFederatedSchedulerClusterToSchedulerCluster(context.Context, uint, uint, types.AddFederatedSchedulerClusterToSchedulerClusterRequest) (*model.SchedulerClusterFederation, error)
+	DeleteFederatedSchedulerClusterToSchedulerCluster(context.Context, uint, uint) error
 
 	CreateScheduler(context.Context, types.CreateSchedulerRequest) (*model.Scheduler, error)
 	DestroyScheduler(context.Context, uint) error
@@ -120,11 +123,28 @@ type Service interface {
 	GetConfigs(context.Context, types.GetConfigsQuery) ([]model.Config, int64, error)
 
 	CreatePreheatJob(context.Context, types.CreatePreheatJobRequest) (*model.Job, error)
+	CreateHostJob(context.Context, types.CreateHostJobRequest) (*model.Job, error)
+	CreateTaskJob(context.Context, types.CreateTaskJobRequest) (*model.Job, error)
 	DestroyJob(context.Context, uint) error
+	CancelJob(context.Context, uint) (*model.Job, error)
 	UpdateJob(context.Context, uint, types.UpdateJobRequest) (*model.Job, error)
 	GetJob(context.Context, uint) (*model.Job, error)
 	GetJobs(context.Context, types.GetJobsQuery) ([]model.Job, int64, error)
 
+	CreateCronJob(context.Context, types.CreateCronJobRequest) (*model.CronJob, error)
+	DestroyCronJob(context.Context, uint) error
+	UpdateCronJob(context.Context, uint, types.UpdateCronJobRequest) (*model.CronJob, error)
+	GetCronJob(context.Context, uint) (*model.CronJob, error)
+	GetCronJobs(context.Context, types.GetCronJobsQuery) ([]model.CronJob, int64, error)
+	TriggerCronJob(context.Context, uint) (*model.Job, error)
+
+	CreateWebhookRule(context.Context, types.CreateWebhookRuleRequest) (*model.WebhookRule, error)
+	DestroyWebhookRule(context.Context, uint) error
+	UpdateWebhookRule(context.Context, uint, types.UpdateWebhookRuleRequest) (*model.WebhookRule, error)
+	GetWebhookRule(context.Context, uint) (*model.WebhookRule, error)
+	GetWebhookRules(context.Context, types.GetWebhookRulesQuery) ([]model.WebhookRule, int64, error)
+	CreateRegistryWebhookJobs(context.Context, string, []byte) ([]model.Job, error)
+
 	CreateV1Preheat(context.Context, types.CreateV1PreheatRequest) (*types.CreateV1PreheatResponse, error)
 	GetV1Preheat(context.Context, string) (*types.GetV1PreheatResponse, error)
 
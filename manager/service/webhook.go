@@ -0,0 +1,403 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+
+	logger "d7y.io/dragonfly/v2/internal/dflog"
+	internaljob "d7y.io/dragonfly/v2/internal/job"
+	"d7y.io/dragonfly/v2/manager/job"
+	"d7y.io/dragonfly/v2/manager/model"
+	"d7y.io/dragonfly/v2/manager/types"
+	"d7y.io/dragonfly/v2/pkg/structure"
+)
+
+const (
+	// distributionPushAction is the action of Docker Distribution push event.
+	distributionPushAction = "push"
+
+	// harborPushArtifactType is the type of Harbor push artifact event.
+	harborPushArtifactType = "PUSH_ARTIFACT"
+)
+
+// ErrWebhookUnauthorized is returned when no enabled webhook rule matches the secret.
+var ErrWebhookUnauthorized = errors.New("webhook secret is invalid")
+
+// webhookImage is the image pushed to registry.
+type webhookImage struct {
+	// Scheme and host of registry, it is empty when the event does not contain it.
+	registry   *url.URL
+	repository string
+	tag        string
+}
+
+func (s *service) CreateWebhookRule(ctx context.Context, json types.CreateWebhookRuleRequest) (*model.WebhookRule, error) {
+	if err := validateWebhookRulePatterns(json.Repository, json.Tag); err != nil {
+		return nil, err
+	}
+
+	args, err := structure.StructToMap(json.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedulerClusters []model.SchedulerCluster
+	if len(json.SchedulerClusterIDs) != 0 {
+		if err := s.db.WithContext(ctx).Find(&schedulerClusters, json.SchedulerClusterIDs).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	webhookRule := model.WebhookRule{
+		Name:              json.Name,
+		BIO:               json.BIO,
+		Secret:            json.Secret,
+		Registry:          json.Registry,
+		Repository:        json.Repository,
+		Tag:               json.Tag,
+		Args:              args,
+		Enable:            json.Enable,
+		UserID:            json.UserID,
+		SchedulerClusters: schedulerClusters,
+	}
+
+	if err := s.db.WithContext(ctx).Create(&webhookRule).Error; err != nil {
+		return nil, err
+	}
+
+	return &webhookRule, nil
+}
+
+func (s *service) DestroyWebhookRule(ctx context.Context, id uint) error {
+	webhookRule := model.WebhookRule{}
+	if err := s.db.WithContext(ctx).First(&webhookRule, id).Error; err != nil {
+		return err
+	}
+
+	if err := s.db.WithContext(ctx).Delete(&model.WebhookRule{}, id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) UpdateWebhookRule(ctx context.Context, id uint, json types.UpdateWebhookRuleRequest) (*model.WebhookRule, error) {
+	if err := validateWebhookRulePatterns(json.Repository, json.Tag); err != nil {
+		return nil, err
+	}
+
+	values := map[string]any{}
+	if json.BIO != "" {
+		values["bio"] = json.BIO
+	}
+
+	if json.Secret != "" {
+		values["secret"] = json.Secret
+	}
+
+	if json.Registry != "" {
+		values["registry"] = json.Registry
+	}
+
+	if json.Repository != "" {
+		values["repository"] = json.Repository
+	}
+
+	if json.Tag != "" {
+		values["tag"] = json.Tag
+	}
+
+	if json.Args != nil {
+		args, err := structure.StructToMap(json.Args)
+		if err != nil {
+			return nil, err
+		}
+		values["args"] = model.JSONMap(args)
+	}
+
+	// Enable is updated by map because the zero value is ignored by updating with struct.
+	if json.Enable != nil {
+		values["enable"] = *json.Enable
+	}
+
+	if json.UserID > 0 {
+		values["user_id"] = json.UserID
+	}
+
+	webhookRule := model.WebhookRule{}
+	if err := s.db.WithContext(ctx).First(&webhookRule, id).Error; err != nil {
+		return nil, err
+	}
+
+	// The loaded webhook rule is returned when the request has no fields to update.
+	if len(values) != 0 {
+		if err := s.db.WithContext(ctx).Model(&webhookRule).Updates(values).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if len(json.SchedulerClusterIDs) != 0 {
+		var schedulerClusters []model.SchedulerCluster
+		if err := s.db.WithContext(ctx).Find(&schedulerClusters, json.SchedulerClusterIDs).Error; err != nil {
+			return nil, err
+		}
+
+		if err := s.db.WithContext(ctx).Model(&webhookRule).Association("SchedulerClusters").Replace(schedulerClusters); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetWebhookRule(ctx, id)
+}
+
+func (s *service) GetWebhookRule(ctx context.Context, id uint) (*model.WebhookRule, error) {
+	webhookRule := model.WebhookRule{}
+	if err := s.db.WithContext(ctx).Preload("SchedulerClusters").First(&webhookRule, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &webhookRule, nil
+}
+
+func (s *service) GetWebhookRules(ctx context.Context, q types.GetWebhookRulesQuery) ([]model.WebhookRule, int64, error) {
+	var count int64
+	var webhookRules []model.WebhookRule
+	if err := s.db.WithContext(ctx).Scopes(model.Paginate(q.Page, q.PerPage)).Where(&model.WebhookRule{
+		Name:   q.Name,
+		UserID: q.UserID,
+	}).Preload("SchedulerClusters").Find(&webhookRules).Limit(-1).Offset(-1).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return webhookRules, count, nil
+}
+
+// CreateRegistryWebhookJobs receives the push event of Docker Distribution or Harbor,
+// and creates preheat jobs for the pushed images matching the enabled webhook rules
+// authenticated by the secret.
+func (s *service) CreateRegistryWebhookJobs(ctx context.Context, secret string, body []byte) ([]model.Job, error) {
+	var webhookRules []model.WebhookRule
+	if err := s.db.WithContext(ctx).Preload("SchedulerClusters").Find(&webhookRules, model.WebhookRule{Enable: true}).Error; err != nil {
+		return nil, err
+	}
+
+	var authorizedRules []model.WebhookRule
+	for _, webhookRule := range webhookRules {
+		if secret != "" && subtle.ConstantTimeCompare([]byte(webhookRule.Secret), []byte(secret)) == 1 {
+			authorizedRules = append(authorizedRules, webhookRule)
+		}
+	}
+
+	if len(authorizedRules) == 0 {
+		return nil, ErrWebhookUnauthorized
+	}
+
+	images, err := parseRegistryWebhook(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		jobs []model.Job
+		errs error
+	)
+	for _, image := range images {
+		for _, webhookRule := range authorizedRules {
+			matched, err := matchWebhookRule(webhookRule, image)
+			if err != nil {
+				errs = multierror.Append(errs, err)
+				continue
+			}
+
+			if !matched {
+				continue
+			}
+
+			preheatJob, err := s.createWebhookPreheatJob(ctx, webhookRule, image)
+			if err != nil {
+				logger.Errorf("webhook rule %s create preheat job for %s:%s failed: %s", webhookRule.Name, image.repository, image.tag, err.Error())
+				errs = multierror.Append(errs, err)
+				continue
+			}
+
+			jobs = append(jobs, *preheatJob)
+		}
+	}
+
+	// Registry retries the whole event when the response is failed,
+	// so it is failed only when none of the preheat jobs are created.
+	if len(jobs) == 0 && errs != nil {
+		return nil, errs
+	}
+
+	return jobs, nil
+}
+
+// createWebhookPreheatJob creates the preheat job of the image by the args of webhook rule.
+func (s *service) createWebhookPreheatJob(ctx context.Context, webhookRule model.WebhookRule, image webhookImage) (*model.Job, error) {
+	b, err := json.Marshal(webhookRule.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	var args types.WebhookPreheatArgs
+	if err := json.Unmarshal(b, &args); err != nil {
+		return nil, err
+	}
+
+	registry := image.registry
+	if webhookRule.Registry != "" {
+		if registry, err = url.Parse(webhookRule.Registry); err != nil {
+			return nil, err
+		}
+	}
+
+	if registry == nil || registry.Host == "" {
+		return nil, fmt.Errorf("registry of %s is unknown", image.repository)
+	}
+
+	scheme := registry.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+
+	var schedulerClusterIDs []uint
+	for _, schedulerCluster := range webhookRule.SchedulerClusters {
+		schedulerClusterIDs = append(schedulerClusterIDs, schedulerCluster.ID)
+	}
+
+	return s.CreatePreheatJob(ctx, types.CreatePreheatJobRequest{
+		BIO:  fmt.Sprintf("preheat %s:%s by webhook rule %s", image.repository, image.tag, webhookRule.Name),
+		Type: internaljob.PreheatJob,
+		Args: types.PreheatArgs{
+			Type:         string(job.PreheatImageType),
+			URL:          fmt.Sprintf("%s://%s/v2/%s/manifests/%s", scheme, registry.Host, image.repository, image.tag),
+			Tag:          args.Tag,
+			Filter:       args.Filter,
+			Headers:      args.Headers,
+			Platforms:    args.Platforms,
+			AllPlatforms: args.AllPlatforms,
+		},
+		UserID:              webhookRule.UserID,
+		SchedulerClusterIDs: schedulerClusterIDs,
+	})
+}
+
+// parseRegistryWebhook parses the pushed images from the Harbor webhook payload
+// or the Docker Distribution notification envelope.
+func parseRegistryWebhook(body []byte) ([]webhookImage, error) {
+	var harborEvent types.HarborEvent
+	if err := json.Unmarshal(body, &harborEvent); err == nil && harborEvent.Type != "" {
+		if harborEvent.Type != harborPushArtifactType {
+			return nil, nil
+		}
+
+		var images []webhookImage
+		for _, resource := range harborEvent.EventData.Resources {
+			if resource.Tag == "" {
+				continue
+			}
+
+			// Resource url of harbor has no scheme, e.g. harbor.example.com/library/nginx:latest.
+			var registry *url.URL
+			if host, _, found := strings.Cut(resource.ResourceURL, "/"); found {
+				registry = &url.URL{Host: host}
+			}
+
+			images = append(images, webhookImage{
+				registry:   registry,
+				repository: harborEvent.EventData.Repository.RepoFullName,
+				tag:        resource.Tag,
+			})
+		}
+
+		return images, nil
+	}
+
+	var notification types.DistributionNotification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		return nil, err
+	}
+
+	var images []webhookImage
+	for _, event := range notification.Events {
+		// Manifests pushed by digest have no tag, e.g. the manifests of manifest list.
+		if event.Action != distributionPushAction || event.Target.Tag == "" {
+			continue
+		}
+
+		var registry *url.URL
+		if u, err := url.Parse(event.Target.URL); err == nil && u.Host != "" {
+			registry = &url.URL{Scheme: u.Scheme, Host: u.Host}
+		}
+
+		images = append(images, webhookImage{
+			registry:   registry,
+			repository: event.Target.Repository,
+			tag:        event.Target.Tag,
+		})
+	}
+
+	return images, nil
+}
+
+// matchWebhookRule returns whether the repository and tag of image match the
+// patterns of webhook rule, the empty pattern matches all.
+func matchWebhookRule(webhookRule model.WebhookRule, image webhookImage) (bool, error) {
+	for _, m := range []struct {
+		pattern string
+		value   string
+	}{
+		{webhookRule.Repository, image.repository},
+		{webhookRule.Tag, image.tag},
+	} {
+		if m.pattern == "" {
+			continue
+		}
+
+		matched, err := regexp.MatchString(fmt.Sprintf("^(?:%s)$", m.pattern), m.value)
+		if err != nil {
+			return false, err
+		}
+
+		if !matched {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func validateWebhookRulePatterns(patterns ...string) error {
+	for _, pattern := range patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+	}
+
+	return nil
+}
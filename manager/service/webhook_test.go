@@ -0,0 +1,253 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"d7y.io/dragonfly/v2/manager/model"
+	"d7y.io/dragonfly/v2/manager/types"
+)
+
+var (
+	mockWebhookRuleQuery   = "SELECT \\* FROM `webhook_rule` WHERE `webhook_rule`.`id` = \\?"
+	mockWebhookRuleColumns = []string{"id", "name", "bio", "secret"}
+)
+
+func TestParseRegistryWebhook(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		expect func(t *testing.T, images []webhookImage, err error)
+	}{
+		{
+			name: "distribution push event",
+			body: `{"events":[{"id":"foo","action":"push","target":{"mediaType":"application/vnd.docker.distribution.manifest.v2+json","digest":"sha256:foo","repository":"library/nginx","url":"http://registry.example.com:5000/v2/library/nginx/manifests/sha256:foo","tag":"1.23"}}]}`,
+			expect: func(t *testing.T, images []webhookImage, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(images, []webhookImage{{
+					registry:   &url.URL{Scheme: "http", Host: "registry.example.com:5000"},
+					repository: "library/nginx",
+					tag:        "1.23",
+				}})
+			},
+		},
+		{
+			name: "distribution digest push and pull events are skipped",
+			body: `{"events":[{"action":"push","target":{"digest":"sha256:foo","repository":"library/nginx","url":"http://registry.example.com/v2/library/nginx/manifests/sha256:foo"}},{"action":"pull","target":{"repository":"library/nginx","tag":"latest"}}]}`,
+			expect: func(t *testing.T, images []webhookImage, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Empty(images)
+			},
+		},
+		{
+			name: "distribution push event without url",
+			body: `{"events":[{"action":"push","target":{"repository":"library/nginx","tag":"latest"}}]}`,
+			expect: func(t *testing.T, images []webhookImage, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(images, []webhookImage{{repository: "library/nginx", tag: "latest"}})
+			},
+		},
+		{
+			name: "harbor push artifact event",
+			body: `{"type":"PUSH_ARTIFACT","occur_at":1660000000,"operator":"admin","event_data":{"resources":[{"digest":"sha256:foo","tag":"v1","resource_url":"harbor.example.com/library/nginx:v1"},{"digest":"sha256:bar","tag":"","resource_url":"harbor.example.com/library/nginx@sha256:bar"}],"repository":{"name":"nginx","namespace":"library","repo_full_name":"library/nginx","repo_type":"public"}}}`,
+			expect: func(t *testing.T, images []webhookImage, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(images, []webhookImage{{
+					registry:   &url.URL{Host: "harbor.example.com"},
+					repository: "library/nginx",
+					tag:        "v1",
+				}})
+			},
+		},
+		{
+			name: "harbor other event is skipped",
+			body: `{"type":"DELETE_ARTIFACT","event_data":{"resources":[{"tag":"v1","resource_url":"harbor.example.com/library/nginx:v1"}],"repository":{"repo_full_name":"library/nginx"}}}`,
+			expect: func(t *testing.T, images []webhookImage, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Empty(images)
+			},
+		},
+		{
+			name: "body is invalid",
+			body: `foo`,
+			expect: func(t *testing.T, images []webhookImage, err error) {
+				assert := assert.New(t)
+				assert.Error(err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			images, err := parseRegistryWebhook([]byte(tc.body))
+			tc.expect(t, images, err)
+		})
+	}
+}
+
+func TestMatchWebhookRule(t *testing.T) {
+	tests := []struct {
+		name        string
+		webhookRule model.WebhookRule
+		image       webhookImage
+		expect      func(t *testing.T, matched bool, err error)
+	}{
+		{
+			name:        "empty patterns match all",
+			webhookRule: model.WebhookRule{},
+			image:       webhookImage{repository: "library/nginx", tag: "latest"},
+			expect: func(t *testing.T, matched bool, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.True(matched)
+			},
+		},
+		{
+			name:        "repository and tag match",
+			webhookRule: model.WebhookRule{Repository: "library/.*", Tag: `v\d+\.\d+`},
+			image:       webhookImage{repository: "library/nginx", tag: "v1.23"},
+			expect: func(t *testing.T, matched bool, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.True(matched)
+			},
+		},
+		{
+			name:        "repository pattern is anchored",
+			webhookRule: model.WebhookRule{Repository: "library"},
+			image:       webhookImage{repository: "library/nginx", tag: "latest"},
+			expect: func(t *testing.T, matched bool, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.False(matched)
+			},
+		},
+		{
+			name:        "tag pattern is anchored",
+			webhookRule: model.WebhookRule{Tag: `v\d+`},
+			image:       webhookImage{repository: "library/nginx", tag: "v1-rc"},
+			expect: func(t *testing.T, matched bool, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.False(matched)
+			},
+		},
+		{
+			name:        "alternation is anchored as a whole",
+			webhookRule: model.WebhookRule{Tag: "latest|stable"},
+			image:       webhookImage{repository: "library/nginx", tag: "unstable"},
+			expect: func(t *testing.T, matched bool, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.False(matched)
+			},
+		},
+		{
+			name:        "pattern is invalid",
+			webhookRule: model.WebhookRule{Repository: "("},
+			image:       webhookImage{repository: "library/nginx", tag: "latest"},
+			expect: func(t *testing.T, matched bool, err error) {
+				assert := assert.New(t)
+				assert.Error(err)
+				assert.False(matched)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			matched, err := matchWebhookRule(tc.webhookRule, tc.image)
+			tc.expect(t, matched, err)
+		})
+	}
+}
+
+func TestService_UpdateWebhookRule(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    types.UpdateWebhookRuleRequest
+		mock   func(mockDB sqlmock.Sqlmock)
+		expect func(t *testing.T, webhookRule *model.WebhookRule, err error)
+	}{
+		{
+			name: "update webhook rule",
+			req:  types.UpdateWebhookRuleRequest{BIO: "bar"},
+			mock: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(mockWebhookRuleQuery).WillReturnRows(sqlmock.NewRows(mockWebhookRuleColumns).AddRow(1, "foo", "", "baz"))
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("UPDATE `webhook_rule` SET").WillReturnResult(sqlmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+				mockDB.ExpectQuery(mockWebhookRuleQuery).WillReturnRows(sqlmock.NewRows(mockWebhookRuleColumns).AddRow(1, "foo", "bar", "baz"))
+				mockDB.ExpectQuery("SELECT \\* FROM `webhook_rule_scheduler_cluster`").WillReturnRows(sqlmock.NewRows([]string{"webhook_rule_id", "scheduler_cluster_id"}))
+			},
+			expect: func(t *testing.T, webhookRule *model.WebhookRule, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(webhookRule.BIO, "bar")
+			},
+		},
+		{
+			name: "request has no fields to update",
+			req:  types.UpdateWebhookRuleRequest{},
+			mock: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(mockWebhookRuleQuery).WillReturnRows(sqlmock.NewRows(mockWebhookRuleColumns).AddRow(1, "foo", "", "baz"))
+				mockDB.ExpectQuery(mockWebhookRuleQuery).WillReturnRows(sqlmock.NewRows(mockWebhookRuleColumns).AddRow(1, "foo", "", "baz"))
+				mockDB.ExpectQuery("SELECT \\* FROM `webhook_rule_scheduler_cluster`").WillReturnRows(sqlmock.NewRows([]string{"webhook_rule_id", "scheduler_cluster_id"}))
+			},
+			expect: func(t *testing.T, webhookRule *model.WebhookRule, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(webhookRule.Name, "foo")
+			},
+		},
+		{
+			name: "webhook rule not found",
+			req:  types.UpdateWebhookRuleRequest{BIO: "bar"},
+			mock: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(mockWebhookRuleQuery).WillReturnRows(sqlmock.NewRows(mockWebhookRuleColumns))
+			},
+			expect: func(t *testing.T, webhookRule *model.WebhookRule, err error) {
+				assert := assert.New(t)
+				assert.Error(err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, mockDB, _ := newMockService(t)
+			tc.mock(mockDB)
+			webhookRule, err := s.UpdateWebhookRule(context.Background(), 1, tc.req)
+			tc.expect(t, webhookRule, err)
+
+			if err := mockDB.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
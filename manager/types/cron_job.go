@@ -0,0 +1,49 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+type CreateCronJobRequest struct {
+	Name                string      `json:"name" binding:"required"`
+	BIO                 string      `json:"bio" binding:"omitempty"`
+	Type                string      `json:"type" binding:"required,oneof=preheat"`
+	Cron                string      `json:"cron" binding:"required"`
+	Args                PreheatArgs `json:"args" binding:"required"`
+	Enable              bool        `json:"enable" binding:"omitempty"`
+	UserID              uint        `json:"user_id" binding:"omitempty"`
+	SchedulerClusterIDs []uint      `json:"scheduler_cluster_ids" binding:"omitempty"`
+}
+
+type UpdateCronJobRequest struct {
+	BIO                 string       `json:"bio" binding:"omitempty"`
+	Cron                string       `json:"cron" binding:"omitempty"`
+	Args                *PreheatArgs `json:"args" binding:"omitempty"`
+	Enable              *bool        `json:"enable" binding:"omitempty"`
+	UserID              uint         `json:"user_id" binding:"omitempty"`
+	SchedulerClusterIDs []uint       `json:"scheduler_cluster_ids" binding:"omitempty"`
+}
+
+type CronJobParams struct {
+	ID uint `uri:"id" binding:"required"`
+}
+
+type GetCronJobsQuery struct {
+	Name    string `form:"name" binding:"omitempty"`
+	Type    string `form:"type" binding:"omitempty"`
+	UserID  uint   `form:"user_id" binding:"omitempty"`
+	Page    int    `form:"page" binding:"omitempty,gte=1"`
+	PerPage int    `form:"per_page" binding:"omitempty,gte=1,lte=50"`
+}
@@ -36,11 +36,12 @@ type JobParams struct {
 }
 
 type GetJobsQuery struct {
-	Type    string `form:"type" binding:"omitempty"`
-	State   string `form:"state" binding:"omitempty,oneof=PENDING RECEIVED STARTED RETRY SUCCESS FAILURE"`
-	UserID  uint   `form:"user_id" binding:"omitempty"`
-	Page    int    `form:"page" binding:"omitempty,gte=1"`
-	PerPage int    `form:"per_page" binding:"omitempty,gte=1,lte=50"`
+	Type      string `form:"type" binding:"omitempty"`
+	State     string `form:"state" binding:"omitempty,oneof=PENDING RECEIVED STARTED RETRY SUCCESS FAILURE CANCELED"`
+	UserID    uint   `form:"user_id" binding:"omitempty"`
+	CronJobID uint   `form:"cron_job_id" binding:"omitempty"`
+	Page      int    `form:"page" binding:"omitempty,gte=1"`
+	PerPage   int    `form:"per_page" binding:"omitempty,gte=1,lte=50"`
 }
 
 type CreatePreheatJobRequest struct {
@@ -58,4 +59,64 @@ type PreheatArgs struct {
 	Tag     string            `json:"tag" binding:"omitempty"`
 	Filter  string            `json:"filter" binding:"omitempty"`
 	Headers map[string]string `json:"headers" binding:"omitempty"`
+
+	// Platforms is the platforms of image preheated when the image is
+	// manifest list or image index, linux/amd64 is preheated by default.
+	Platforms []PreheatPlatform `json:"platforms" binding:"omitempty,dive"`
+
+	// AllPlatforms preheats all platforms of image, platforms are ignored.
+	AllPlatforms bool `json:"all_platforms" binding:"omitempty"`
+}
+
+type PreheatPlatform struct {
+	OS           string `json:"os" binding:"required"`
+	Architecture string `json:"architecture" binding:"required"`
+	Variant      string `json:"variant" binding:"omitempty"`
+}
+
+type CreateHostJobRequest struct {
+	BIO                 string         `json:"bio" binding:"omitempty"`
+	Type                string         `json:"type" binding:"required,oneof=cordon_host uncordon_host drain_host"`
+	Args                HostArgs       `json:"args" binding:"required"`
+	Result              map[string]any `json:"result" binding:"omitempty"`
+	UserID              uint           `json:"user_id" binding:"omitempty"`
+	SchedulerClusterIDs []uint         `json:"scheduler_cluster_ids" binding:"omitempty"`
+}
+
+type HostArgs struct {
+	HostID string `json:"host_id" binding:"required"`
+}
+
+type CreateTaskJobRequest struct {
+	BIO                 string         `json:"bio" binding:"omitempty"`
+	Type                string         `json:"type" binding:"required,oneof=purge_task get_task"`
+	Args                TaskArgs       `json:"args" binding:"required"`
+	Result              map[string]any `json:"result" binding:"omitempty"`
+	UserID              uint           `json:"user_id" binding:"omitempty"`
+	SchedulerClusterIDs []uint         `json:"scheduler_cluster_ids" binding:"omitempty"`
+}
+
+// TaskArgs identifies the task by task id,
+// or by url with tag, filter, digest and headers.
+type TaskArgs struct {
+	TaskID  string            `json:"task_id" binding:"required_without=URL"`
+	URL     string            `json:"url" binding:"required_without=TaskID"`
+	Tag     string            `json:"tag" binding:"omitempty"`
+	Digest  string            `json:"digest" binding:"omitempty"`
+	Filter  string            `json:"filter" binding:"omitempty"`
+	Headers map[string]string `json:"headers" binding:"omitempty"`
+}
+
+// TaskSummary is the summary of peers holding the task reported by get_task job.
+type TaskSummary struct {
+	PeerCount          int                       `json:"peer_count"`
+	SeedPeerCount      int                       `json:"seed_peer_count"`
+	SucceededPeerCount int                       `json:"succeeded_peer_count"`
+	IDCs               map[string]*TaskPeerCount `json:"idcs"`
+	Locations          map[string]*TaskPeerCount `json:"locations"`
+}
+
+type TaskPeerCount struct {
+	PeerCount          int `json:"peer_count"`
+	SucceededPeerCount int `json:"succeeded_peer_count"`
 }
@@ -25,6 +25,20 @@ type AddSchedulerToSchedulerClusterParams struct {
 	SchedulerID uint `uri:"scheduler_id" binding:"required"`
 }
 
+type AddFederatedSchedulerClusterToSchedulerClusterParams struct {
+	ID                          uint `uri:"id" binding:"required"`
+	FederatedSchedulerClusterID uint `uri:"federated_scheduler_cluster_id" binding:"required"`
+}
+
+type AddFederatedSchedulerClusterToSchedulerClusterRequest struct {
+	Cost uint `json:"cost" binding:"omitempty"`
+}
+
+type DeleteFederatedSchedulerClusterToSchedulerClusterParams struct {
+	ID                          uint `uri:"id" binding:"required"`
+	FederatedSchedulerClusterID uint `uri:"federated_scheduler_cluster_id" binding:"required"`
+}
+
 type CreateSchedulerClusterRequest struct {
 	Name              string                        `json:"name" binding:"required"`
 	BIO               string                        `json:"bio" binding:"omitempty"`
@@ -54,7 +68,34 @@ type GetSchedulerClustersQuery struct {
 }
 
 type SchedulerClusterConfig struct {
-	FilterParentLimit uint32 `yaml:"filterParentLimit" mapstructure:"filterParentLimit" json:"filter_parent_limit" binding:"omitempty,gte=1,lte=100"`
+	FilterParentLimit           uint32                             `yaml:"filterParentLimit" mapstructure:"filterParentLimit" json:"filter_parent_limit" binding:"omitempty,gte=1,lte=100"`
+	CandidateParentLimit        uint32                             `yaml:"candidateParentLimit" mapstructure:"candidateParentLimit" json:"candidate_parent_limit" binding:"omitempty,gte=1,lte=20"`
+	BackToSourceConcurrentLimit uint32                             `yaml:"backToSourceConcurrentLimit" mapstructure:"backToSourceConcurrentLimit" json:"back_to_source_concurrent_limit" binding:"omitempty,gte=1"`
+	BackToSourceRateLimit       uint64                             `yaml:"backToSourceRateLimit" mapstructure:"backToSourceRateLimit" json:"back_to_source_rate_limit" binding:"omitempty,gte=1"`
+	EvaluatorProfile            string                             `yaml:"evaluatorProfile" mapstructure:"evaluatorProfile" json:"evaluator_profile" binding:"omitempty"`
+	EvaluatorProfiles           []SchedulerClusterEvaluatorProfile `yaml:"evaluatorProfiles" mapstructure:"evaluatorProfiles" json:"evaluator_profiles" binding:"omitempty,dive"`
+	PieceRangeAssignment        bool                               `yaml:"pieceRangeAssignment" mapstructure:"pieceRangeAssignment" json:"piece_range_assignment"`
+}
+
+type SchedulerClusterEvaluatorProfile struct {
+	Name                      string                          `yaml:"name" mapstructure:"name" json:"name" binding:"required"`
+	FinishedPieceWeight       float64                         `yaml:"finishedPieceWeight" mapstructure:"finishedPieceWeight" json:"finished_piece_weight" binding:"omitempty,gte=0,lte=1"`
+	FreeLoadWeight            float64                         `yaml:"freeLoadWeight" mapstructure:"freeLoadWeight" json:"free_load_weight" binding:"omitempty,gte=0,lte=1"`
+	HostTypeAffinityWeight    float64                         `yaml:"hostTypeAffinityWeight" mapstructure:"hostTypeAffinityWeight" json:"host_type_affinity_weight" binding:"omitempty,gte=0,lte=1"`
+	IDCAffinityWeight         float64                         `yaml:"idcAffinityWeight" mapstructure:"idcAffinityWeight" json:"idc_affinity_weight" binding:"omitempty,gte=0,lte=1"`
+	NetTopologyAffinityWeight float64                         `yaml:"netTopologyAffinityWeight" mapstructure:"netTopologyAffinityWeight" json:"net_topology_affinity_weight" binding:"omitempty,gte=0,lte=1"`
+	LocationAffinityWeight    float64                         `yaml:"locationAffinityWeight" mapstructure:"locationAffinityWeight" json:"location_affinity_weight" binding:"omitempty,gte=0,lte=1"`
+	NetworkDistanceWeight     float64                         `yaml:"networkDistanceWeight" mapstructure:"networkDistanceWeight" json:"network_distance_weight" binding:"omitempty,gte=0,lte=1"`
+	BandwidthWeight           float64                         `yaml:"bandwidthWeight" mapstructure:"bandwidthWeight" json:"bandwidth_weight" binding:"omitempty,gte=0,lte=1"`
+	Rules                     []SchedulerClusterEvaluatorRule `yaml:"rules" mapstructure:"rules" json:"rules" binding:"omitempty,dive"`
+}
+
+type SchedulerClusterEvaluatorRule struct {
+	HostType    string  `yaml:"hostType" mapstructure:"hostType" json:"host_type" binding:"omitempty,oneof=normal super strong weak"`
+	IDC         string  `yaml:"idc" mapstructure:"idc" json:"idc" binding:"omitempty"`
+	NetTopology string  `yaml:"netTopology" mapstructure:"netTopology" json:"net_topology" binding:"omitempty"`
+	Location    string  `yaml:"location" mapstructure:"location" json:"location" binding:"omitempty"`
+	Score       float64 `yaml:"score" mapstructure:"score" json:"score" binding:"omitempty,gte=-1,lte=1"`
 }
 
 type SchedulerClusterClientConfig struct {
@@ -0,0 +1,110 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+type CreateWebhookRuleRequest struct {
+	Name                string             `json:"name" binding:"required"`
+	BIO                 string             `json:"bio" binding:"omitempty"`
+	Secret              string             `json:"secret" binding:"required"`
+	Registry            string             `json:"registry" binding:"omitempty,url"`
+	Repository          string             `json:"repository" binding:"omitempty"`
+	Tag                 string             `json:"tag" binding:"omitempty"`
+	Args                WebhookPreheatArgs `json:"args" binding:"omitempty"`
+	Enable              bool               `json:"enable" binding:"omitempty"`
+	UserID              uint               `json:"user_id" binding:"omitempty"`
+	SchedulerClusterIDs []uint             `json:"scheduler_cluster_ids" binding:"omitempty"`
+}
+
+type UpdateWebhookRuleRequest struct {
+	BIO                 string              `json:"bio" binding:"omitempty"`
+	Secret              string              `json:"secret" binding:"omitempty"`
+	Registry            string              `json:"registry" binding:"omitempty,url"`
+	Repository          string              `json:"repository" binding:"omitempty"`
+	Tag                 string              `json:"tag" binding:"omitempty"`
+	Args                *WebhookPreheatArgs `json:"args" binding:"omitempty"`
+	Enable              *bool               `json:"enable" binding:"omitempty"`
+	UserID              uint                `json:"user_id" binding:"omitempty"`
+	SchedulerClusterIDs []uint              `json:"scheduler_cluster_ids" binding:"omitempty"`
+}
+
+// WebhookPreheatArgs is the args of preheat jobs created by webhook rule,
+// the url of image is generated by the registry push event.
+type WebhookPreheatArgs struct {
+	Tag          string            `json:"tag" binding:"omitempty"`
+	Filter       string            `json:"filter" binding:"omitempty"`
+	Headers      map[string]string `json:"headers" binding:"omitempty"`
+	Platforms    []PreheatPlatform `json:"platforms" binding:"omitempty,dive"`
+	AllPlatforms bool              `json:"all_platforms" binding:"omitempty"`
+}
+
+type WebhookRuleParams struct {
+	ID uint `uri:"id" binding:"required"`
+}
+
+type GetWebhookRulesQuery struct {
+	Name    string `form:"name" binding:"omitempty"`
+	UserID  uint   `form:"user_id" binding:"omitempty"`
+	Page    int    `form:"page" binding:"omitempty,gte=1"`
+	PerPage int    `form:"per_page" binding:"omitempty,gte=1,lte=50"`
+}
+
+// DistributionNotification is the notification envelope of Docker Distribution,
+// refer to https://github.com/distribution/distribution/blob/main/docs/notifications.md.
+type DistributionNotification struct {
+	Events []DistributionEvent `json:"events"`
+}
+
+type DistributionEvent struct {
+	ID     string                  `json:"id"`
+	Action string                  `json:"action"`
+	Target DistributionEventTarget `json:"target"`
+}
+
+type DistributionEventTarget struct {
+	MediaType  string `json:"mediaType"`
+	Digest     string `json:"digest"`
+	Repository string `json:"repository"`
+	URL        string `json:"url"`
+	Tag        string `json:"tag"`
+}
+
+// HarborEvent is the webhook payload of Harbor,
+// refer to https://goharbor.io/docs/main/working-with-projects/project-configuration/configure-webhooks/.
+type HarborEvent struct {
+	Type      string          `json:"type"`
+	OccurAt   int64           `json:"occur_at"`
+	Operator  string          `json:"operator"`
+	EventData HarborEventData `json:"event_data"`
+}
+
+type HarborEventData struct {
+	Resources  []HarborResource `json:"resources"`
+	Repository HarborRepository `json:"repository"`
+}
+
+type HarborResource struct {
+	Digest      string `json:"digest"`
+	Tag         string `json:"tag"`
+	ResourceURL string `json:"resource_url"`
+}
+
+type HarborRepository struct {
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace"`
+	RepoFullName string `json:"repo_full_name"`
+	RepoType     string `json:"repo_type"`
+}
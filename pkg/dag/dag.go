@@ -63,6 +63,9 @@ type DAG interface {
 
 	// DeleteEdge deletes edge between two vertices.
 	DeleteEdge(fromVertexID, toVertexID string) error
+
+	// IsReachable determines whether there is a path from fromVertex to toVertex.
+	IsReachable(fromVertexID, toVertexID string) bool
 }
 
 // dag provides directed acyclic graph function.
@@ -220,6 +223,14 @@ func (d *dag) DeleteEdge(fromVertexID, toVertexID string) error {
 	return nil
 }
 
+// IsReachable determines whether there is a path from fromVertex to toVertex.
+func (d *dag) IsReachable(fromVertexID, toVertexID string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.depthFirstSearch(fromVertexID, toVertexID)
+}
+
 // depthFirstSearch is a depth-first search of the directed acyclic graph.
 func (d *dag) depthFirstSearch(fromVertexID, toVertexID string) bool {
 	successors := make(map[string]struct{})
@@ -489,6 +489,58 @@ func TestDAGDeleteEdge(t *testing.T) {
 	}
 }
 
+func TestDAGIsReachable(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect func(t *testing.T, d DAG)
+	}{
+		{
+			name: "vertices are reachable",
+			expect: func(t *testing.T, d DAG) {
+				assert := assert.New(t)
+				var (
+					mockVertexEID = "bae"
+					mockVertexFID = "baf"
+					mockVertexGID = "bag"
+				)
+
+				for _, id := range []string{mockVertexEID, mockVertexFID, mockVertexGID} {
+					if err := d.AddVertex(id, mockVertexValue); err != nil {
+						assert.NoError(err)
+					}
+				}
+
+				if err := d.AddEdge(mockVertexEID, mockVertexFID); err != nil {
+					assert.NoError(err)
+				}
+
+				if err := d.AddEdge(mockVertexFID, mockVertexGID); err != nil {
+					assert.NoError(err)
+				}
+
+				assert.True(d.IsReachable(mockVertexEID, mockVertexFID))
+				assert.True(d.IsReachable(mockVertexEID, mockVertexGID))
+				assert.False(d.IsReachable(mockVertexGID, mockVertexEID))
+				assert.False(d.IsReachable(mockVertexEID, mockVertexEID))
+			},
+		},
+		{
+			name: "vertex not found",
+			expect: func(t *testing.T, d DAG) {
+				assert := assert.New(t)
+				assert.False(d.IsReachable("bae", "baf"))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewDAG()
+			tc.expect(t, d)
+		})
+	}
+}
+
 func BenchmarkDAGAddVertex(b *testing.B) {
 	var ids []string
 	d := NewDAG()
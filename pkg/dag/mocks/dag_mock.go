@@ -103,6 +103,20 @@ func (mr *MockDAGMockRecorder) GetVertex(id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVertex", reflect.TypeOf((*MockDAG)(nil).GetVertex), id)
 }
 
+// IsReachable mocks base method.
+func (m *MockDAG) IsReachable(fromVertexID, toVertexID string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsReachable", fromVertexID, toVertexID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsReachable indicates an expected call of IsReachable.
+func (mr *MockDAGMockRecorder) IsReachable(fromVertexID, toVertexID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsReachable", reflect.TypeOf((*MockDAG)(nil).IsReachable), fromVertexID, toVertexID)
+}
+
 // LenVertex mocks base method.
 func (m *MockDAG) LenVertex() int {
 	m.ctrl.T.Helper()
@@ -32,6 +32,9 @@ import (
 	logger "d7y.io/dragonfly/v2/internal/dflog"
 )
 
+// ErrDigestNotMatch is returned when the encoded of content does not match the digest.
+var ErrDigestNotMatch = errors.New("digest encoded not match")
+
 // Reader is the interface used for reading resource.
 type Reader interface {
 	io.Reader
@@ -118,7 +121,7 @@ func (r *reader) Read(p []byte) (int, error) {
 		encoded := r.Encoded()
 		if encoded != r.encoded {
 			r.logger.Warnf("digest encoded not match, desired: %s, actual: %s", r.encoded, encoded)
-			return n, errors.New("digest encoded not match")
+			return n, ErrDigestNotMatch
 		}
 
 		r.logger.Debugf("digest encoded match: %s", encoded)
@@ -137,6 +137,15 @@ func (m *UrlMeta) Validate() error {
 
 	// no validation rules for Header
 
+	if _, ok := Priority_name[int32(m.GetPriority())]; !ok {
+		return UrlMetaValidationError{
+			field:  "Priority",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for Application
+
 	return nil
 }
 
@@ -0,0 +1,90 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+//go:generate mockgen -destination mocks/client_mock.go -source client.go -package mocks
+
+package client
+
+import (
+	"context"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials/insecure"
+
+	logger "d7y.io/dragonfly/v2/internal/dflog"
+	"d7y.io/dragonfly/v2/pkg/rpc/evaluator"
+)
+
+const (
+	backoffBaseDelay  = 1 * time.Second
+	backoffMultiplier = 1.6
+	backoffJitter     = 0.2
+	backoffMaxDelay   = 10 * time.Second
+)
+
+// Client is the interface for grpc client.
+type Client interface {
+	// Evaluate scores candidate parents of child in batch.
+	Evaluate(context.Context, *evaluator.EvaluateRequest, ...grpc.CallOption) (*evaluator.EvaluateResponse, error)
+
+	// Close client connect.
+	Close() error
+}
+
+// client provides evaluator grpc function.
+type client struct {
+	evaluator.EvaluatorClient
+	conn *grpc.ClientConn
+}
+
+// New creates evaluator client, the connection is established in background
+// so the scheduler can start before the evaluator service is ready.
+func New(target string) (Client, error) {
+	conn, err := grpc.Dial(
+		target,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: backoff.Config{
+				BaseDelay:  backoffBaseDelay,
+				Multiplier: backoffMultiplier,
+				Jitter:     backoffJitter,
+				MaxDelay:   backoffMaxDelay,
+			},
+		}),
+		grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(
+			grpc_prometheus.UnaryClientInterceptor,
+			grpc_zap.UnaryClientInterceptor(logger.GrpcLogger.Desugar()),
+		)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client{
+		EvaluatorClient: evaluator.NewEvaluatorClient(conn),
+		conn:            conn,
+	}, nil
+}
+
+// Close grpc service.
+func (c *client) Close() error {
+	return c.conn.Close()
+}
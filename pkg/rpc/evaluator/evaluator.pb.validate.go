@@ -0,0 +1,580 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: pkg/rpc/evaluator/evaluator.proto
+
+package evaluator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// Validate checks the field values on Host with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *Host) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return HostValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Type
+
+	// no validation rules for Ip
+
+	// no validation rules for Hostname
+
+	// no validation rules for SecurityDomain
+
+	// no validation rules for Location
+
+	// no validation rules for Idc
+
+	// no validation rules for NetTopology
+
+	// no validation rules for UploadLoadLimit
+
+	// no validation rules for UploadPeerCount
+
+	// no validation rules for TxBandwidth
+
+	// no validation rules for UploadBandwidthLimit
+
+	// no validation rules for CpuRatio
+
+	// no validation rules for MemoryRatio
+
+	return nil
+}
+
+// HostValidationError is the validation error returned by Host.Validate if the
+// designated constraints aren't met.
+type HostValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HostValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HostValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HostValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HostValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HostValidationError) ErrorName() string { return "HostValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HostValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHost.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HostValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HostValidationError{}
+
+// Validate checks the field values on Peer with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *Peer) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return PeerValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if m.GetHost() == nil {
+		return PeerValidationError{
+			field:  "Host",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetHost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PeerValidationError{
+				field:  "Host",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for State
+
+	// no validation rules for FinishedPieceCount
+
+	// no validation rules for IsBackToSource
+
+	// no validation rules for Depth
+
+	return nil
+}
+
+// PeerValidationError is the validation error returned by Peer.Validate if the
+// designated constraints aren't met.
+type PeerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeerValidationError) ErrorName() string { return "PeerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PeerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeerValidationError{}
+
+// Validate checks the field values on Candidate with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Candidate) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetPeer() == nil {
+		return CandidateValidationError{
+			field:  "Peer",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetPeer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CandidateValidationError{
+				field:  "Peer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Rtt
+
+	// no validation rules for Loss
+
+	return nil
+}
+
+// CandidateValidationError is the validation error returned by
+// Candidate.Validate if the designated constraints aren't met.
+type CandidateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CandidateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CandidateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CandidateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CandidateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CandidateValidationError) ErrorName() string { return "CandidateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CandidateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCandidate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CandidateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CandidateValidationError{}
+
+// Validate checks the field values on EvaluateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *EvaluateRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		return EvaluateRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for TotalPieceCount
+
+	if m.GetChild() == nil {
+		return EvaluateRequestValidationError{
+			field:  "Child",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetChild()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EvaluateRequestValidationError{
+				field:  "Child",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetCandidates()) < 1 {
+		return EvaluateRequestValidationError{
+			field:  "Candidates",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetCandidates() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EvaluateRequestValidationError{
+					field:  fmt.Sprintf("Candidates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// EvaluateRequestValidationError is the validation error returned by
+// EvaluateRequest.Validate if the designated constraints aren't met.
+type EvaluateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EvaluateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EvaluateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EvaluateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EvaluateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EvaluateRequestValidationError) ErrorName() string { return "EvaluateRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EvaluateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvaluateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EvaluateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EvaluateRequestValidationError{}
+
+// Validate checks the field values on Score with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Score) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetPeerId()) < 1 {
+		return ScoreValidationError{
+			field:  "PeerId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Score
+
+	// no validation rules for BadNode
+
+	return nil
+}
+
+// ScoreValidationError is the validation error returned by Score.Validate if
+// the designated constraints aren't met.
+type ScoreValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScoreValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScoreValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScoreValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScoreValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScoreValidationError) ErrorName() string { return "ScoreValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScoreValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScore.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScoreValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScoreValidationError{}
+
+// Validate checks the field values on EvaluateResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *EvaluateResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetScores() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EvaluateResponseValidationError{
+					field:  fmt.Sprintf("Scores[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// EvaluateResponseValidationError is the validation error returned by
+// EvaluateResponse.Validate if the designated constraints aren't met.
+type EvaluateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EvaluateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EvaluateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EvaluateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EvaluateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EvaluateResponseValidationError) ErrorName() string { return "EvaluateResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EvaluateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvaluateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EvaluateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EvaluateResponseValidationError{}
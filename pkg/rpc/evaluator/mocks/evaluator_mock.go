@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: evaluator/evaluator.pb.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	evaluator "d7y.io/dragonfly/v2/pkg/rpc/evaluator"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockEvaluatorClient is a mock of EvaluatorClient interface.
+type MockEvaluatorClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvaluatorClientMockRecorder
+}
+
+// MockEvaluatorClientMockRecorder is the mock recorder for MockEvaluatorClient.
+type MockEvaluatorClientMockRecorder struct {
+	mock *MockEvaluatorClient
+}
+
+// NewMockEvaluatorClient creates a new mock instance.
+func NewMockEvaluatorClient(ctrl *gomock.Controller) *MockEvaluatorClient {
+	mock := &MockEvaluatorClient{ctrl: ctrl}
+	mock.recorder = &MockEvaluatorClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvaluatorClient) EXPECT() *MockEvaluatorClientMockRecorder {
+	return m.recorder
+}
+
+// Evaluate mocks base method.
+func (m *MockEvaluatorClient) Evaluate(ctx context.Context, in *evaluator.EvaluateRequest, opts ...grpc.CallOption) (*evaluator.EvaluateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Evaluate", varargs...)
+	ret0, _ := ret[0].(*evaluator.EvaluateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Evaluate indicates an expected call of Evaluate.
+func (mr *MockEvaluatorClientMockRecorder) Evaluate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockEvaluatorClient)(nil).Evaluate), varargs...)
+}
+
+// MockEvaluatorServer is a mock of EvaluatorServer interface.
+type MockEvaluatorServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvaluatorServerMockRecorder
+}
+
+// MockEvaluatorServerMockRecorder is the mock recorder for MockEvaluatorServer.
+type MockEvaluatorServerMockRecorder struct {
+	mock *MockEvaluatorServer
+}
+
+// NewMockEvaluatorServer creates a new mock instance.
+func NewMockEvaluatorServer(ctrl *gomock.Controller) *MockEvaluatorServer {
+	mock := &MockEvaluatorServer{ctrl: ctrl}
+	mock.recorder = &MockEvaluatorServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvaluatorServer) EXPECT() *MockEvaluatorServerMockRecorder {
+	return m.recorder
+}
+
+// Evaluate mocks base method.
+func (m *MockEvaluatorServer) Evaluate(arg0 context.Context, arg1 *evaluator.EvaluateRequest) (*evaluator.EvaluateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", arg0, arg1)
+	ret0, _ := ret[0].(*evaluator.EvaluateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Evaluate indicates an expected call of Evaluate.
+func (mr *MockEvaluatorServerMockRecorder) Evaluate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockEvaluatorServer)(nil).Evaluate), arg0, arg1)
+}
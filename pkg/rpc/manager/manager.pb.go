@@ -665,6 +665,8 @@ type SchedulerCluster struct {
 	Scopes []byte `protobuf:"bytes,6,opt,name=scopes,proto3" json:"scopes,omitempty"`
 	// Security group to which the scheduler cluster belongs.
 	SecurityGroup *SecurityGroup `protobuf:"bytes,7,opt,name=security_group,json=securityGroup,proto3" json:"security_group,omitempty"`
+	// IDs of hosts cordoned in the cluster.
+	CordonedHostIds []string `protobuf:"bytes,8,rep,name=cordoned_host_ids,json=cordonedHostIds,proto3" json:"cordoned_host_ids,omitempty"`
 }
 
 func (x *SchedulerCluster) Reset() {
@@ -748,6 +750,13 @@ func (x *SchedulerCluster) GetSecurityGroup() *SecurityGroup {
 	return nil
 }
 
+func (x *SchedulerCluster) GetCordonedHostIds() []string {
+	if x != nil {
+		return x.CordonedHostIds
+	}
+	return nil
+}
+
 // SeedPeerCluster represents scheduler for network.
 type Scheduler struct {
 	state         protoimpl.MessageState
@@ -780,6 +789,10 @@ type Scheduler struct {
 	SeedPeers []*SeedPeer `protobuf:"bytes,13,rep,name=seed_peers,json=seedPeers,proto3" json:"seed_peers,omitempty"`
 	// Scheduler network topology.
 	NetTopology string `protobuf:"bytes,14,opt,name=net_topology,json=netTopology,proto3" json:"net_topology,omitempty"`
+	// Active schedulers of the cluster to which the scheduler belongs.
+	Schedulers []*Scheduler `protobuf:"bytes,15,rep,name=schedulers,proto3" json:"schedulers,omitempty"`
+	// Scheduler clusters federated with the cluster to which the scheduler belongs.
+	FederatedSchedulerClusters []*FederatedSchedulerCluster `protobuf:"bytes,16,rep,name=federated_scheduler_clusters,json=federatedSchedulerClusters,proto3" json:"federated_scheduler_clusters,omitempty"`
 }
 
 func (x *Scheduler) Reset() {
@@ -905,6 +918,97 @@ func (x *Scheduler) GetNetTopology() string {
 	return ""
 }
 
+func (x *Scheduler) GetSchedulers() []*Scheduler {
+	if x != nil {
+		return x.Schedulers
+	}
+	return nil
+}
+
+func (x *Scheduler) GetFederatedSchedulerClusters() []*FederatedSchedulerCluster {
+	if x != nil {
+		return x.FederatedSchedulerClusters
+	}
+	return nil
+}
+
+// FederatedSchedulerCluster represents scheduler cluster which
+// the peers of tasks can be found in.
+type FederatedSchedulerCluster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Cluster id.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Cluster name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Cost of traffic from the cluster.
+	Cost uint64 `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	// Active schedulers of the cluster.
+	Schedulers []*Scheduler `protobuf:"bytes,4,rep,name=schedulers,proto3" json:"schedulers,omitempty"`
+}
+
+func (x *FederatedSchedulerCluster) Reset() {
+	*x = FederatedSchedulerCluster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederatedSchedulerCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederatedSchedulerCluster) ProtoMessage() {}
+
+func (x *FederatedSchedulerCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederatedSchedulerCluster.ProtoReflect.Descriptor instead.
+func (*FederatedSchedulerCluster) Descriptor() ([]byte, []int) {
+	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FederatedSchedulerCluster) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FederatedSchedulerCluster) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FederatedSchedulerCluster) GetCost() uint64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *FederatedSchedulerCluster) GetSchedulers() []*Scheduler {
+	if x != nil {
+		return x.Schedulers
+	}
+	return nil
+}
+
 // GetSchedulerRequest represents request of GetScheduler.
 type GetSchedulerRequest struct {
 	state         protoimpl.MessageState
@@ -922,7 +1026,7 @@ type GetSchedulerRequest struct {
 func (x *GetSchedulerRequest) Reset() {
 	*x = GetSchedulerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[7]
+		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -935,7 +1039,7 @@ func (x *GetSchedulerRequest) String() string {
 func (*GetSchedulerRequest) ProtoMessage() {}
 
 func (x *GetSchedulerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[7]
+	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -948,7 +1052,7 @@ func (x *GetSchedulerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSchedulerRequest.ProtoReflect.Descriptor instead.
 func (*GetSchedulerRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{7}
+	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetSchedulerRequest) GetSourceType() SourceType {
@@ -1003,7 +1107,7 @@ type UpdateSchedulerRequest struct {
 func (x *UpdateSchedulerRequest) Reset() {
 	*x = UpdateSchedulerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[8]
+		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1016,7 +1120,7 @@ func (x *UpdateSchedulerRequest) String() string {
 func (*UpdateSchedulerRequest) ProtoMessage() {}
 
 func (x *UpdateSchedulerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[8]
+	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,7 +1133,7 @@ func (x *UpdateSchedulerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSchedulerRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSchedulerRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{8}
+	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateSchedulerRequest) GetSourceType() SourceType {
@@ -1121,7 +1225,7 @@ type ListSchedulersRequest struct {
 func (x *ListSchedulersRequest) Reset() {
 	*x = ListSchedulersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[9]
+		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1134,7 +1238,7 @@ func (x *ListSchedulersRequest) String() string {
 func (*ListSchedulersRequest) ProtoMessage() {}
 
 func (x *ListSchedulersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[9]
+	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,7 +1251,7 @@ func (x *ListSchedulersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSchedulersRequest.ProtoReflect.Descriptor instead.
 func (*ListSchedulersRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{9}
+	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListSchedulersRequest) GetSourceType() SourceType {
@@ -1191,7 +1295,7 @@ type ListSchedulersResponse struct {
 func (x *ListSchedulersResponse) Reset() {
 	*x = ListSchedulersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[10]
+		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1204,7 +1308,7 @@ func (x *ListSchedulersResponse) String() string {
 func (*ListSchedulersResponse) ProtoMessage() {}
 
 func (x *ListSchedulersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[10]
+	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1217,7 +1321,7 @@ func (x *ListSchedulersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSchedulersResponse.ProtoReflect.Descriptor instead.
 func (*ListSchedulersResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{10}
+	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListSchedulersResponse) GetSchedulers() []*Scheduler {
@@ -1248,7 +1352,7 @@ type ObjectStorage struct {
 func (x *ObjectStorage) Reset() {
 	*x = ObjectStorage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[11]
+		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1261,7 +1365,7 @@ func (x *ObjectStorage) String() string {
 func (*ObjectStorage) ProtoMessage() {}
 
 func (x *ObjectStorage) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[11]
+	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,7 +1378,7 @@ func (x *ObjectStorage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectStorage.ProtoReflect.Descriptor instead.
 func (*ObjectStorage) Descriptor() ([]byte, []int) {
-	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{11}
+	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ObjectStorage) GetName() string {
@@ -1329,7 +1433,7 @@ type GetObjectStorageRequest struct {
 func (x *GetObjectStorageRequest) Reset() {
 	*x = GetObjectStorageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[12]
+		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1342,7 +1446,7 @@ func (x *GetObjectStorageRequest) String() string {
 func (*GetObjectStorageRequest) ProtoMessage() {}
 
 func (x *GetObjectStorageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[12]
+	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1355,7 +1459,7 @@ func (x *GetObjectStorageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetObjectStorageRequest.ProtoReflect.Descriptor instead.
 func (*GetObjectStorageRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{12}
+	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetObjectStorageRequest) GetSourceType() SourceType {
@@ -1392,7 +1496,7 @@ type Bucket struct {
 func (x *Bucket) Reset() {
 	*x = Bucket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[13]
+		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1405,7 +1509,7 @@ func (x *Bucket) String() string {
 func (*Bucket) ProtoMessage() {}
 
 func (x *Bucket) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[13]
+	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,7 +1522,7 @@ func (x *Bucket) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Bucket.ProtoReflect.Descriptor instead.
 func (*Bucket) Descriptor() ([]byte, []int) {
-	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{13}
+	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Bucket) GetName() string {
@@ -1445,7 +1549,7 @@ type ListBucketsRequest struct {
 func (x *ListBucketsRequest) Reset() {
 	*x = ListBucketsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[14]
+		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1458,7 +1562,7 @@ func (x *ListBucketsRequest) String() string {
 func (*ListBucketsRequest) ProtoMessage() {}
 
 func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[14]
+	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1471,7 +1575,7 @@ func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBucketsRequest.ProtoReflect.Descriptor instead.
 func (*ListBucketsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{14}
+	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListBucketsRequest) GetSourceType() SourceType {
@@ -1508,7 +1612,7 @@ type ListBucketsResponse struct {
 func (x *ListBucketsResponse) Reset() {
 	*x = ListBucketsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[15]
+		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1521,7 +1625,7 @@ func (x *ListBucketsResponse) String() string {
 func (*ListBucketsResponse) ProtoMessage() {}
 
 func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[15]
+	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,7 +1638,7 @@ func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBucketsResponse.ProtoReflect.Descriptor instead.
 func (*ListBucketsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{15}
+	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListBucketsResponse) GetBuckets() []*Bucket {
@@ -1561,7 +1665,7 @@ type KeepAliveRequest struct {
 func (x *KeepAliveRequest) Reset() {
 	*x = KeepAliveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[16]
+		mi := &file_pkg_rpc_manager_manager_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1574,7 +1678,7 @@ func (x *KeepAliveRequest) String() string {
 func (*KeepAliveRequest) ProtoMessage() {}
 
 func (x *KeepAliveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[16]
+	mi := &file_pkg_rpc_manager_manager_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1587,7 +1691,7 @@ func (x *KeepAliveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeepAliveRequest.ProtoReflect.Descriptor instead.
 func (*KeepAliveRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{16}
+	return file_pkg_rpc_manager_manager_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *KeepAliveRequest) GetSourceType() SourceType {
@@ -1713,8 +1817,8 @@ var file_pkg_rpc_manager_manager_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
 	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0xfa, 0x42, 0x0b,
 	0x1a, 0x09, 0x10, 0xff, 0xff, 0x03, 0x28, 0x80, 0x08, 0x40, 0x01, 0x52, 0x11, 0x6f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x22, 0xdc,
-	0x01, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x6c, 0x75, 0x73,
+	0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x88,
+	0x02, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x6c, 0x75, 0x73,
 	0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
 	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x03,
@@ -1727,34 +1831,55 @@ var file_pkg_rpc_manager_manager_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
 	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
 	0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0d,
-	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xa2, 0x03,
-	0x0a, 0x09, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x68,
-	0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x70, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x70, 0x73, 0x12, 0x10, 0x0a, 0x03,
-	0x69, 0x64, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x63, 0x12, 0x1a,
-	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65,
-	0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
-	0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x10, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a,
-	0x0a, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x65, 0x64,
-	0x50, 0x65, 0x65, 0x72, 0x52, 0x09, 0x73, 0x65, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f,
-	0x67, 0x79, 0x22, 0xb6, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x0a,
+	0x11, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e,
+	0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22, 0xbc, 0x04, 0x0a, 0x09, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x70, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x30, 0x0a, 0x14, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x65, 0x65,
+	0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72,
+	0x52, 0x09, 0x73, 0x65, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x65, 0x74, 0x5f, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x32,
+	0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x73, 0x12, 0x64, 0x0a, 0x1c, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x1a, 0x66, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x19, 0x46, 0x65, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x32,
+	0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
 	0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
 	0x13, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
@@ -1927,28 +2052,29 @@ func file_pkg_rpc_manager_manager_proto_rawDescGZIP() []byte {
 }
 
 var file_pkg_rpc_manager_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_rpc_manager_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_pkg_rpc_manager_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_pkg_rpc_manager_manager_proto_goTypes = []interface{}{
-	(SourceType)(0),                 // 0: manager.SourceType
-	(*SecurityGroup)(nil),           // 1: manager.SecurityGroup
-	(*SeedPeerCluster)(nil),         // 2: manager.SeedPeerCluster
-	(*SeedPeer)(nil),                // 3: manager.SeedPeer
-	(*GetSeedPeerRequest)(nil),      // 4: manager.GetSeedPeerRequest
-	(*UpdateSeedPeerRequest)(nil),   // 5: manager.UpdateSeedPeerRequest
-	(*SchedulerCluster)(nil),        // 6: manager.SchedulerCluster
-	(*Scheduler)(nil),               // 7: manager.Scheduler
-	(*GetSchedulerRequest)(nil),     // 8: manager.GetSchedulerRequest
-	(*UpdateSchedulerRequest)(nil),  // 9: manager.UpdateSchedulerRequest
-	(*ListSchedulersRequest)(nil),   // 10: manager.ListSchedulersRequest
-	(*ListSchedulersResponse)(nil),  // 11: manager.ListSchedulersResponse
-	(*ObjectStorage)(nil),           // 12: manager.ObjectStorage
-	(*GetObjectStorageRequest)(nil), // 13: manager.GetObjectStorageRequest
-	(*Bucket)(nil),                  // 14: manager.Bucket
-	(*ListBucketsRequest)(nil),      // 15: manager.ListBucketsRequest
-	(*ListBucketsResponse)(nil),     // 16: manager.ListBucketsResponse
-	(*KeepAliveRequest)(nil),        // 17: manager.KeepAliveRequest
-	nil,                             // 18: manager.ListSchedulersRequest.HostInfoEntry
-	(*emptypb.Empty)(nil),           // 19: google.protobuf.Empty
+	(SourceType)(0),                   // 0: manager.SourceType
+	(*SecurityGroup)(nil),             // 1: manager.SecurityGroup
+	(*SeedPeerCluster)(nil),           // 2: manager.SeedPeerCluster
+	(*SeedPeer)(nil),                  // 3: manager.SeedPeer
+	(*GetSeedPeerRequest)(nil),        // 4: manager.GetSeedPeerRequest
+	(*UpdateSeedPeerRequest)(nil),     // 5: manager.UpdateSeedPeerRequest
+	(*SchedulerCluster)(nil),          // 6: manager.SchedulerCluster
+	(*Scheduler)(nil),                 // 7: manager.Scheduler
+	(*FederatedSchedulerCluster)(nil), // 8: manager.FederatedSchedulerCluster
+	(*GetSchedulerRequest)(nil),       // 9: manager.GetSchedulerRequest
+	(*UpdateSchedulerRequest)(nil),    // 10: manager.UpdateSchedulerRequest
+	(*ListSchedulersRequest)(nil),     // 11: manager.ListSchedulersRequest
+	(*ListSchedulersResponse)(nil),    // 12: manager.ListSchedulersResponse
+	(*ObjectStorage)(nil),             // 13: manager.ObjectStorage
+	(*GetObjectStorageRequest)(nil),   // 14: manager.GetObjectStorageRequest
+	(*Bucket)(nil),                    // 15: manager.Bucket
+	(*ListBucketsRequest)(nil),        // 16: manager.ListBucketsRequest
+	(*ListBucketsResponse)(nil),       // 17: manager.ListBucketsResponse
+	(*KeepAliveRequest)(nil),          // 18: manager.KeepAliveRequest
+	nil,                               // 19: manager.ListSchedulersRequest.HostInfoEntry
+	(*emptypb.Empty)(nil),             // 20: google.protobuf.Empty
 }
 var file_pkg_rpc_manager_manager_proto_depIdxs = []int32{
 	1,  // 0: manager.SeedPeerCluster.security_group:type_name -> manager.SecurityGroup
@@ -1959,36 +2085,39 @@ var file_pkg_rpc_manager_manager_proto_depIdxs = []int32{
 	1,  // 5: manager.SchedulerCluster.security_group:type_name -> manager.SecurityGroup
 	6,  // 6: manager.Scheduler.scheduler_cluster:type_name -> manager.SchedulerCluster
 	3,  // 7: manager.Scheduler.seed_peers:type_name -> manager.SeedPeer
-	0,  // 8: manager.GetSchedulerRequest.source_type:type_name -> manager.SourceType
-	0,  // 9: manager.UpdateSchedulerRequest.source_type:type_name -> manager.SourceType
-	0,  // 10: manager.ListSchedulersRequest.source_type:type_name -> manager.SourceType
-	18, // 11: manager.ListSchedulersRequest.host_info:type_name -> manager.ListSchedulersRequest.HostInfoEntry
-	7,  // 12: manager.ListSchedulersResponse.schedulers:type_name -> manager.Scheduler
-	0,  // 13: manager.GetObjectStorageRequest.source_type:type_name -> manager.SourceType
-	0,  // 14: manager.ListBucketsRequest.source_type:type_name -> manager.SourceType
-	14, // 15: manager.ListBucketsResponse.buckets:type_name -> manager.Bucket
-	0,  // 16: manager.KeepAliveRequest.source_type:type_name -> manager.SourceType
-	4,  // 17: manager.Manager.GetSeedPeer:input_type -> manager.GetSeedPeerRequest
-	5,  // 18: manager.Manager.UpdateSeedPeer:input_type -> manager.UpdateSeedPeerRequest
-	8,  // 19: manager.Manager.GetScheduler:input_type -> manager.GetSchedulerRequest
-	9,  // 20: manager.Manager.UpdateScheduler:input_type -> manager.UpdateSchedulerRequest
-	10, // 21: manager.Manager.ListSchedulers:input_type -> manager.ListSchedulersRequest
-	13, // 22: manager.Manager.GetObjectStorage:input_type -> manager.GetObjectStorageRequest
-	15, // 23: manager.Manager.ListBuckets:input_type -> manager.ListBucketsRequest
-	17, // 24: manager.Manager.KeepAlive:input_type -> manager.KeepAliveRequest
-	3,  // 25: manager.Manager.GetSeedPeer:output_type -> manager.SeedPeer
-	3,  // 26: manager.Manager.UpdateSeedPeer:output_type -> manager.SeedPeer
-	7,  // 27: manager.Manager.GetScheduler:output_type -> manager.Scheduler
-	7,  // 28: manager.Manager.UpdateScheduler:output_type -> manager.Scheduler
-	11, // 29: manager.Manager.ListSchedulers:output_type -> manager.ListSchedulersResponse
-	12, // 30: manager.Manager.GetObjectStorage:output_type -> manager.ObjectStorage
-	16, // 31: manager.Manager.ListBuckets:output_type -> manager.ListBucketsResponse
-	19, // 32: manager.Manager.KeepAlive:output_type -> google.protobuf.Empty
-	25, // [25:33] is the sub-list for method output_type
-	17, // [17:25] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	7,  // 8: manager.Scheduler.schedulers:type_name -> manager.Scheduler
+	8,  // 9: manager.Scheduler.federated_scheduler_clusters:type_name -> manager.FederatedSchedulerCluster
+	7,  // 10: manager.FederatedSchedulerCluster.schedulers:type_name -> manager.Scheduler
+	0,  // 11: manager.GetSchedulerRequest.source_type:type_name -> manager.SourceType
+	0,  // 12: manager.UpdateSchedulerRequest.source_type:type_name -> manager.SourceType
+	0,  // 13: manager.ListSchedulersRequest.source_type:type_name -> manager.SourceType
+	19, // 14: manager.ListSchedulersRequest.host_info:type_name -> manager.ListSchedulersRequest.HostInfoEntry
+	7,  // 15: manager.ListSchedulersResponse.schedulers:type_name -> manager.Scheduler
+	0,  // 16: manager.GetObjectStorageRequest.source_type:type_name -> manager.SourceType
+	0,  // 17: manager.ListBucketsRequest.source_type:type_name -> manager.SourceType
+	15, // 18: manager.ListBucketsResponse.buckets:type_name -> manager.Bucket
+	0,  // 19: manager.KeepAliveRequest.source_type:type_name -> manager.SourceType
+	4,  // 20: manager.Manager.GetSeedPeer:input_type -> manager.GetSeedPeerRequest
+	5,  // 21: manager.Manager.UpdateSeedPeer:input_type -> manager.UpdateSeedPeerRequest
+	9,  // 22: manager.Manager.GetScheduler:input_type -> manager.GetSchedulerRequest
+	10, // 23: manager.Manager.UpdateScheduler:input_type -> manager.UpdateSchedulerRequest
+	11, // 24: manager.Manager.ListSchedulers:input_type -> manager.ListSchedulersRequest
+	14, // 25: manager.Manager.GetObjectStorage:input_type -> manager.GetObjectStorageRequest
+	16, // 26: manager.Manager.ListBuckets:input_type -> manager.ListBucketsRequest
+	18, // 27: manager.Manager.KeepAlive:input_type -> manager.KeepAliveRequest
+	3,  // 28: manager.Manager.GetSeedPeer:output_type -> manager.SeedPeer
+	3,  // 29: manager.Manager.UpdateSeedPeer:output_type -> manager.SeedPeer
+	7,  // 30: manager.Manager.GetScheduler:output_type -> manager.Scheduler
+	7,  // 31: manager.Manager.UpdateScheduler:output_type -> manager.Scheduler
+	12, // 32: manager.Manager.ListSchedulers:output_type -> manager.ListSchedulersResponse
+	13, // 33: manager.Manager.GetObjectStorage:output_type -> manager.ObjectStorage
+	17, // 34: manager.Manager.ListBuckets:output_type -> manager.ListBucketsResponse
+	20, // 35: manager.Manager.KeepAlive:output_type -> google.protobuf.Empty
+	28, // [28:36] is the sub-list for method output_type
+	20, // [20:28] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_pkg_rpc_manager_manager_proto_init() }
@@ -2082,7 +2211,7 @@ func file_pkg_rpc_manager_manager_proto_init() {
 			}
 		}
 		file_pkg_rpc_manager_manager_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSchedulerRequest); i {
+			switch v := v.(*FederatedSchedulerCluster); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2094,7 +2223,7 @@ func file_pkg_rpc_manager_manager_proto_init() {
 			}
 		}
 		file_pkg_rpc_manager_manager_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSchedulerRequest); i {
+			switch v := v.(*GetSchedulerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2106,7 +2235,7 @@ func file_pkg_rpc_manager_manager_proto_init() {
 			}
 		}
 		file_pkg_rpc_manager_manager_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSchedulersRequest); i {
+			switch v := v.(*UpdateSchedulerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2118,7 +2247,7 @@ func file_pkg_rpc_manager_manager_proto_init() {
 			}
 		}
 		file_pkg_rpc_manager_manager_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSchedulersResponse); i {
+			switch v := v.(*ListSchedulersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2130,7 +2259,7 @@ func file_pkg_rpc_manager_manager_proto_init() {
 			}
 		}
 		file_pkg_rpc_manager_manager_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObjectStorage); i {
+			switch v := v.(*ListSchedulersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2142,7 +2271,7 @@ func file_pkg_rpc_manager_manager_proto_init() {
 			}
 		}
 		file_pkg_rpc_manager_manager_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetObjectStorageRequest); i {
+			switch v := v.(*ObjectStorage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2154,7 +2283,7 @@ func file_pkg_rpc_manager_manager_proto_init() {
 			}
 		}
 		file_pkg_rpc_manager_manager_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Bucket); i {
+			switch v := v.(*GetObjectStorageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2166,7 +2295,7 @@ func file_pkg_rpc_manager_manager_proto_init() {
 			}
 		}
 		file_pkg_rpc_manager_manager_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBucketsRequest); i {
+			switch v := v.(*Bucket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2178,7 +2307,7 @@ func file_pkg_rpc_manager_manager_proto_init() {
 			}
 		}
 		file_pkg_rpc_manager_manager_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBucketsResponse); i {
+			switch v := v.(*ListBucketsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2190,6 +2319,18 @@ func file_pkg_rpc_manager_manager_proto_init() {
 			}
 		}
 		file_pkg_rpc_manager_manager_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBucketsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_rpc_manager_manager_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*KeepAliveRequest); i {
 			case 0:
 				return &v.state
@@ -2208,7 +2349,7 @@ func file_pkg_rpc_manager_manager_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_rpc_manager_manager_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   18,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -763,6 +763,36 @@ func (m *Scheduler) Validate() error {
 
 	// no validation rules for NetTopology
 
+	for idx, item := range m.GetSchedulers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchedulerValidationError{
+					field:  fmt.Sprintf("Schedulers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetFederatedSchedulerClusters() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchedulerValidationError{
+					field:  fmt.Sprintf("FederatedSchedulerClusters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -820,6 +850,94 @@ var _ interface {
 	ErrorName() string
 } = SchedulerValidationError{}
 
+// Validate checks the field values on FederatedSchedulerCluster with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FederatedSchedulerCluster) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Cost
+
+	for idx, item := range m.GetSchedulers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FederatedSchedulerClusterValidationError{
+					field:  fmt.Sprintf("Schedulers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// FederatedSchedulerClusterValidationError is the validation error returned by
+// FederatedSchedulerCluster.Validate if the designated constraints aren't met.
+type FederatedSchedulerClusterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FederatedSchedulerClusterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FederatedSchedulerClusterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FederatedSchedulerClusterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FederatedSchedulerClusterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FederatedSchedulerClusterValidationError) ErrorName() string {
+	return "FederatedSchedulerClusterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FederatedSchedulerClusterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFederatedSchedulerCluster.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FederatedSchedulerClusterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FederatedSchedulerClusterValidationError{}
+
 // Validate checks the field values on GetSchedulerRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
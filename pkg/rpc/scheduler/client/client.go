@@ -23,6 +23,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"google.golang.org/grpc"
 
 	logger "d7y.io/dragonfly/v2/internal/dflog"
@@ -91,6 +92,15 @@ type Client interface {
 	// A peer announces that it has the announced task to other peers.
 	AnnounceTask(context.Context, *scheduler.AnnounceTaskRequest, ...grpc.CallOption) error
 
+	// FindTaskPeers finds the succeeded peers of task for the scheduler of federated cluster.
+	FindTaskPeers(context.Context, *scheduler.FindTaskPeersRequest, ...grpc.CallOption) (*scheduler.FindTaskPeersResponse, error)
+
+	// SyncProbes reports probe results of the host to all schedulers and receives the hosts to be probed.
+	SyncProbes(context.Context, *scheduler.SyncProbesRequest, ...grpc.CallOption) (*scheduler.SyncProbesResponse, error)
+
+	// AnnounceHost reports the load of the host to all schedulers.
+	AnnounceHost(context.Context, *scheduler.AnnounceHostRequest, ...grpc.CallOption) error
+
 	// Update grpc addresses.
 	UpdateState([]dfnet.NetAddr)
 
@@ -209,3 +219,84 @@ func (sc *client) AnnounceTask(ctx context.Context, req *scheduler.AnnounceTaskR
 
 	return nil
 }
+
+// FindTaskPeers finds the succeeded peers of task for the scheduler of federated cluster.
+func (sc *client) FindTaskPeers(ctx context.Context, req *scheduler.FindTaskPeersRequest, opts ...grpc.CallOption) (*scheduler.FindTaskPeersResponse, error) {
+	client, target, err := sc.getClient(req.TaskId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithTaskID(req.TaskId).Infof("find task peers with %s request: %#v", target, req)
+	resp, err := client.FindTaskPeers(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// SyncProbes reports probe results of the host to all schedulers, because parents are
+// evaluated by the scheduler which the task is hashed to, and receives the hosts to be
+// probed from the scheduler which the host is hashed to.
+func (sc *client) SyncProbes(ctx context.Context, req *scheduler.SyncProbesRequest, opts ...grpc.CallOption) (*scheduler.SyncProbesResponse, error) {
+	_, target, err := sc.getClient(req.PeerHost.Id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		resp   *scheduler.SyncProbesResponse
+		result error
+	)
+	for _, addr := range sc.GetState() {
+		clientConn, err := sc.Connection.GetClientConnByTarget(addr.GetEndpoint())
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		logger.WithHostID(req.PeerHost.Id).Infof("sync probes with %s request: %d probes", clientConn.Target(), len(req.Probes))
+		r, err := scheduler.NewSchedulerClient(clientConn).SyncProbes(ctx, req, opts...)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		// Use the hosts sampled by the scheduler which the host is hashed to,
+		// otherwise use the hosts sampled by any available scheduler.
+		if resp == nil || clientConn.Target() == target {
+			resp = r
+		}
+	}
+
+	if resp == nil {
+		return nil, result
+	}
+
+	if result != nil {
+		logger.WithHostID(req.PeerHost.Id).Warnf("sync probes failed: %s", result.Error())
+	}
+
+	return resp, nil
+}
+
+// AnnounceHost reports the load of the host to all schedulers,
+// because the host may be scheduled as parent by any scheduler.
+func (sc *client) AnnounceHost(ctx context.Context, req *scheduler.AnnounceHostRequest, opts ...grpc.CallOption) error {
+	var result error
+	for _, addr := range sc.GetState() {
+		clientConn, err := sc.Connection.GetClientConnByTarget(addr.GetEndpoint())
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		logger.WithHostID(req.PeerHost.Id).Debugf("announce host with %s request: %#v", addr.GetEndpoint(), req.Stats)
+		if _, err := scheduler.NewSchedulerClient(clientConn).AnnounceHost(ctx, req, opts...); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result
+}
@@ -37,6 +37,25 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// AnnounceHost mocks base method.
+func (m *MockClient) AnnounceHost(arg0 context.Context, arg1 *scheduler.AnnounceHostRequest, arg2 ...grpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AnnounceHost", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AnnounceHost indicates an expected call of AnnounceHost.
+func (mr *MockClientMockRecorder) AnnounceHost(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnounceHost", reflect.TypeOf((*MockClient)(nil).AnnounceHost), varargs...)
+}
+
 // AnnounceTask mocks base method.
 func (m *MockClient) AnnounceTask(arg0 context.Context, arg1 *scheduler.AnnounceTaskRequest, arg2 ...grpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -70,6 +89,26 @@ func (mr *MockClientMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockClient)(nil).Close))
 }
 
+// FindTaskPeers mocks base method.
+func (m *MockClient) FindTaskPeers(arg0 context.Context, arg1 *scheduler.FindTaskPeersRequest, arg2 ...grpc.CallOption) (*scheduler.FindTaskPeersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindTaskPeers", varargs...)
+	ret0, _ := ret[0].(*scheduler.FindTaskPeersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTaskPeers indicates an expected call of FindTaskPeers.
+func (mr *MockClientMockRecorder) FindTaskPeers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTaskPeers", reflect.TypeOf((*MockClient)(nil).FindTaskPeers), varargs...)
+}
+
 // GetState mocks base method.
 func (m *MockClient) GetState() []dfnet.NetAddr {
 	m.ctrl.T.Helper()
@@ -182,6 +221,26 @@ func (mr *MockClientMockRecorder) StatTask(arg0, arg1 interface{}, arg2 ...inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatTask", reflect.TypeOf((*MockClient)(nil).StatTask), varargs...)
 }
 
+// SyncProbes mocks base method.
+func (m *MockClient) SyncProbes(arg0 context.Context, arg1 *scheduler.SyncProbesRequest, arg2 ...grpc.CallOption) (*scheduler.SyncProbesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SyncProbes", varargs...)
+	ret0, _ := ret[0].(*scheduler.SyncProbesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncProbes indicates an expected call of SyncProbes.
+func (mr *MockClientMockRecorder) SyncProbes(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncProbes", reflect.TypeOf((*MockClient)(nil).SyncProbes), varargs...)
+}
+
 // UpdateState mocks base method.
 func (m *MockClient) UpdateState(arg0 []dfnet.NetAddr) {
 	m.ctrl.T.Helper()
@@ -143,6 +143,26 @@ func (m *MockSchedulerClient) EXPECT() *MockSchedulerClientMockRecorder {
 	return m.recorder
 }
 
+// AnnounceHost mocks base method.
+func (m *MockSchedulerClient) AnnounceHost(ctx context.Context, in *scheduler.AnnounceHostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AnnounceHost", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnounceHost indicates an expected call of AnnounceHost.
+func (mr *MockSchedulerClientMockRecorder) AnnounceHost(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnounceHost", reflect.TypeOf((*MockSchedulerClient)(nil).AnnounceHost), varargs...)
+}
+
 // AnnounceTask mocks base method.
 func (m *MockSchedulerClient) AnnounceTask(ctx context.Context, in *scheduler.AnnounceTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +183,26 @@ func (mr *MockSchedulerClientMockRecorder) AnnounceTask(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnounceTask", reflect.TypeOf((*MockSchedulerClient)(nil).AnnounceTask), varargs...)
 }
 
+// FindTaskPeers mocks base method.
+func (m *MockSchedulerClient) FindTaskPeers(ctx context.Context, in *scheduler.FindTaskPeersRequest, opts ...grpc.CallOption) (*scheduler.FindTaskPeersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindTaskPeers", varargs...)
+	ret0, _ := ret[0].(*scheduler.FindTaskPeersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTaskPeers indicates an expected call of FindTaskPeers.
+func (mr *MockSchedulerClientMockRecorder) FindTaskPeers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTaskPeers", reflect.TypeOf((*MockSchedulerClient)(nil).FindTaskPeers), varargs...)
+}
+
 // LeaveTask mocks base method.
 func (m *MockSchedulerClient) LeaveTask(ctx context.Context, in *scheduler.PeerTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -263,6 +303,46 @@ func (mr *MockSchedulerClientMockRecorder) StatTask(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatTask", reflect.TypeOf((*MockSchedulerClient)(nil).StatTask), varargs...)
 }
 
+// SyncProbes mocks base method.
+func (m *MockSchedulerClient) SyncProbes(ctx context.Context, in *scheduler.SyncProbesRequest, opts ...grpc.CallOption) (*scheduler.SyncProbesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SyncProbes", varargs...)
+	ret0, _ := ret[0].(*scheduler.SyncProbesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncProbes indicates an expected call of SyncProbes.
+func (mr *MockSchedulerClientMockRecorder) SyncProbes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncProbes", reflect.TypeOf((*MockSchedulerClient)(nil).SyncProbes), varargs...)
+}
+
+// Watch mocks base method.
+func (m *MockSchedulerClient) Watch(ctx context.Context, in *scheduler.WatchRequest, opts ...grpc.CallOption) (scheduler.Scheduler_WatchClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Watch", varargs...)
+	ret0, _ := ret[0].(scheduler.Scheduler_WatchClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockSchedulerClientMockRecorder) Watch(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockSchedulerClient)(nil).Watch), varargs...)
+}
+
 // MockScheduler_ReportPieceResultClient is a mock of Scheduler_ReportPieceResultClient interface.
 type MockScheduler_ReportPieceResultClient struct {
 	ctrl     *gomock.Controller
@@ -400,6 +480,129 @@ func (mr *MockScheduler_ReportPieceResultClientMockRecorder) Trailer() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockScheduler_ReportPieceResultClient)(nil).Trailer))
 }
 
+// MockScheduler_WatchClient is a mock of Scheduler_WatchClient interface.
+type MockScheduler_WatchClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduler_WatchClientMockRecorder
+}
+
+// MockScheduler_WatchClientMockRecorder is the mock recorder for MockScheduler_WatchClient.
+type MockScheduler_WatchClientMockRecorder struct {
+	mock *MockScheduler_WatchClient
+}
+
+// NewMockScheduler_WatchClient creates a new mock instance.
+func NewMockScheduler_WatchClient(ctrl *gomock.Controller) *MockScheduler_WatchClient {
+	mock := &MockScheduler_WatchClient{ctrl: ctrl}
+	mock.recorder = &MockScheduler_WatchClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduler_WatchClient) EXPECT() *MockScheduler_WatchClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockScheduler_WatchClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockScheduler_WatchClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockScheduler_WatchClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockScheduler_WatchClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockScheduler_WatchClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockScheduler_WatchClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockScheduler_WatchClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockScheduler_WatchClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockScheduler_WatchClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockScheduler_WatchClient) Recv() (*scheduler.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*scheduler.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockScheduler_WatchClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockScheduler_WatchClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockScheduler_WatchClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockScheduler_WatchClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockScheduler_WatchClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockScheduler_WatchClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockScheduler_WatchClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockScheduler_WatchClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockScheduler_WatchClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockScheduler_WatchClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockScheduler_WatchClient)(nil).Trailer))
+}
+
 // MockSchedulerServer is a mock of SchedulerServer interface.
 type MockSchedulerServer struct {
 	ctrl     *gomock.Controller
@@ -423,6 +626,21 @@ func (m *MockSchedulerServer) EXPECT() *MockSchedulerServerMockRecorder {
 	return m.recorder
 }
 
+// AnnounceHost mocks base method.
+func (m *MockSchedulerServer) AnnounceHost(arg0 context.Context, arg1 *scheduler.AnnounceHostRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnnounceHost", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnounceHost indicates an expected call of AnnounceHost.
+func (mr *MockSchedulerServerMockRecorder) AnnounceHost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnounceHost", reflect.TypeOf((*MockSchedulerServer)(nil).AnnounceHost), arg0, arg1)
+}
+
 // AnnounceTask mocks base method.
 func (m *MockSchedulerServer) AnnounceTask(arg0 context.Context, arg1 *scheduler.AnnounceTaskRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -438,6 +656,21 @@ func (mr *MockSchedulerServerMockRecorder) AnnounceTask(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnounceTask", reflect.TypeOf((*MockSchedulerServer)(nil).AnnounceTask), arg0, arg1)
 }
 
+// FindTaskPeers mocks base method.
+func (m *MockSchedulerServer) FindTaskPeers(arg0 context.Context, arg1 *scheduler.FindTaskPeersRequest) (*scheduler.FindTaskPeersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTaskPeers", arg0, arg1)
+	ret0, _ := ret[0].(*scheduler.FindTaskPeersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTaskPeers indicates an expected call of FindTaskPeers.
+func (mr *MockSchedulerServerMockRecorder) FindTaskPeers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTaskPeers", reflect.TypeOf((*MockSchedulerServer)(nil).FindTaskPeers), arg0, arg1)
+}
+
 // LeaveTask mocks base method.
 func (m *MockSchedulerServer) LeaveTask(arg0 context.Context, arg1 *scheduler.PeerTarget) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -512,6 +745,35 @@ func (mr *MockSchedulerServerMockRecorder) StatTask(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatTask", reflect.TypeOf((*MockSchedulerServer)(nil).StatTask), arg0, arg1)
 }
 
+// SyncProbes mocks base method.
+func (m *MockSchedulerServer) SyncProbes(arg0 context.Context, arg1 *scheduler.SyncProbesRequest) (*scheduler.SyncProbesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncProbes", arg0, arg1)
+	ret0, _ := ret[0].(*scheduler.SyncProbesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncProbes indicates an expected call of SyncProbes.
+func (mr *MockSchedulerServerMockRecorder) SyncProbes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncProbes", reflect.TypeOf((*MockSchedulerServer)(nil).SyncProbes), arg0, arg1)
+}
+
+// Watch mocks base method.
+func (m *MockSchedulerServer) Watch(arg0 *scheduler.WatchRequest, arg1 scheduler.Scheduler_WatchServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockSchedulerServerMockRecorder) Watch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockSchedulerServer)(nil).Watch), arg0, arg1)
+}
+
 // MockScheduler_ReportPieceResultServer is a mock of Scheduler_ReportPieceResultServer interface.
 type MockScheduler_ReportPieceResultServer struct {
 	ctrl     *gomock.Controller
@@ -645,3 +907,122 @@ func (mr *MockScheduler_ReportPieceResultServerMockRecorder) SetTrailer(arg0 int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockScheduler_ReportPieceResultServer)(nil).SetTrailer), arg0)
 }
+
+// MockScheduler_WatchServer is a mock of Scheduler_WatchServer interface.
+type MockScheduler_WatchServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduler_WatchServerMockRecorder
+}
+
+// MockScheduler_WatchServerMockRecorder is the mock recorder for MockScheduler_WatchServer.
+type MockScheduler_WatchServerMockRecorder struct {
+	mock *MockScheduler_WatchServer
+}
+
+// NewMockScheduler_WatchServer creates a new mock instance.
+func NewMockScheduler_WatchServer(ctrl *gomock.Controller) *MockScheduler_WatchServer {
+	mock := &MockScheduler_WatchServer{ctrl: ctrl}
+	mock.recorder = &MockScheduler_WatchServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduler_WatchServer) EXPECT() *MockScheduler_WatchServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockScheduler_WatchServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockScheduler_WatchServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockScheduler_WatchServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockScheduler_WatchServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockScheduler_WatchServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockScheduler_WatchServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockScheduler_WatchServer) Send(arg0 *scheduler.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockScheduler_WatchServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockScheduler_WatchServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockScheduler_WatchServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockScheduler_WatchServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockScheduler_WatchServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockScheduler_WatchServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockScheduler_WatchServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockScheduler_WatchServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockScheduler_WatchServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockScheduler_WatchServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockScheduler_WatchServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockScheduler_WatchServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockScheduler_WatchServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockScheduler_WatchServer)(nil).SetTrailer), arg0)
+}
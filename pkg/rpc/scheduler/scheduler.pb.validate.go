@@ -692,6 +692,21 @@ func (m *PeerPacket) Validate() error {
 
 	// no validation rules for Code
 
+	for idx, item := range m.GetPieceRanges() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PeerPacketValidationError{
+					field:  fmt.Sprintf("PieceRanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	switch m.ErrorDetail.(type) {
 
 	case *PeerPacket_SourceError:
@@ -1295,41 +1310,291 @@ var _ interface {
 	ErrorName() string
 } = AnnounceTaskRequestValidationError{}
 
-// Validate checks the field values on PeerPacket_DestPeer with the rules
+// Validate checks the field values on FindTaskPeersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *PeerPacket_DestPeer) Validate() error {
+func (m *FindTaskPeersRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		return FindTaskPeersRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for SecurityDomain
+
+	if m.GetLimit() < 1 {
+		return FindTaskPeersRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 1",
+		}
+	}
+
+	return nil
+}
+
+// FindTaskPeersRequestValidationError is the validation error returned by
+// FindTaskPeersRequest.Validate if the designated constraints aren't met.
+type FindTaskPeersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindTaskPeersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindTaskPeersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindTaskPeersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindTaskPeersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindTaskPeersRequestValidationError) ErrorName() string {
+	return "FindTaskPeersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindTaskPeersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindTaskPeersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindTaskPeersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindTaskPeersRequestValidationError{}
+
+// Validate checks the field values on FindTaskPeersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FindTaskPeersResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetPeers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FindTaskPeersResponseValidationError{
+					field:  fmt.Sprintf("Peers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// FindTaskPeersResponseValidationError is the validation error returned by
+// FindTaskPeersResponse.Validate if the designated constraints aren't met.
+type FindTaskPeersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindTaskPeersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindTaskPeersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindTaskPeersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindTaskPeersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindTaskPeersResponseValidationError) ErrorName() string {
+	return "FindTaskPeersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindTaskPeersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindTaskPeersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindTaskPeersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindTaskPeersResponseValidationError{}
+
+// Validate checks the field values on Probe with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Probe) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetHostId()) < 1 {
+		return ProbeValidationError{
+			field:  "HostId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if m.GetRtt() < 0 {
+		return ProbeValidationError{
+			field:  "Rtt",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	if val := m.GetLoss(); val < 0 || val > 1 {
+		return ProbeValidationError{
+			field:  "Loss",
+			reason: "value must be inside range [0, 1]",
+		}
+	}
+
+	return nil
+}
+
+// ProbeValidationError is the validation error returned by Probe.Validate if
+// the designated constraints aren't met.
+type ProbeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProbeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProbeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProbeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProbeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProbeValidationError) ErrorName() string { return "ProbeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProbeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProbe.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProbeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProbeValidationError{}
+
+// Validate checks the field values on ProbeTarget with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ProbeTarget) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	if utf8.RuneCountInString(m.GetHostId()) < 1 {
+		return ProbeTargetValidationError{
+			field:  "HostId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
 	if ip := net.ParseIP(m.GetIp()); ip == nil {
-		return PeerPacket_DestPeerValidationError{
+		return ProbeTargetValidationError{
 			field:  "Ip",
 			reason: "value must be a valid IP address",
 		}
 	}
 
 	if val := m.GetRpcPort(); val < 1024 || val >= 65535 {
-		return PeerPacket_DestPeerValidationError{
+		return ProbeTargetValidationError{
 			field:  "RpcPort",
 			reason: "value must be inside range [1024, 65535)",
 		}
 	}
 
-	if utf8.RuneCountInString(m.GetPeerId()) < 1 {
-		return PeerPacket_DestPeerValidationError{
-			field:  "PeerId",
-			reason: "value length must be at least 1 runes",
-		}
-	}
-
 	return nil
 }
 
-// PeerPacket_DestPeerValidationError is the validation error returned by
-// PeerPacket_DestPeer.Validate if the designated constraints aren't met.
-type PeerPacket_DestPeerValidationError struct {
+// ProbeTargetValidationError is the validation error returned by
+// ProbeTarget.Validate if the designated constraints aren't met.
+type ProbeTargetValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1337,24 +1602,22 @@ type PeerPacket_DestPeerValidationError struct {
 }
 
 // Field function returns field value.
-func (e PeerPacket_DestPeerValidationError) Field() string { return e.field }
+func (e ProbeTargetValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PeerPacket_DestPeerValidationError) Reason() string { return e.reason }
+func (e ProbeTargetValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PeerPacket_DestPeerValidationError) Cause() error { return e.cause }
+func (e ProbeTargetValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PeerPacket_DestPeerValidationError) Key() bool { return e.key }
+func (e ProbeTargetValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PeerPacket_DestPeerValidationError) ErrorName() string {
-	return "PeerPacket_DestPeerValidationError"
-}
+func (e ProbeTargetValidationError) ErrorName() string { return "ProbeTargetValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PeerPacket_DestPeerValidationError) Error() string {
+func (e ProbeTargetValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1366,14 +1629,14 @@ func (e PeerPacket_DestPeerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPeerPacket_DestPeer.%s: %s%s",
+		"invalid %sProbeTarget.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PeerPacket_DestPeerValidationError{}
+var _ error = ProbeTargetValidationError{}
 
 var _ interface {
 	Field() string
@@ -1381,4 +1644,715 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PeerPacket_DestPeerValidationError{}
+} = ProbeTargetValidationError{}
+
+// Validate checks the field values on SyncProbesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *SyncProbesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetPeerHost() == nil {
+		return SyncProbesRequestValidationError{
+			field:  "PeerHost",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetPeerHost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SyncProbesRequestValidationError{
+				field:  "PeerHost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetProbes() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncProbesRequestValidationError{
+					field:  fmt.Sprintf("Probes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// SyncProbesRequestValidationError is the validation error returned by
+// SyncProbesRequest.Validate if the designated constraints aren't met.
+type SyncProbesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncProbesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncProbesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncProbesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncProbesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncProbesRequestValidationError) ErrorName() string {
+	return "SyncProbesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncProbesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncProbesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncProbesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncProbesRequestValidationError{}
+
+// Validate checks the field values on SyncProbesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SyncProbesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetTargets() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncProbesResponseValidationError{
+					field:  fmt.Sprintf("Targets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// SyncProbesResponseValidationError is the validation error returned by
+// SyncProbesResponse.Validate if the designated constraints aren't met.
+type SyncProbesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncProbesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncProbesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncProbesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncProbesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncProbesResponseValidationError) ErrorName() string {
+	return "SyncProbesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncProbesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncProbesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncProbesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncProbesResponseValidationError{}
+
+// Validate checks the field values on HostStats with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *HostStats) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for RxBandwidth
+
+	// no validation rules for TxBandwidth
+
+	// no validation rules for UploadBandwidthLimit
+
+	// no validation rules for DiskFree
+
+	// no validation rules for DiskTotal
+
+	if val := m.GetCpuRatio(); val < 0 || val > 1 {
+		return HostStatsValidationError{
+			field:  "CpuRatio",
+			reason: "value must be inside range [0, 1]",
+		}
+	}
+
+	if val := m.GetMemoryRatio(); val < 0 || val > 1 {
+		return HostStatsValidationError{
+			field:  "MemoryRatio",
+			reason: "value must be inside range [0, 1]",
+		}
+	}
+
+	return nil
+}
+
+// HostStatsValidationError is the validation error returned by
+// HostStats.Validate if the designated constraints aren't met.
+type HostStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HostStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HostStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HostStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HostStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HostStatsValidationError) ErrorName() string { return "HostStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HostStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHostStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HostStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HostStatsValidationError{}
+
+// Validate checks the field values on AnnounceHostRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AnnounceHostRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetPeerHost() == nil {
+		return AnnounceHostRequestValidationError{
+			field:  "PeerHost",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetPeerHost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnnounceHostRequestValidationError{
+				field:  "PeerHost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetStats() == nil {
+		return AnnounceHostRequestValidationError{
+			field:  "Stats",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnnounceHostRequestValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// AnnounceHostRequestValidationError is the validation error returned by
+// AnnounceHostRequest.Validate if the designated constraints aren't met.
+type AnnounceHostRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnnounceHostRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnnounceHostRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnnounceHostRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnnounceHostRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnnounceHostRequestValidationError) ErrorName() string {
+	return "AnnounceHostRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnnounceHostRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnnounceHostRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnnounceHostRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnnounceHostRequestValidationError{}
+
+// Validate checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Event) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Type
+
+	// no validation rules for TaskId
+
+	// no validation rules for Url
+
+	// no validation rules for Tag
+
+	// no validation rules for Application
+
+	// no validation rules for PeerId
+
+	// no validation rules for HostId
+
+	// no validation rules for State
+
+	if v, ok := interface{}(m.GetSourceError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "SourceError",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedAt
+
+	return nil
+}
+
+// EventValidationError is the validation error returned by Event.Validate if
+// the designated constraints aren't met.
+type EventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventValidationError) ErrorName() string { return "EventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventValidationError{}
+
+// Validate checks the field values on WatchRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *WatchRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for TaskId
+
+	// no validation rules for Tag
+
+	// no validation rules for Application
+
+	// no validation rules for HostId
+
+	return nil
+}
+
+// WatchRequestValidationError is the validation error returned by
+// WatchRequest.Validate if the designated constraints aren't met.
+type WatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchRequestValidationError) ErrorName() string { return "WatchRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchRequestValidationError{}
+
+// Validate checks the field values on PeerPacket_DestPeer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PeerPacket_DestPeer) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if ip := net.ParseIP(m.GetIp()); ip == nil {
+		return PeerPacket_DestPeerValidationError{
+			field:  "Ip",
+			reason: "value must be a valid IP address",
+		}
+	}
+
+	if val := m.GetRpcPort(); val < 1024 || val >= 65535 {
+		return PeerPacket_DestPeerValidationError{
+			field:  "RpcPort",
+			reason: "value must be inside range [1024, 65535)",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetPeerId()) < 1 {
+		return PeerPacket_DestPeerValidationError{
+			field:  "PeerId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// PeerPacket_DestPeerValidationError is the validation error returned by
+// PeerPacket_DestPeer.Validate if the designated constraints aren't met.
+type PeerPacket_DestPeerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeerPacket_DestPeerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeerPacket_DestPeerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeerPacket_DestPeerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeerPacket_DestPeerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeerPacket_DestPeerValidationError) ErrorName() string {
+	return "PeerPacket_DestPeerValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PeerPacket_DestPeerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeerPacket_DestPeer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeerPacket_DestPeerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeerPacket_DestPeerValidationError{}
+
+// Validate checks the field values on PeerPacket_PieceRange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PeerPacket_PieceRange) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetPeerId()) < 1 {
+		return PeerPacket_PieceRangeValidationError{
+			field:  "PeerId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if m.GetStartNum() < 0 {
+		return PeerPacket_PieceRangeValidationError{
+			field:  "StartNum",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	if m.GetEndNum() < 1 {
+		return PeerPacket_PieceRangeValidationError{
+			field:  "EndNum",
+			reason: "value must be greater than or equal to 1",
+		}
+	}
+
+	return nil
+}
+
+// PeerPacket_PieceRangeValidationError is the validation error returned by
+// PeerPacket_PieceRange.Validate if the designated constraints aren't met.
+type PeerPacket_PieceRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeerPacket_PieceRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeerPacket_PieceRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeerPacket_PieceRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeerPacket_PieceRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeerPacket_PieceRangeValidationError) ErrorName() string {
+	return "PeerPacket_PieceRangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PeerPacket_PieceRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeerPacket_PieceRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeerPacket_PieceRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeerPacket_PieceRangeValidationError{}
@@ -0,0 +1,320 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"d7y.io/dragonfly/v2/scheduler/config"
+	"d7y.io/dragonfly/v2/scheduler/resource"
+)
+
+const (
+	// RouterGroupTasks is the router group of tasks.
+	RouterGroupTasks = "/tasks"
+
+	// RouterGroupHosts is the router group of hosts.
+	RouterGroupHosts = "/hosts"
+)
+
+// admin provides introspection of scheduler resource.
+type admin struct {
+	resource resource.Resource
+}
+
+// New returns a new admin http server, it shows tasks, peers, hosts
+// and distribution trees in the scheduler. The admin service is served
+// over http only, there is no grpc service of it.
+func New(cfg *config.AdminConfig, resource resource.Resource) *http.Server {
+	a := &admin{resource: resource}
+	return &http.Server{
+		Addr:    cfg.Addr,
+		Handler: a.initRouter(),
+	}
+}
+
+// Initialize router of gin.
+func (a *admin) initRouter() *gin.Engine {
+	r := gin.New()
+
+	// Middleware
+	r.Use(gin.Recovery())
+
+	// Health Check.
+	r.GET("/healthy", a.getHealth)
+
+	// Tasks
+	t := r.Group(RouterGroupTasks)
+	t.GET("", a.getTasks)
+	t.GET(":id", a.getTask)
+	t.GET(":id/peers", a.getPeers)
+	t.GET(":id/tree", a.getTree)
+
+	// Hosts
+	h := r.Group(RouterGroupHosts)
+	h.GET("", a.getHosts)
+
+	return r
+}
+
+// getHealth uses to check server health.
+func (a *admin) getHealth(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, http.StatusText(http.StatusOK))
+}
+
+// getTasks uses to list tasks with filters.
+func (a *admin) getTasks(ctx *gin.Context) {
+	var query GetTasksQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	tasks := []*Task{}
+	a.resource.TaskManager().Range(func(_, value any) bool {
+		task, ok := value.(*resource.Task)
+		if !ok {
+			return true
+		}
+
+		if query.State != "" && !task.FSM.Is(query.State) {
+			return true
+		}
+
+		if query.Type != "" && task.Type.String() != query.Type {
+			return true
+		}
+
+		if query.Tag != "" && (task.URLMeta == nil || task.URLMeta.Tag != query.Tag) {
+			return true
+		}
+
+		if query.URL != "" && !strings.Contains(task.URL, query.URL) {
+			return true
+		}
+
+		tasks = append(tasks, newTask(task))
+		return true
+	})
+
+	// Latest tasks are listed first.
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].CreatedAt.After(tasks[j].CreatedAt)
+	})
+
+	if query.Limit > 0 && len(tasks) > query.Limit {
+		tasks = tasks[:query.Limit]
+	}
+
+	ctx.JSON(http.StatusOK, tasks)
+}
+
+// getTask uses to get task.
+func (a *admin) getTask(ctx *gin.Context) {
+	var params TaskParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	task, ok := a.resource.TaskManager().Load(params.ID)
+	if !ok {
+		ctx.JSON(http.StatusNotFound, gin.H{"errors": http.StatusText(http.StatusNotFound)})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newTask(task))
+}
+
+// getPeers uses to list peers of task and their states.
+func (a *admin) getPeers(ctx *gin.Context) {
+	var params TaskParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	var query GetPeersQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	task, ok := a.resource.TaskManager().Load(params.ID)
+	if !ok {
+		ctx.JSON(http.StatusNotFound, gin.H{"errors": http.StatusText(http.StatusNotFound)})
+		return
+	}
+
+	peers := []*Peer{}
+	task.Peers.Range(func(_, value any) bool {
+		peer, ok := value.(*resource.Peer)
+		if !ok {
+			return true
+		}
+
+		if query.State != "" && !peer.FSM.Is(query.State) {
+			return true
+		}
+
+		peers = append(peers, newPeer(peer))
+		return true
+	})
+
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].CreatedAt.Before(peers[j].CreatedAt)
+	})
+
+	ctx.JSON(http.StatusOK, peers)
+}
+
+// getTree uses to export distribution tree of task.
+func (a *admin) getTree(ctx *gin.Context) {
+	var params TaskParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	var query GetTreeQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	task, ok := a.resource.TaskManager().Load(params.ID)
+	if !ok {
+		ctx.JSON(http.StatusNotFound, gin.H{"errors": http.StatusText(http.StatusNotFound)})
+		return
+	}
+
+	if query.Format == TreeFormatDOT {
+		ctx.String(http.StatusOK, treeToDOT(task))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newTree(task))
+}
+
+// getHosts uses to list hosts and their upload load.
+func (a *admin) getHosts(ctx *gin.Context) {
+	var query GetHostsQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": err.Error()})
+		return
+	}
+
+	hosts := []*Host{}
+	a.resource.HostManager().Range(func(_, value any) bool {
+		host, ok := value.(*resource.Host)
+		if !ok {
+			return true
+		}
+
+		if query.Type != "" && host.Type.Name() != query.Type {
+			return true
+		}
+
+		hosts = append(hosts, newHost(host))
+		return true
+	})
+
+	// Busiest hosts are listed first.
+	sort.Slice(hosts, func(i, j int) bool {
+		return hosts[i].UploadPeerCount > hosts[j].UploadPeerCount
+	})
+
+	ctx.JSON(http.StatusOK, hosts)
+}
+
+// newTask converts resource.Task to Task.
+func newTask(task *resource.Task) *Task {
+	t := &Task{
+		ID:                    task.ID,
+		URL:                   task.URL,
+		Type:                  task.Type.String(),
+		State:                 task.FSM.Current(),
+		ContentLength:         task.ContentLength.Load(),
+		TotalPieceCount:       task.TotalPieceCount.Load(),
+		PeerCount:             task.PeerCount.Load(),
+		BackToSourcePeerCount: task.BackToSourcePeers.Len(),
+		BackToSourceLimit:     task.BackToSourceLimit.Load(),
+		PeerFailedCount:       task.PeerFailedCount.Load(),
+		CreatedAt:             task.CreateAt.Load(),
+		UpdatedAt:             task.UpdateAt.Load(),
+	}
+
+	if task.URLMeta != nil {
+		t.Tag = task.URLMeta.Tag
+	}
+
+	return t
+}
+
+// newPeer converts resource.Peer to Peer.
+func newPeer(peer *resource.Peer) *Peer {
+	p := &Peer{
+		ID:                 peer.ID,
+		Tag:                peer.Tag,
+		State:              peer.FSM.Current(),
+		HostID:             peer.Host.ID,
+		Hostname:           peer.Host.Hostname,
+		IP:                 peer.Host.IP,
+		HostType:           peer.Host.Type.Name(),
+		ChildCount:         peer.ChildCount.Load(),
+		FinishedPieceCount: peer.Pieces.Count(),
+		IsBackToSource:     peer.IsBackToSource.Load(),
+		ParentIDs:          []string{},
+		CreatedAt:          peer.CreateAt.Load(),
+		UpdatedAt:          peer.UpdateAt.Load(),
+	}
+
+	if parent, ok := peer.LoadParent(); ok {
+		p.ParentID = parent.ID
+	}
+
+	for _, parent := range peer.Parents() {
+		p.ParentIDs = append(p.ParentIDs, parent.ID)
+	}
+
+	return p
+}
+
+// newHost converts resource.Host to Host.
+func newHost(host *resource.Host) *Host {
+	return &Host{
+		ID:              host.ID,
+		Type:            host.Type.Name(),
+		Hostname:        host.Hostname,
+		IP:              host.IP,
+		Port:            host.Port,
+		DownloadPort:    host.DownloadPort,
+		IDC:             host.IDC,
+		NetTopology:     host.NetTopology,
+		Location:        host.Location,
+		UploadPeerCount: host.UploadPeerCount.Load(),
+		UploadLoadLimit: host.UploadLoadLimit.Load(),
+		FreeUploadLoad:  host.FreeUploadLoad(),
+		PeerCount:       host.PeerCount.Load(),
+		CreatedAt:       host.CreateAt.Load(),
+		UpdatedAt:       host.UpdateAt.Load(),
+	}
+}
@@ -0,0 +1,312 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"d7y.io/dragonfly/v2/pkg/idgen"
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	rpcscheduler "d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+	"d7y.io/dragonfly/v2/scheduler/config"
+	"d7y.io/dragonfly/v2/scheduler/resource"
+)
+
+var (
+	mockRawHost = &rpcscheduler.PeerHost{
+		Id:             idgen.HostID("hostname", 8003),
+		Ip:             "127.0.0.1",
+		RpcPort:        8003,
+		DownPort:       8001,
+		HostName:       "hostname",
+		SecurityDomain: "security_domain",
+		Location:       "location",
+		Idc:            "idc",
+		NetTopology:    "net_topology",
+	}
+
+	mockRawSeedHost = &rpcscheduler.PeerHost{
+		Id:             idgen.HostID("hostname_seed", 8003),
+		Ip:             "127.0.0.2",
+		RpcPort:        8003,
+		DownPort:       8001,
+		HostName:       "hostname_seed",
+		SecurityDomain: "security_domain",
+		Location:       "location",
+		Idc:            "idc",
+		NetTopology:    "net_topology",
+	}
+
+	mockTaskURLMeta = &base.UrlMeta{
+		Digest: "digest",
+		Tag:    "tag",
+		Range:  "range",
+		Filter: "filter",
+		Header: map[string]string{
+			"content-length": "100",
+		},
+	}
+
+	mockTaskURL = "http://example.com/foo"
+	mockTaskID  = idgen.TaskID(mockTaskURL, mockTaskURLMeta)
+)
+
+func newMockResource(ctl *gomock.Controller) (*resource.MockResource, *resource.Task, *resource.Peer, *resource.Peer) {
+	seedHost := resource.NewHost(mockRawSeedHost, resource.WithHostType(resource.HostTypeSuperSeed))
+	host := resource.NewHost(mockRawHost)
+	task := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+	task.TotalPieceCount.Store(2)
+	task.FSM.SetState(resource.TaskStateRunning)
+
+	seedPeer := resource.NewPeer(idgen.SeedPeerID(mockRawSeedHost.Ip), task, seedHost)
+	seedPeer.FSM.SetState(resource.PeerStateSucceeded)
+	seedPeer.Pieces.Set(0)
+	seedPeer.Pieces.Set(1)
+	task.StorePeer(seedPeer)
+
+	peer := resource.NewPeer(idgen.PeerID(mockRawHost.Ip), task, host)
+	peer.FSM.SetState(resource.PeerStateRunning)
+	peer.Pieces.Set(0)
+	peer.StoreParent(seedPeer)
+	task.StorePeer(peer)
+
+	taskManager := resource.NewMockTaskManager(ctl)
+	taskManager.EXPECT().Load(gomock.Any()).DoAndReturn(func(id string) (*resource.Task, bool) {
+		if id == task.ID {
+			return task, true
+		}
+
+		return nil, false
+	}).AnyTimes()
+	taskManager.EXPECT().Range(gomock.Any()).Do(func(f func(any, any) bool) {
+		f(task.ID, task)
+	}).AnyTimes()
+
+	hostManager := resource.NewMockHostManager(ctl)
+	hostManager.EXPECT().Range(gomock.Any()).Do(func(f func(any, any) bool) {
+		if !f(host.ID, host) {
+			return
+		}
+		f(seedHost.ID, seedHost)
+	}).AnyTimes()
+
+	res := resource.NewMockResource(ctl)
+	res.EXPECT().TaskManager().Return(taskManager).AnyTimes()
+	res.EXPECT().HostManager().Return(hostManager).AnyTimes()
+	return res, task, seedPeer, peer
+}
+
+func TestAdmin_New(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	res, _, _, _ := newMockResource(ctl)
+
+	server := New(&config.AdminConfig{Enable: true, Addr: ":8004"}, res)
+	assert := assert.New(t)
+	assert.Equal(server.Addr, ":8004")
+	assert.NotNil(server.Handler)
+}
+
+func TestAdmin_Handlers(t *testing.T) {
+	tests := []struct {
+		name   string
+		url    string
+		expect func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer)
+	}{
+		{
+			name: "get health",
+			url:  "/healthy",
+			expect: func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(w.Code, http.StatusOK)
+			},
+		},
+		{
+			name: "get tasks",
+			url:  "/tasks",
+			expect: func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(w.Code, http.StatusOK)
+				var tasks []*Task
+				assert.NoError(json.Unmarshal(w.Body.Bytes(), &tasks))
+				assert.Len(tasks, 1)
+				assert.Equal(tasks[0].ID, task.ID)
+				assert.Equal(tasks[0].Tag, mockTaskURLMeta.Tag)
+				assert.Equal(tasks[0].State, resource.TaskStateRunning)
+				assert.Equal(tasks[0].PeerCount, int32(2))
+			},
+		},
+		{
+			name: "get tasks with state filter",
+			url:  "/tasks?state=Succeeded",
+			expect: func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(w.Code, http.StatusOK)
+				var tasks []*Task
+				assert.NoError(json.Unmarshal(w.Body.Bytes(), &tasks))
+				assert.Len(tasks, 0)
+			},
+		},
+		{
+			name: "get tasks with url filter",
+			url:  "/tasks?url=example.com",
+			expect: func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(w.Code, http.StatusOK)
+				var tasks []*Task
+				assert.NoError(json.Unmarshal(w.Body.Bytes(), &tasks))
+				assert.Len(tasks, 1)
+			},
+		},
+		{
+			name: "get tasks with invalid state",
+			url:  "/tasks?state=foo",
+			expect: func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(w.Code, http.StatusUnprocessableEntity)
+			},
+		},
+		{
+			name: "get task",
+			url:  "/tasks/" + mockTaskID,
+			expect: func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(w.Code, http.StatusOK)
+				var result Task
+				assert.NoError(json.Unmarshal(w.Body.Bytes(), &result))
+				assert.Equal(result.ID, task.ID)
+				assert.Equal(result.URL, task.URL)
+				assert.Equal(result.TotalPieceCount, int32(2))
+			},
+		},
+		{
+			name: "task not found",
+			url:  "/tasks/foo",
+			expect: func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(w.Code, http.StatusNotFound)
+			},
+		},
+		{
+			name: "get peers",
+			url:  "/tasks/" + mockTaskID + "/peers",
+			expect: func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(w.Code, http.StatusOK)
+				var peers []*Peer
+				assert.NoError(json.Unmarshal(w.Body.Bytes(), &peers))
+				assert.Len(peers, 2)
+			},
+		},
+		{
+			name: "get peers with state filter",
+			url:  "/tasks/" + mockTaskID + "/peers?state=Running",
+			expect: func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(w.Code, http.StatusOK)
+				var peers []*Peer
+				assert.NoError(json.Unmarshal(w.Body.Bytes(), &peers))
+				assert.Len(peers, 1)
+				assert.Equal(peers[0].ID, peer.ID)
+				assert.Equal(peers[0].ParentID, seedPeer.ID)
+				assert.Equal(peers[0].ParentIDs, []string{seedPeer.ID})
+				assert.Equal(peers[0].HostType, resource.HostTypeNormalName)
+				assert.Equal(peers[0].FinishedPieceCount, uint(1))
+			},
+		},
+		{
+			name: "get tree",
+			url:  "/tasks/" + mockTaskID + "/tree",
+			expect: func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(w.Code, http.StatusOK)
+				var roots []*TreeNode
+				assert.NoError(json.Unmarshal(w.Body.Bytes(), &roots))
+				assert.Len(roots, 1)
+				assert.Equal(roots[0].ID, seedPeer.ID)
+				assert.Equal(roots[0].HostType, "super")
+				assert.Len(roots[0].Children, 1)
+				assert.Equal(roots[0].Children[0].ID, peer.ID)
+			},
+		},
+		{
+			name: "get tree in dot format",
+			url:  "/tasks/" + mockTaskID + "/tree?format=dot",
+			expect: func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(w.Code, http.StatusOK)
+				body := w.Body.String()
+				assert.True(strings.HasPrefix(body, "digraph"))
+				assert.Contains(body, "\""+seedPeer.ID+"\" -> \""+peer.ID+"\"")
+			},
+		},
+		{
+			name: "get tree with invalid format",
+			url:  "/tasks/" + mockTaskID + "/tree?format=foo",
+			expect: func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(w.Code, http.StatusUnprocessableEntity)
+			},
+		},
+		{
+			name: "get hosts",
+			url:  "/hosts",
+			expect: func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(w.Code, http.StatusOK)
+				var hosts []*Host
+				assert.NoError(json.Unmarshal(w.Body.Bytes(), &hosts))
+				assert.Len(hosts, 2)
+				assert.Equal(hosts[0].ID, mockRawSeedHost.Id)
+				assert.Equal(hosts[0].UploadPeerCount, int32(1))
+			},
+		},
+		{
+			name: "get hosts with type filter",
+			url:  "/hosts?type=normal",
+			expect: func(t *testing.T, w *httptest.ResponseRecorder, task *resource.Task, seedPeer, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(w.Code, http.StatusOK)
+				var hosts []*Host
+				assert.NoError(json.Unmarshal(w.Body.Bytes(), &hosts))
+				assert.Len(hosts, 1)
+				assert.Equal(hosts[0].ID, mockRawHost.Id)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			res, task, seedPeer, peer := newMockResource(ctl)
+
+			a := &admin{resource: res}
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			a.initRouter().ServeHTTP(w, req)
+			tc.expect(t, w, task, seedPeer, peer)
+		})
+	}
+}
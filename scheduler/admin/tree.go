@@ -0,0 +1,128 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"d7y.io/dragonfly/v2/scheduler/resource"
+)
+
+// taskPeers returns peers of task sorted by id.
+func taskPeers(task *resource.Task) []*resource.Peer {
+	var peers []*resource.Peer
+	task.Peers.Range(func(_, value any) bool {
+		if peer, ok := value.(*resource.Peer); ok {
+			peers = append(peers, peer)
+		}
+
+		return true
+	})
+
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].ID < peers[j].ID
+	})
+
+	return peers
+}
+
+// newTree returns the distribution tree of task, peers without parent in
+// the task are roots of the tree. Only edges of main parents are in the tree.
+func newTree(task *resource.Task) []*TreeNode {
+	peers := taskPeers(task)
+	nodes := make(map[string]*TreeNode, len(peers))
+	for _, peer := range peers {
+		nodes[peer.ID] = &TreeNode{
+			ID:                 peer.ID,
+			State:              peer.FSM.Current(),
+			HostID:             peer.Host.ID,
+			Hostname:           peer.Host.Hostname,
+			IP:                 peer.Host.IP,
+			HostType:           peer.Host.Type.Name(),
+			FinishedPieceCount: peer.Pieces.Count(),
+			Children:           []*TreeNode{},
+		}
+	}
+
+	roots := []*TreeNode{}
+	for _, peer := range peers {
+		node := nodes[peer.ID]
+		parent, ok := peer.LoadParent()
+		if !ok {
+			roots = append(roots, node)
+			continue
+		}
+
+		parentNode, ok := nodes[parent.ID]
+		if !ok || inSubtree(node, parentNode.ID) {
+			roots = append(roots, node)
+			continue
+		}
+
+		parentNode.Children = append(parentNode.Children, node)
+	}
+
+	return roots
+}
+
+// inSubtree determines whether the node with id is in the subtree of node,
+// it prevents the tree from producing an infinite loop.
+func inSubtree(node *TreeNode, id string) bool {
+	if node.ID == id {
+		return true
+	}
+
+	for _, child := range node.Children {
+		if inSubtree(child, id) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// treeToDOT returns the distribution graph of task in graphviz dot format,
+// edges of steal peers are dashed.
+func treeToDOT(task *resource.Task) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", task.ID)
+	b.WriteString("  node [shape=box];\n")
+
+	peers := taskPeers(task)
+	for _, peer := range peers {
+		fmt.Fprintf(&b, "  %q [label=%q];\n", peer.ID,
+			fmt.Sprintf("%s\n%s(%s)\n%s %d/%d", peer.ID, peer.Host.Hostname, peer.Host.IP,
+				peer.FSM.Current(), peer.Pieces.Count(), task.TotalPieceCount.Load()))
+	}
+
+	for _, peer := range peers {
+		mainParent, _ := peer.LoadParent()
+		for _, parent := range peer.Parents() {
+			if mainParent != nil && mainParent.ID == parent.ID {
+				fmt.Fprintf(&b, "  %q -> %q;\n", parent.ID, peer.ID)
+				continue
+			}
+
+			fmt.Fprintf(&b, "  %q -> %q [style=dashed];\n", parent.ID, peer.ID)
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
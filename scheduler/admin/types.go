@@ -0,0 +1,117 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import "time"
+
+const (
+	// TreeFormatJSON is the json format of distribution tree.
+	TreeFormatJSON = "json"
+
+	// TreeFormatDOT is the graphviz dot format of distribution tree.
+	TreeFormatDOT = "dot"
+)
+
+type TaskParams struct {
+	ID string `uri:"id" binding:"required"`
+}
+
+type GetTasksQuery struct {
+	State string `form:"state" binding:"omitempty,oneof=Pending Running Succeeded Failed"`
+	Type  string `form:"type" binding:"omitempty,oneof=Normal DfCache DfStore"`
+	Tag   string `form:"tag" binding:"omitempty"`
+	URL   string `form:"url" binding:"omitempty"`
+	Limit int    `form:"limit" binding:"omitempty,gte=1"`
+}
+
+type GetPeersQuery struct {
+	State string `form:"state" binding:"omitempty"`
+}
+
+type GetTreeQuery struct {
+	Format string `form:"format,default=json" binding:"omitempty,oneof=json dot"`
+}
+
+type GetHostsQuery struct {
+	Type string `form:"type" binding:"omitempty,oneof=normal super strong weak"`
+}
+
+// Task is the task information of scheduler.
+type Task struct {
+	ID                    string    `json:"id"`
+	URL                   string    `json:"url"`
+	Type                  string    `json:"type"`
+	Tag                   string    `json:"tag"`
+	State                 string    `json:"state"`
+	ContentLength         int64     `json:"content_length"`
+	TotalPieceCount       int32     `json:"total_piece_count"`
+	PeerCount             int32     `json:"peer_count"`
+	BackToSourcePeerCount uint      `json:"back_to_source_peer_count"`
+	BackToSourceLimit     int32     `json:"back_to_source_limit"`
+	PeerFailedCount       int32     `json:"peer_failed_count"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+// Peer is the peer information of scheduler.
+type Peer struct {
+	ID                 string    `json:"id"`
+	Tag                string    `json:"tag"`
+	State              string    `json:"state"`
+	HostID             string    `json:"host_id"`
+	Hostname           string    `json:"hostname"`
+	IP                 string    `json:"ip"`
+	HostType           string    `json:"host_type"`
+	ParentID           string    `json:"parent_id"`
+	ParentIDs          []string  `json:"parent_ids"`
+	ChildCount         int32     `json:"child_count"`
+	FinishedPieceCount uint      `json:"finished_piece_count"`
+	IsBackToSource     bool      `json:"is_back_to_source"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// Host is the host information and upload load of scheduler.
+type Host struct {
+	ID              string    `json:"id"`
+	Type            string    `json:"type"`
+	Hostname        string    `json:"hostname"`
+	IP              string    `json:"ip"`
+	Port            int32     `json:"port"`
+	DownloadPort    int32     `json:"download_port"`
+	IDC             string    `json:"idc"`
+	NetTopology     string    `json:"net_topology"`
+	Location        string    `json:"location"`
+	UploadPeerCount int32     `json:"upload_peer_count"`
+	UploadLoadLimit int32     `json:"upload_load_limit"`
+	FreeUploadLoad  int32     `json:"free_upload_load"`
+	PeerCount       int32     `json:"peer_count"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// TreeNode is the node of task distribution tree.
+type TreeNode struct {
+	ID                 string      `json:"id"`
+	State              string      `json:"state"`
+	HostID             string      `json:"host_id"`
+	Hostname           string      `json:"hostname"`
+	IP                 string      `json:"ip"`
+	HostType           string      `json:"host_type"`
+	FinishedPieceCount uint        `json:"finished_piece_count"`
+	Children           []*TreeNode `json:"children"`
+}
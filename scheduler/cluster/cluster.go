@@ -0,0 +1,452 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+//go:generate mockgen -destination mocks/cluster_mock.go -source cluster.go -package mocks
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/serialx/hashring"
+	"google.golang.org/grpc"
+
+	logger "d7y.io/dragonfly/v2/internal/dflog"
+	"d7y.io/dragonfly/v2/pkg/dfnet"
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	rpcscheduler "d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+	schedulerclient "d7y.io/dragonfly/v2/pkg/rpc/scheduler/client"
+	"d7y.io/dragonfly/v2/scheduler/config"
+	"d7y.io/dragonfly/v2/scheduler/metrics"
+	"d7y.io/dragonfly/v2/scheduler/resource"
+)
+
+const (
+	// announceTimeout is the timeout of announcing a peer to other scheduler.
+	announceTimeout = 10 * time.Second
+
+	// announceConcurrency is the number of schedulers announced to concurrently in a round.
+	announceConcurrency = 8
+)
+
+// Cluster is the members of scheduler cluster, the tasks are owned by schedulers
+// through consistent hashing of task id, which is the same as the hashring used by
+// dfdaemon to pick a scheduler. The owner replicates succeeded peers of task to
+// the successor, which is the scheduler taking over the task when the owner leaves,
+// so an outage of scheduler does not force the peers back-to-source.
+type Cluster interface {
+	// Owner returns the address of scheduler owning the task.
+	Owner(taskID string) (string, bool)
+
+	// Successor returns the address of scheduler taking over the task when the owner leaves.
+	Successor(taskID string) (string, bool)
+
+	// IsOwner returns whether the task is owned by the current scheduler.
+	IsOwner(taskID string) bool
+
+	// Members returns the addresses of schedulers in the cluster.
+	Members() []string
+
+	// Serve replicates and hands off tasks periodically.
+	Serve()
+
+	// Stop stops replicating and closes the clients of schedulers.
+	Stop()
+
+	// Observer is dynconfig observer interface.
+	config.Observer
+}
+
+type cluster struct {
+	// self is the address of the current scheduler.
+	self string
+
+	// interval is the interval of replicating.
+	interval time.Duration
+
+	// resource is resource interface.
+	resource resource.Resource
+
+	// newClient returns the grpc client of scheduler.
+	newClient func(addr string) (schedulerclient.Client, error)
+
+	// members is the addresses of schedulers in the cluster.
+	members []string
+
+	// ring is the hashring of members.
+	ring *hashring.HashRing
+
+	// clients is the map of scheduler address and grpc client.
+	clients map[string]schedulerclient.Client
+
+	// mu guards members, ring and clients.
+	mu sync.RWMutex
+
+	// owned is the tasks owned by the current scheduler in the last round,
+	// including the tasks whose handoff is not finished.
+	owned map[string]struct{}
+
+	// replicated is the map of peer id and the address of scheduler
+	// which the peer has been announced to.
+	replicated map[string]string
+
+	// done channel.
+	done chan struct{}
+
+	// wg waits for Serve to return.
+	wg sync.WaitGroup
+
+	// serveMu orders wg.Add in Serve and wg.Wait in Stop,
+	// Serve does not run after Stop is called.
+	serveMu sync.Mutex
+}
+
+// New returns a new Cluster.
+func New(cfg *config.Config, resource resource.Resource, dynconfig config.DynconfigInterface, opts ...grpc.DialOption) (Cluster, error) {
+	data, err := dynconfig.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &cluster{
+		self:     fmt.Sprintf("%s:%d", cfg.Server.IP, cfg.Server.Port),
+		interval: cfg.Replication.Interval,
+		resource: resource,
+		newClient: func(addr string) (schedulerclient.Client, error) {
+			return schedulerclient.GetClientByAddr([]dfnet.NetAddr{{Type: dfnet.TCP, Addr: addr}}, opts...)
+		},
+		clients:    map[string]schedulerclient.Client{},
+		owned:      map[string]struct{}{},
+		replicated: map[string]string{},
+		done:       make(chan struct{}),
+	}
+	c.setMembers(schedulersToAddrs(data.Schedulers))
+
+	dynconfig.Register(c)
+	return c, nil
+}
+
+// Owner returns the address of scheduler owning the task.
+func (c *cluster) Owner(taskID string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.ring.GetNode(taskID)
+}
+
+// Successor returns the address of scheduler taking over the task when the owner leaves.
+func (c *cluster) Successor(taskID string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	nodes, ok := c.ring.GetNodes(taskID, 2)
+	if !ok || len(nodes) < 2 {
+		return "", false
+	}
+
+	return nodes[1], true
+}
+
+// IsOwner returns whether the task is owned by the current scheduler.
+func (c *cluster) IsOwner(taskID string) bool {
+	owner, ok := c.Owner(taskID)
+	return ok && owner == c.self
+}
+
+// Members returns the addresses of schedulers in the cluster.
+func (c *cluster) Members() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.members
+}
+
+// Serve replicates and hands off tasks periodically.
+func (c *cluster) Serve() {
+	c.serveMu.Lock()
+	select {
+	case <-c.done:
+		c.serveMu.Unlock()
+		return
+	default:
+	}
+	c.wg.Add(1)
+	c.serveMu.Unlock()
+	defer c.wg.Done()
+
+	tick := time.NewTicker(c.interval)
+	for {
+		select {
+		case <-tick.C:
+			c.sync()
+		case <-c.done:
+			tick.Stop()
+			return
+		}
+	}
+}
+
+// Stop stops replicating and closes the clients of schedulers.
+func (c *cluster) Stop() {
+	c.serveMu.Lock()
+	close(c.done)
+	c.serveMu.Unlock()
+
+	// Wait for the running round, otherwise it announces peers with the closed clients.
+	c.wg.Wait()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for addr, client := range c.clients {
+		if err := client.Close(); err != nil {
+			logger.Errorf("close scheduler %s client failed: %s", addr, err.Error())
+		}
+		delete(c.clients, addr)
+	}
+}
+
+// OnNotify updates the members of cluster.
+func (c *cluster) OnNotify(data *config.DynconfigData) {
+	members := schedulersToAddrs(data.Schedulers)
+	if reflect.DeepEqual(c.Members(), members) {
+		return
+	}
+
+	c.setMembers(members)
+	logger.Infof("scheduler cluster members have been updated: %v", members)
+}
+
+// setMembers updates the members and hashring, and closes the clients of removed members.
+func (c *cluster) setMembers(members []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.members = members
+	c.ring = hashring.New(members)
+
+	for addr, client := range c.clients {
+		found := false
+		for _, member := range members {
+			if member == addr {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			if err := client.Close(); err != nil {
+				logger.Errorf("close scheduler %s client failed: %s", addr, err.Error())
+			}
+			delete(c.clients, addr)
+		}
+	}
+}
+
+// sync announces the succeeded peers of task to the successor if the task is owned
+// by the current scheduler, or to the new owner if the task is no longer owned by
+// the current scheduler after the members of cluster change. The schedulers are
+// announced to concurrently, and a round is bounded by the interval of replicating,
+// so a slow scheduler does not stall the others. The scheduler failed to be announced
+// to is skipped for the rest of the round, and the unfinished handoff is retried
+// in the next round.
+func (c *cluster) sync() {
+	owned := map[string]struct{}{}
+	replicated := map[string]string{}
+	handoffs := map[string]string{}
+	peers := map[string][]*resource.Peer{}
+	c.resource.TaskManager().Range(func(_, value any) bool {
+		task, ok := value.(*resource.Task)
+		if !ok || !task.FSM.Is(resource.TaskStateSucceeded) {
+			return true
+		}
+
+		var target string
+		if c.IsOwner(task.ID) {
+			owned[task.ID] = struct{}{}
+			if target, ok = c.Successor(task.ID); !ok {
+				return true
+			}
+		} else if _, ok := c.owned[task.ID]; ok {
+			if target, ok = c.Owner(task.ID); !ok {
+				return true
+			}
+			handoffs[task.ID] = target
+			task.Log.Infof("hand off task to scheduler %s", target)
+		} else {
+			return true
+		}
+
+		task.Peers.Range(func(_, value any) bool {
+			peer, ok := value.(*resource.Peer)
+			if !ok || !peer.FSM.Is(resource.PeerStateSucceeded) {
+				return true
+			}
+
+			if c.replicated[peer.ID] == target {
+				replicated[peer.ID] = target
+				return true
+			}
+
+			peers[target] = append(peers[target], peer)
+			return true
+		})
+
+		return true
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.interval)
+	defer cancel()
+	go func() {
+		select {
+		case <-c.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		pending = map[string]struct{}{}
+		sem     = make(chan struct{}, announceConcurrency)
+	)
+	for target, targetPeers := range peers {
+		wg.Add(1)
+		go func(target string, targetPeers []*resource.Peer) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			for i, peer := range targetPeers {
+				if err := c.announce(ctx, target, peer); err != nil {
+					metrics.ReplicatePeerFailureCount.Inc()
+					peer.Log.Errorf("announce peer to scheduler %s failed: %s", target, err.Error())
+
+					mu.Lock()
+					for _, peer := range targetPeers[i:] {
+						pending[peer.Task.ID] = struct{}{}
+					}
+					mu.Unlock()
+					return
+				}
+
+				metrics.ReplicatePeerCount.Inc()
+				mu.Lock()
+				replicated[peer.ID] = target
+				mu.Unlock()
+			}
+		}(target, targetPeers)
+	}
+	wg.Wait()
+
+	for taskID, target := range handoffs {
+		if _, ok := pending[taskID]; ok {
+			logger.WithTaskID(taskID).Warnf("hand off task to scheduler %s is not finished", target)
+			owned[taskID] = struct{}{}
+		}
+	}
+
+	c.owned = owned
+	c.replicated = replicated
+}
+
+// announce announces the succeeded peer to the scheduler.
+func (c *cluster) announce(ctx context.Context, addr string, peer *resource.Peer) error {
+	client, err := c.loadOrCreateClient(addr)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, announceTimeout)
+	defer cancel()
+	return client.AnnounceTask(ctx, NewAnnounceTaskRequest(peer))
+}
+
+// loadOrCreateClient returns the grpc client of scheduler.
+func (c *cluster) loadOrCreateClient(addr string) (schedulerclient.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if client, ok := c.clients[addr]; ok {
+		return client, nil
+	}
+
+	client, err := c.newClient(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	c.clients[addr] = client
+	return client, nil
+}
+
+// NewAnnounceTaskRequest returns the request announcing the succeeded peer.
+func NewAnnounceTaskRequest(peer *resource.Peer) *rpcscheduler.AnnounceTaskRequest {
+	urlMeta := peer.Task.URLMeta
+	if urlMeta == nil {
+		urlMeta = &base.UrlMeta{}
+	}
+
+	var pieceInfos []*base.PieceInfo
+	for i, ok := peer.Pieces.NextSet(0); ok; i, ok = peer.Pieces.NextSet(i + 1) {
+		if pieceInfo, ok := peer.Task.LoadPiece(int32(i)); ok {
+			pieceInfos = append(pieceInfos, pieceInfo)
+		}
+	}
+
+	return &rpcscheduler.AnnounceTaskRequest{
+		TaskId:   peer.Task.ID,
+		Url:      peer.Task.URL,
+		UrlMeta:  urlMeta,
+		TaskType: peer.Task.Type,
+		PeerHost: &rpcscheduler.PeerHost{
+			Id:             peer.Host.ID,
+			Ip:             peer.Host.IP,
+			RpcPort:        peer.Host.Port,
+			DownPort:       peer.Host.DownloadPort,
+			HostName:       peer.Host.Hostname,
+			SecurityDomain: peer.Host.SecurityDomain,
+			Location:       peer.Host.Location,
+			Idc:            peer.Host.IDC,
+			NetTopology:    peer.Host.NetTopology,
+		},
+		PiecePacket: &base.PiecePacket{
+			TaskId:        peer.Task.ID,
+			DstPid:        peer.ID,
+			DstAddr:       fmt.Sprintf("%s:%d", peer.Host.IP, peer.Host.DownloadPort),
+			PieceInfos:    pieceInfos,
+			TotalPiece:    peer.Task.TotalPieceCount.Load(),
+			ContentLength: peer.Task.ContentLength.Load(),
+		},
+	}
+}
+
+// schedulersToAddrs coverts []*config.Scheduler to the sorted addresses.
+func schedulersToAddrs(schedulers []*config.Scheduler) []string {
+	addrs := make([]string, 0, len(schedulers))
+	for _, scheduler := range schedulers {
+		addrs = append(addrs, fmt.Sprintf("%s:%d", scheduler.IP, scheduler.Port))
+	}
+
+	sort.Strings(addrs)
+	return addrs
+}
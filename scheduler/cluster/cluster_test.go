@@ -0,0 +1,703 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc"
+
+	"d7y.io/dragonfly/v2/pkg/idgen"
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	rpcscheduler "d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+	schedulerclient "d7y.io/dragonfly/v2/pkg/rpc/scheduler/client"
+	clientmocks "d7y.io/dragonfly/v2/pkg/rpc/scheduler/client/mocks"
+	"d7y.io/dragonfly/v2/scheduler/config"
+	configmocks "d7y.io/dragonfly/v2/scheduler/config/mocks"
+	"d7y.io/dragonfly/v2/scheduler/resource"
+)
+
+var (
+	mockConfig = &config.Config{
+		Server: &config.ServerConfig{
+			IP:   "127.0.0.1",
+			Port: 8002,
+		},
+		Replication: &config.ReplicationConfig{
+			Enable:   true,
+			Interval: time.Minute,
+		},
+	}
+
+	mockSchedulers = []*config.Scheduler{
+		{ID: 1, IP: "127.0.0.1", Port: 8002},
+		{ID: 2, IP: "127.0.0.2", Port: 8002},
+	}
+
+	mockRawHost = &rpcscheduler.PeerHost{
+		Id:       idgen.HostID("hostname", 8003),
+		Ip:       "127.0.0.1",
+		RpcPort:  8003,
+		DownPort: 8001,
+		HostName: "hostname",
+	}
+
+	mockTaskURLMeta = &base.UrlMeta{
+		Digest: "digest",
+		Tag:    "tag",
+		Range:  "range",
+		Filter: "filter",
+	}
+
+	mockTaskURL = "http://example.com/foo"
+	mockTaskID  = idgen.TaskID(mockTaskURL, mockTaskURLMeta)
+	mockPeerID  = idgen.PeerID("127.0.0.1")
+)
+
+func TestCluster_New(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(dynconfig *configmocks.MockDynconfigInterfaceMockRecorder)
+		expect func(t *testing.T, c Cluster, err error)
+	}{
+		{
+			name: "new cluster",
+			mock: func(dynconfig *configmocks.MockDynconfigInterfaceMockRecorder) {
+				gomock.InOrder(
+					dynconfig.Get().Return(&config.DynconfigData{
+						Schedulers: []*config.Scheduler{mockSchedulers[1], mockSchedulers[0]},
+					}, nil).Times(1),
+					dynconfig.Register(gomock.Any()).Return().Times(1),
+				)
+			},
+			expect: func(t *testing.T, c Cluster, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.EqualValues(c.Members(), []string{"127.0.0.1:8002", "127.0.0.2:8002"})
+			},
+		},
+		{
+			name: "new cluster without schedulers",
+			mock: func(dynconfig *configmocks.MockDynconfigInterfaceMockRecorder) {
+				gomock.InOrder(
+					dynconfig.Get().Return(&config.DynconfigData{}, nil).Times(1),
+					dynconfig.Register(gomock.Any()).Return().Times(1),
+				)
+			},
+			expect: func(t *testing.T, c Cluster, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(len(c.Members()), 0)
+				_, ok := c.Owner(mockTaskID)
+				assert.False(ok)
+				assert.False(c.IsOwner(mockTaskID))
+			},
+		},
+		{
+			name: "new cluster failed because of dynconfig get error data",
+			mock: func(dynconfig *configmocks.MockDynconfigInterfaceMockRecorder) {
+				dynconfig.Get().Return(nil, errors.New("foo")).Times(1)
+			},
+			expect: func(t *testing.T, c Cluster, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "foo")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			res := resource.NewMockResource(ctl)
+			tc.mock(dynconfig.EXPECT())
+
+			c, err := New(mockConfig, res, dynconfig)
+			tc.expect(t, c, err)
+		})
+	}
+}
+
+func TestCluster_Successor(t *testing.T) {
+	tests := []struct {
+		name       string
+		schedulers []*config.Scheduler
+		expect     func(t *testing.T, c Cluster)
+	}{
+		{
+			name:       "successor is the next member of owner",
+			schedulers: mockSchedulers,
+			expect: func(t *testing.T, c Cluster) {
+				assert := assert.New(t)
+				owner, ok := c.Owner(mockTaskID)
+				assert.True(ok)
+				successor, ok := c.Successor(mockTaskID)
+				assert.True(ok)
+				assert.NotEqual(owner, successor)
+				assert.Contains(c.Members(), successor)
+			},
+		},
+		{
+			name:       "successor does not exist in a single member cluster",
+			schedulers: mockSchedulers[:1],
+			expect: func(t *testing.T, c Cluster) {
+				assert := assert.New(t)
+				owner, ok := c.Owner(mockTaskID)
+				assert.True(ok)
+				assert.Equal(owner, "127.0.0.1:8002")
+				assert.True(c.IsOwner(mockTaskID))
+				_, ok = c.Successor(mockTaskID)
+				assert.False(ok)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			res := resource.NewMockResource(ctl)
+			gomock.InOrder(
+				dynconfig.EXPECT().Get().Return(&config.DynconfigData{Schedulers: tc.schedulers}, nil).Times(1),
+				dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1),
+			)
+
+			c, err := New(mockConfig, res, dynconfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tc.expect(t, c)
+		})
+	}
+}
+
+func TestCluster_OnNotify(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   *config.DynconfigData
+		mock   func(client *clientmocks.MockClientMockRecorder)
+		expect func(t *testing.T, c *cluster)
+	}{
+		{
+			name: "members have been updated",
+			data: &config.DynconfigData{Schedulers: mockSchedulers},
+			mock: func(client *clientmocks.MockClientMockRecorder) {},
+			expect: func(t *testing.T, c *cluster) {
+				assert := assert.New(t)
+				assert.EqualValues(c.Members(), []string{"127.0.0.1:8002", "127.0.0.2:8002"})
+				assert.Equal(len(c.clients), 1)
+			},
+		},
+		{
+			name: "client of removed member has been closed",
+			data: &config.DynconfigData{Schedulers: mockSchedulers[:1]},
+			mock: func(client *clientmocks.MockClientMockRecorder) {
+				client.Close().Return(nil).Times(1)
+			},
+			expect: func(t *testing.T, c *cluster) {
+				assert := assert.New(t)
+				assert.EqualValues(c.Members(), []string{"127.0.0.1:8002"})
+				assert.Equal(len(c.clients), 0)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			res := resource.NewMockResource(ctl)
+			client := clientmocks.NewMockClient(ctl)
+			tc.mock(client.EXPECT())
+			gomock.InOrder(
+				dynconfig.EXPECT().Get().Return(&config.DynconfigData{Schedulers: mockSchedulers}, nil).Times(1),
+				dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1),
+			)
+
+			c, err := New(mockConfig, res, dynconfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cl := c.(*cluster)
+			cl.clients["127.0.0.2:8002"] = client
+
+			c.OnNotify(tc.data)
+			tc.expect(t, cl)
+		})
+	}
+}
+
+func TestCluster_sync(t *testing.T) {
+	tests := []struct {
+		name       string
+		owner      bool
+		owned      bool
+		replicated bool
+		mock       func(peer *resource.Peer, client *clientmocks.MockClientMockRecorder)
+		expect     func(t *testing.T, c *cluster, peer *resource.Peer)
+	}{
+		{
+			name:  "owner replicates succeeded peer to successor",
+			owner: true,
+			mock: func(peer *resource.Peer, client *clientmocks.MockClientMockRecorder) {
+				client.AnnounceTask(gomock.Any(), gomock.Eq(NewAnnounceTaskRequest(peer))).Return(nil).Times(1)
+			},
+			expect: func(t *testing.T, c *cluster, peer *resource.Peer) {
+				assert := assert.New(t)
+				successor, _ := c.Successor(mockTaskID)
+				assert.Equal(c.replicated[peer.ID], successor)
+				assert.Contains(c.owned, mockTaskID)
+			},
+		},
+		{
+			name:       "owner does not replicate peer which has been replicated",
+			owner:      true,
+			replicated: true,
+			mock:       func(peer *resource.Peer, client *clientmocks.MockClientMockRecorder) {},
+			expect: func(t *testing.T, c *cluster, peer *resource.Peer) {
+				assert := assert.New(t)
+				successor, _ := c.Successor(mockTaskID)
+				assert.Equal(c.replicated[peer.ID], successor)
+			},
+		},
+		{
+			name:  "owner replicates peer failed",
+			owner: true,
+			mock: func(peer *resource.Peer, client *clientmocks.MockClientMockRecorder) {
+				client.AnnounceTask(gomock.Any(), gomock.Any()).Return(errors.New("foo")).Times(1)
+			},
+			expect: func(t *testing.T, c *cluster, peer *resource.Peer) {
+				assert := assert.New(t)
+				_, ok := c.replicated[peer.ID]
+				assert.False(ok)
+				assert.Contains(c.owned, mockTaskID)
+			},
+		},
+		{
+			name:  "previous owner hands off task to new owner",
+			owner: false,
+			owned: true,
+			mock: func(peer *resource.Peer, client *clientmocks.MockClientMockRecorder) {
+				client.AnnounceTask(gomock.Any(), gomock.Eq(NewAnnounceTaskRequest(peer))).Return(nil).Times(1)
+			},
+			expect: func(t *testing.T, c *cluster, peer *resource.Peer) {
+				assert := assert.New(t)
+				owner, _ := c.Owner(mockTaskID)
+				assert.Equal(c.replicated[peer.ID], owner)
+				assert.NotContains(c.owned, mockTaskID)
+			},
+		},
+		{
+			name:  "previous owner hands off task failed",
+			owner: false,
+			owned: true,
+			mock: func(peer *resource.Peer, client *clientmocks.MockClientMockRecorder) {
+				client.AnnounceTask(gomock.Any(), gomock.Any()).Return(errors.New("foo")).Times(1)
+			},
+			expect: func(t *testing.T, c *cluster, peer *resource.Peer) {
+				assert := assert.New(t)
+				_, ok := c.replicated[peer.ID]
+				assert.False(ok)
+				assert.Contains(c.owned, mockTaskID)
+			},
+		},
+		{
+			name:  "replica does not announce peer",
+			owner: false,
+			mock:  func(peer *resource.Peer, client *clientmocks.MockClientMockRecorder) {},
+			expect: func(t *testing.T, c *cluster, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(len(c.replicated), 0)
+				assert.Equal(len(c.owned), 0)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			res := resource.NewMockResource(ctl)
+			taskManager := resource.NewMockTaskManager(ctl)
+			client := clientmocks.NewMockClient(ctl)
+			gomock.InOrder(
+				dynconfig.EXPECT().Get().Return(&config.DynconfigData{Schedulers: mockSchedulers}, nil).Times(1),
+				dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1),
+			)
+
+			mockHost := resource.NewHost(mockRawHost)
+			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+			mockTask.FSM.SetState(resource.TaskStateSucceeded)
+			mockTask.StorePiece(&base.PieceInfo{PieceNum: 0})
+			mockTask.TotalPieceCount.Store(1)
+			mockPeer := resource.NewPeer(mockPeerID, mockTask, mockHost)
+			mockPeer.FSM.SetState(resource.PeerStateSucceeded)
+			mockPeer.Pieces.Set(0)
+			mockTask.StorePeer(mockPeer)
+
+			var tasks sync.Map
+			tasks.Store(mockTask.ID, mockTask)
+			res.EXPECT().TaskManager().Return(taskManager).AnyTimes()
+			taskManager.EXPECT().Range(gomock.Any()).Do(func(f func(any, any) bool) {
+				tasks.Range(f)
+			}).AnyTimes()
+			tc.mock(mockPeer, client.EXPECT())
+
+			c, err := New(mockConfig, res, dynconfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cl := c.(*cluster)
+			cl.newClient = func(string) (schedulerclient.Client, error) {
+				return client, nil
+			}
+
+			owner, _ := c.Owner(mockTaskID)
+			successor, _ := c.Successor(mockTaskID)
+			if tc.owner {
+				cl.self = owner
+			} else {
+				cl.self = successor
+			}
+
+			if tc.owned {
+				cl.owned[mockTaskID] = struct{}{}
+			}
+
+			if tc.replicated {
+				cl.replicated[mockPeer.ID] = successor
+			}
+
+			cl.sync()
+			tc.expect(t, cl, mockPeer)
+		})
+	}
+}
+
+func TestCluster_syncWithFailedTarget(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+	res := resource.NewMockResource(ctl)
+	taskManager := resource.NewMockTaskManager(ctl)
+	client := clientmocks.NewMockClient(ctl)
+	gomock.InOrder(
+		dynconfig.EXPECT().Get().Return(&config.DynconfigData{Schedulers: mockSchedulers}, nil).Times(1),
+		dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1),
+	)
+
+	var tasks sync.Map
+	mockHost := resource.NewHost(mockRawHost)
+	for _, url := range []string{"http://example.com/foo", "http://example.com/bar"} {
+		mockTask := resource.NewTask(idgen.TaskID(url, mockTaskURLMeta), url, base.TaskType_Normal, mockTaskURLMeta)
+		mockTask.FSM.SetState(resource.TaskStateSucceeded)
+		for i := 0; i < 2; i++ {
+			mockPeer := resource.NewPeer(idgen.PeerID("127.0.0.1"), mockTask, mockHost)
+			mockPeer.FSM.SetState(resource.PeerStateSucceeded)
+			mockTask.StorePeer(mockPeer)
+		}
+		tasks.Store(mockTask.ID, mockTask)
+	}
+	res.EXPECT().TaskManager().Return(taskManager).AnyTimes()
+	taskManager.EXPECT().Range(gomock.Any()).Do(func(f func(any, any) bool) {
+		tasks.Range(f)
+	}).AnyTimes()
+
+	// The target is announced only once in the round after it fails.
+	client.EXPECT().AnnounceTask(gomock.Any(), gomock.Any()).Return(errors.New("foo")).Times(1)
+
+	c, err := New(mockConfig, res, dynconfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := c.(*cluster)
+	cl.newClient = func(string) (schedulerclient.Client, error) {
+		return client, nil
+	}
+
+	// Hand off both tasks to the other scheduler.
+	cl.self = "127.0.0.3:8002"
+	tasks.Range(func(key, _ any) bool {
+		cl.owned[key.(string)] = struct{}{}
+		return true
+	})
+
+	cl.sync()
+	assert := assert.New(t)
+	assert.Equal(len(cl.replicated), 0)
+	assert.Equal(len(cl.owned), 2)
+}
+
+func TestCluster_Stop(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+	res := resource.NewMockResource(ctl)
+	taskManager := resource.NewMockTaskManager(ctl)
+	client := clientmocks.NewMockClient(ctl)
+	gomock.InOrder(
+		dynconfig.EXPECT().Get().Return(&config.DynconfigData{Schedulers: mockSchedulers}, nil).Times(1),
+		dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1),
+	)
+
+	mockHost := resource.NewHost(mockRawHost)
+	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+	mockTask.FSM.SetState(resource.TaskStateSucceeded)
+	mockPeer := resource.NewPeer(mockPeerID, mockTask, mockHost)
+	mockPeer.FSM.SetState(resource.PeerStateSucceeded)
+	mockTask.StorePeer(mockPeer)
+
+	var tasks sync.Map
+	tasks.Store(mockTask.ID, mockTask)
+	res.EXPECT().TaskManager().Return(taskManager).AnyTimes()
+	taskManager.EXPECT().Range(gomock.Any()).Do(func(f func(any, any) bool) {
+		tasks.Range(f)
+	}).AnyTimes()
+
+	// The running round is canceled by Stop, then the client is closed.
+	announced := make(chan struct{})
+	gomock.InOrder(
+		client.EXPECT().AnnounceTask(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, _ *rpcscheduler.AnnounceTaskRequest, _ ...grpc.CallOption) error {
+			close(announced)
+			<-ctx.Done()
+			return ctx.Err()
+		}).Times(1),
+		client.EXPECT().Close().Return(nil).Times(1),
+	)
+
+	c, err := New(mockConfig, res, dynconfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := c.(*cluster)
+	cl.newClient = func(string) (schedulerclient.Client, error) {
+		return client, nil
+	}
+	owner, _ := c.Owner(mockTaskID)
+	cl.self = owner
+
+	// Run a round as Serve does.
+	cl.wg.Add(1)
+	go func() {
+		defer cl.wg.Done()
+		cl.sync()
+	}()
+
+	<-announced
+	c.Stop()
+	assert.Equal(t, len(cl.clients), 0)
+}
+
+func TestCluster_StopAfterServe(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+	res := resource.NewMockResource(ctl)
+	taskManager := resource.NewMockTaskManager(ctl)
+	client := clientmocks.NewMockClient(ctl)
+	gomock.InOrder(
+		dynconfig.EXPECT().Get().Return(&config.DynconfigData{Schedulers: mockSchedulers}, nil).Times(1),
+		dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1),
+	)
+
+	mockHost := resource.NewHost(mockRawHost)
+	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+	mockTask.FSM.SetState(resource.TaskStateSucceeded)
+	mockPeer := resource.NewPeer(mockPeerID, mockTask, mockHost)
+	mockPeer.FSM.SetState(resource.PeerStateSucceeded)
+	mockTask.StorePeer(mockPeer)
+
+	var tasks sync.Map
+	tasks.Store(mockTask.ID, mockTask)
+	res.EXPECT().TaskManager().Return(taskManager).AnyTimes()
+	taskManager.EXPECT().Range(gomock.Any()).Do(func(f func(any, any) bool) {
+		tasks.Range(f)
+	}).AnyTimes()
+
+	// The client is never used after it is closed.
+	closed := atomic.NewBool(false)
+	client.EXPECT().AnnounceTask(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, _ *rpcscheduler.AnnounceTaskRequest, _ ...grpc.CallOption) error {
+		if closed.Load() {
+			t.Error("announce peer with closed client")
+		}
+
+		<-ctx.Done()
+		return ctx.Err()
+	}).AnyTimes()
+	client.EXPECT().Close().DoAndReturn(func() error {
+		closed.Store(true)
+		return nil
+	}).AnyTimes()
+
+	cfg := *mockConfig
+	cfg.Replication = &config.ReplicationConfig{
+		Enable:   true,
+		Interval: time.Microsecond,
+	}
+	c, err := New(&cfg, res, dynconfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := c.(*cluster)
+	cl.newClient = func(string) (schedulerclient.Client, error) {
+		return client, nil
+	}
+	owner, _ := c.Owner(mockTaskID)
+	cl.self = owner
+
+	go c.Serve()
+	c.Stop()
+
+	// Serve started after Stop does not run a round.
+	c.Serve()
+	assert.Equal(t, len(cl.clients), 0)
+}
+
+func TestCluster_handoff(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(peer *resource.Peer, client *clientmocks.MockClientMockRecorder)
+		expect func(t *testing.T, c *cluster, peer *resource.Peer, addrs []string)
+	}{
+		{
+			name: "hand off task to new owner after the owner leaves",
+			mock: func(peer *resource.Peer, client *clientmocks.MockClientMockRecorder) {
+				client.AnnounceTask(gomock.Any(), gomock.Eq(NewAnnounceTaskRequest(peer))).Return(nil).Times(1)
+			},
+			expect: func(t *testing.T, c *cluster, peer *resource.Peer, addrs []string) {
+				assert := assert.New(t)
+				owner, ok := c.Owner(mockTaskID)
+				assert.True(ok)
+				assert.NotEqual(owner, c.self)
+				assert.Equal(addrs, []string{owner})
+				assert.NotContains(c.owned, mockTaskID)
+			},
+		},
+		{
+			name: "retry handoff in the next round when it fails",
+			mock: func(peer *resource.Peer, client *clientmocks.MockClientMockRecorder) {
+				gomock.InOrder(
+					client.AnnounceTask(gomock.Any(), gomock.Any()).Return(errors.New("foo")).Times(1),
+					client.AnnounceTask(gomock.Any(), gomock.Eq(NewAnnounceTaskRequest(peer))).Return(nil).Times(1),
+				)
+			},
+			expect: func(t *testing.T, c *cluster, peer *resource.Peer, addrs []string) {
+				assert := assert.New(t)
+				owner, _ := c.Owner(mockTaskID)
+				assert.Equal(addrs, []string{owner})
+				assert.NotContains(c.owned, mockTaskID)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			res := resource.NewMockResource(ctl)
+			taskManager := resource.NewMockTaskManager(ctl)
+			client := clientmocks.NewMockClient(ctl)
+			gomock.InOrder(
+				dynconfig.EXPECT().Get().Return(&config.DynconfigData{Schedulers: mockSchedulers[:1]}, nil).Times(1),
+				dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1),
+			)
+
+			mockHost := resource.NewHost(mockRawHost)
+			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+			mockTask.FSM.SetState(resource.TaskStateSucceeded)
+			mockTask.StorePiece(&base.PieceInfo{PieceNum: 0})
+			mockTask.TotalPieceCount.Store(1)
+			mockPeer := resource.NewPeer(mockPeerID, mockTask, mockHost)
+			mockPeer.FSM.SetState(resource.PeerStateSucceeded)
+			mockPeer.Pieces.Set(0)
+			mockTask.StorePeer(mockPeer)
+
+			var tasks sync.Map
+			tasks.Store(mockTask.ID, mockTask)
+			res.EXPECT().TaskManager().Return(taskManager).AnyTimes()
+			taskManager.EXPECT().Range(gomock.Any()).Do(func(f func(any, any) bool) {
+				tasks.Range(f)
+			}).AnyTimes()
+			tc.mock(mockPeer, client.EXPECT())
+
+			c, err := New(mockConfig, res, dynconfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cl := c.(*cluster)
+			var addrs []string
+			cl.newClient = func(addr string) (schedulerclient.Client, error) {
+				addrs = append(addrs, addr)
+				return client, nil
+			}
+
+			// The only member owns the task without successor.
+			cl.sync()
+			assert := assert.New(t)
+			assert.True(c.IsOwner(mockTaskID))
+			assert.Contains(cl.owned, mockTaskID)
+			assert.Equal(len(cl.replicated), 0)
+
+			// The current scheduler leaves the cluster.
+			c.OnNotify(&config.DynconfigData{Schedulers: []*config.Scheduler{
+				{ID: 2, IP: "127.0.0.2", Port: 8002},
+				{ID: 3, IP: "127.0.0.3", Port: 8002},
+			}})
+			for i := 0; i < 3; i++ {
+				cl.sync()
+			}
+
+			tc.expect(t, cl, mockPeer, addrs)
+		})
+	}
+}
+
+func TestCluster_NewAnnounceTaskRequest(t *testing.T) {
+	mockHost := resource.NewHost(mockRawHost)
+	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+	mockTask.StorePiece(&base.PieceInfo{PieceNum: 0})
+	mockTask.StorePiece(&base.PieceInfo{PieceNum: 1})
+	mockTask.TotalPieceCount.Store(2)
+	mockTask.ContentLength.Store(1024)
+	mockPeer := resource.NewPeer(mockPeerID, mockTask, mockHost)
+	mockPeer.Pieces.Set(1)
+
+	req := NewAnnounceTaskRequest(mockPeer)
+	assert := assert.New(t)
+	assert.Equal(req.TaskId, mockTaskID)
+	assert.Equal(req.Url, mockTaskURL)
+	assert.Equal(req.UrlMeta, mockTaskURLMeta)
+	assert.Equal(req.PeerHost.Id, mockRawHost.Id)
+	assert.Equal(req.PiecePacket.DstPid, mockPeerID)
+	assert.Equal(req.PiecePacket.DstAddr, "127.0.0.1:8001")
+	assert.Equal(len(req.PiecePacket.PieceInfos), 1)
+	assert.Equal(req.PiecePacket.PieceInfos[0].PieceNum, int32(1))
+	assert.Equal(req.PiecePacket.TotalPiece, int32(2))
+	assert.Equal(req.PiecePacket.ContentLength, int64(1024))
+}
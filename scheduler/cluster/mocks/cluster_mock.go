@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cluster.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	config "d7y.io/dragonfly/v2/scheduler/config"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCluster is a mock of Cluster interface.
+type MockCluster struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterMockRecorder
+}
+
+// MockClusterMockRecorder is the mock recorder for MockCluster.
+type MockClusterMockRecorder struct {
+	mock *MockCluster
+}
+
+// NewMockCluster creates a new mock instance.
+func NewMockCluster(ctrl *gomock.Controller) *MockCluster {
+	mock := &MockCluster{ctrl: ctrl}
+	mock.recorder = &MockClusterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCluster) EXPECT() *MockClusterMockRecorder {
+	return m.recorder
+}
+
+// IsOwner mocks base method.
+func (m *MockCluster) IsOwner(taskID string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOwner", taskID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsOwner indicates an expected call of IsOwner.
+func (mr *MockClusterMockRecorder) IsOwner(taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOwner", reflect.TypeOf((*MockCluster)(nil).IsOwner), taskID)
+}
+
+// Members mocks base method.
+func (m *MockCluster) Members() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Members")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Members indicates an expected call of Members.
+func (mr *MockClusterMockRecorder) Members() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Members", reflect.TypeOf((*MockCluster)(nil).Members))
+}
+
+// OnNotify mocks base method.
+func (m *MockCluster) OnNotify(arg0 *config.DynconfigData) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnNotify", arg0)
+}
+
+// OnNotify indicates an expected call of OnNotify.
+func (mr *MockClusterMockRecorder) OnNotify(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnNotify", reflect.TypeOf((*MockCluster)(nil).OnNotify), arg0)
+}
+
+// Owner mocks base method.
+func (m *MockCluster) Owner(taskID string) (string, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Owner", taskID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Owner indicates an expected call of Owner.
+func (mr *MockClusterMockRecorder) Owner(taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Owner", reflect.TypeOf((*MockCluster)(nil).Owner), taskID)
+}
+
+// Serve mocks base method.
+func (m *MockCluster) Serve() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Serve")
+}
+
+// Serve indicates an expected call of Serve.
+func (mr *MockClusterMockRecorder) Serve() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Serve", reflect.TypeOf((*MockCluster)(nil).Serve))
+}
+
+// Stop mocks base method.
+func (m *MockCluster) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockClusterMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockCluster)(nil).Stop))
+}
+
+// Successor mocks base method.
+func (m *MockCluster) Successor(taskID string) (string, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Successor", taskID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Successor indicates an expected call of Successor.
+func (mr *MockClusterMockRecorder) Successor(taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Successor", reflect.TypeOf((*MockCluster)(nil).Successor), taskID)
+}
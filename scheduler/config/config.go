@@ -54,8 +54,20 @@ type Config struct {
 	// Storage configuration.
 	Storage *StorageConfig `yaml:"storage" mapstructure:"storage"`
 
+	// Snapshot configuration.
+	Snapshot *SnapshotConfig `yaml:"snapshot" mapstructure:"snapshot"`
+
+	// Replication configuration.
+	Replication *ReplicationConfig `yaml:"replication" mapstructure:"replication"`
+
+	// Federation configuration.
+	Federation *FederationConfig `yaml:"federation" mapstructure:"federation"`
+
 	// Metrics configuration.
 	Metrics *MetricsConfig `yaml:"metrics" mapstructure:"metrics"`
+
+	// Admin configuration.
+	Admin *AdminConfig `yaml:"admin" mapstructure:"admin"`
 }
 
 // New default configuration.
@@ -68,18 +80,31 @@ func New() *Config {
 			Port:   DefaultServerPort,
 		},
 		Scheduler: &SchedulerConfig{
-			Algorithm:            DefaultSchedulerAlgorithm,
-			BackSourceCount:      DefaultSchedulerBackSourceCount,
-			RetryBackSourceLimit: DefaultSchedulerRetryBackSourceLimit,
-			RetryLimit:           DefaultSchedulerRetryLimit,
-			RetryInterval:        DefaultSchedulerRetryInterval,
+			Algorithm:                    DefaultSchedulerAlgorithm,
+			BackSourceCount:              DefaultSchedulerBackSourceCount,
+			RetryBackSourceLimit:         DefaultSchedulerRetryBackSourceLimit,
+			RetryLimit:                   DefaultSchedulerRetryLimit,
+			RetryInterval:                DefaultSchedulerRetryInterval,
+			BackSourceAdmissionWaitLimit: DefaultSchedulerBackSourceAdmissionWaitLimit,
+			ProbeCount:                   DefaultSchedulerProbeCount,
+			ReservedUploadLoadRatio:      DefaultSchedulerReservedUploadLoadRatio,
+			CorruptedPieceLimit:          DefaultSchedulerCorruptedPieceLimit,
+			HostCorruptedPieceLimit:      DefaultSchedulerHostCorruptedPieceLimit,
+			RemoteEvaluator: RemoteEvaluatorConfig{
+				Timeout: DefaultSchedulerRemoteEvaluatorTimeout,
+			},
+			ObjectCache: ObjectCacheConfig{
+				ObjectSizeLimit: DefaultSchedulerObjectCacheObjectSizeLimit,
+				SizeLimit:       DefaultSchedulerObjectCacheSizeLimit,
+			},
 			GC: &GCConfig{
-				PeerGCInterval: DefaultSchedulerPeerGCInterval,
-				PeerTTL:        DefaultSchedulerPeerTTL,
-				TaskGCInterval: DefaultSchedulerTaskGCInterval,
-				TaskTTL:        DefaultSchedulerTaskTTL,
-				HostGCInterval: DefaultSchedulerHostGCInterval,
-				HostTTL:        DefaultSchedulerHostTTL,
+				PeerGCInterval:     DefaultSchedulerPeerGCInterval,
+				PeerTTL:            DefaultSchedulerPeerTTL,
+				TaskGCInterval:     DefaultSchedulerTaskGCInterval,
+				TaskTTL:            DefaultSchedulerTaskTTL,
+				TaskEvictionPolicy: DefaultSchedulerTaskEvictionPolicy,
+				HostGCInterval:     DefaultSchedulerHostGCInterval,
+				HostTTL:            DefaultSchedulerHostTTL,
 			},
 		},
 		DynConfig: &DynConfig{
@@ -111,10 +136,28 @@ func New() *Config {
 			MaxBackups: storage.DefaultMaxBackups,
 			BufferSize: storage.DefaultBufferSize,
 		},
+		Snapshot: &SnapshotConfig{
+			Enable:   true,
+			Interval: DefaultSnapshotInterval,
+		},
+		Replication: &ReplicationConfig{
+			Enable:   false,
+			Interval: DefaultReplicationInterval,
+		},
+		Federation: &FederationConfig{
+			Enable:           false,
+			Timeout:          DefaultFederationTimeout,
+			CostLimit:        DefaultFederationCostLimit,
+			PeerLimit:        DefaultFederationPeerLimit,
+			NegativeCacheTTL: DefaultFederationNegativeCacheTTL,
+		},
 		Metrics: &MetricsConfig{
 			Enable:         false,
 			EnablePeerHost: false,
 		},
+		Admin: &AdminConfig{
+			Enable: false,
+		},
 	}
 }
 
@@ -144,6 +187,10 @@ func (cfg *Config) Validate() error {
 		return errors.New("scheduler requires parameter algorithm")
 	}
 
+	if cfg.Scheduler.RemoteEvaluator.Timeout <= 0 {
+		return errors.New("scheduler requires parameter remoteEvaluator timeout")
+	}
+
 	if cfg.Scheduler.RetryLimit <= 0 {
 		return errors.New("scheduler requires parameter retryLimit")
 	}
@@ -152,6 +199,40 @@ func (cfg *Config) Validate() error {
 		return errors.New("scheduler requires parameter retryInterval")
 	}
 
+	if cfg.Scheduler.BackSourceAdmissionWaitLimit <= 0 {
+		return errors.New("scheduler requires parameter backSourceAdmissionWaitLimit")
+	}
+
+	if cfg.Scheduler.ProbeCount <= 0 {
+		return errors.New("scheduler requires parameter probeCount")
+	}
+
+	if cfg.Scheduler.ReservedUploadLoadRatio < 0 || cfg.Scheduler.ReservedUploadLoadRatio >= 1 {
+		return errors.New("scheduler requires parameter reservedUploadLoadRatio in [0, 1)")
+	}
+
+	if cfg.Scheduler.CorruptedPieceLimit <= 0 {
+		return errors.New("scheduler requires parameter corruptedPieceLimit")
+	}
+
+	if cfg.Scheduler.HostCorruptedPieceLimit <= 0 {
+		return errors.New("scheduler requires parameter hostCorruptedPieceLimit")
+	}
+
+	if cfg.Scheduler.ObjectCache.Enable {
+		if cfg.Scheduler.ObjectCache.ObjectSizeLimit <= 0 {
+			return errors.New("scheduler requires parameter objectCache objectSizeLimit")
+		}
+
+		if cfg.Scheduler.ObjectCache.ObjectSizeLimit > MaxSchedulerObjectCacheObjectSizeLimit {
+			return errors.New("scheduler requires parameter objectCache objectSizeLimit less than or equal to 2MB")
+		}
+
+		if cfg.Scheduler.ObjectCache.SizeLimit <= 0 {
+			return errors.New("scheduler requires parameter objectCache sizeLimit")
+		}
+	}
+
 	if cfg.Scheduler.GC == nil {
 		return errors.New("scheduler requires parameter gc")
 	}
@@ -172,6 +253,18 @@ func (cfg *Config) Validate() error {
 		return errors.New("scheduler requires parameter taskTTL")
 	}
 
+	if cfg.Scheduler.GC.TaskLimit < 0 {
+		return errors.New("scheduler requires parameter taskLimit")
+	}
+
+	if cfg.Scheduler.GC.TaskMemoryLimit < 0 {
+		return errors.New("scheduler requires parameter taskMemoryLimit")
+	}
+
+	if cfg.Scheduler.GC.TaskEvictionPolicy != TaskEvictionPolicyLRU && cfg.Scheduler.GC.TaskEvictionPolicy != TaskEvictionPolicyLFU {
+		return errors.New("scheduler requires parameter taskEvictionPolicy")
+	}
+
 	if cfg.DynConfig.RefreshInterval <= 0 {
 		return errors.New("dynconfig requires parameter refreshInterval")
 	}
@@ -234,12 +327,44 @@ func (cfg *Config) Validate() error {
 		return errors.New("storage requires parameter bufferSize")
 	}
 
+	if cfg.Snapshot != nil && cfg.Snapshot.Enable {
+		if cfg.Snapshot.Interval <= 0 {
+			return errors.New("snapshot requires parameter interval")
+		}
+	}
+
+	if cfg.Replication != nil && cfg.Replication.Enable {
+		if cfg.Replication.Interval <= 0 {
+			return errors.New("replication requires parameter interval")
+		}
+	}
+
+	if cfg.Federation != nil && cfg.Federation.Enable {
+		if cfg.Federation.Timeout <= 0 {
+			return errors.New("federation requires parameter timeout")
+		}
+
+		if cfg.Federation.PeerLimit <= 0 {
+			return errors.New("federation requires parameter peerLimit")
+		}
+
+		if cfg.Federation.NegativeCacheTTL <= 0 {
+			return errors.New("federation requires parameter negativeCacheTTL")
+		}
+	}
+
 	if cfg.Metrics != nil && cfg.Metrics.Enable {
 		if cfg.Metrics.Addr == "" {
 			return errors.New("metrics requires parameter addr")
 		}
 	}
 
+	if cfg.Admin != nil && cfg.Admin.Enable {
+		if cfg.Admin.Addr == "" {
+			return errors.New("admin requires parameter addr")
+		}
+	}
+
 	return nil
 }
 
@@ -270,6 +395,13 @@ type SchedulerConfig struct {
 	// Scheduling algorithm used by the scheduler.
 	Algorithm string `yaml:"algorithm" mapstructure:"algorithm"`
 
+	// ModelFile is the model file of machine learning algorithm,
+	// default is model.json in the data directory.
+	ModelFile string `yaml:"modelFile" mapstructure:"modelFile"`
+
+	// RemoteEvaluator is the configuration of remote algorithm.
+	RemoteEvaluator RemoteEvaluatorConfig `yaml:"remoteEvaluator" mapstructure:"remoteEvaluator"`
+
 	// Single task allows the client to back-to-source count.
 	BackSourceCount int `yaml:"backSourceCount" mapstructure:"backSourceCount"`
 
@@ -279,13 +411,62 @@ type SchedulerConfig struct {
 	// Retry scheduling limit times.
 	RetryLimit int `yaml:"retryLimit" mapstructure:"retryLimit"`
 
+	// BackSourceAdmissionWaitLimit is the times of peer waiting for back-to-source
+	// admission of origin host, peer is notified scheduling failed when it is reached.
+	BackSourceAdmissionWaitLimit int `yaml:"backSourceAdmissionWaitLimit" mapstructure:"backSourceAdmissionWaitLimit"`
+
 	// Retry scheduling interval.
 	RetryInterval time.Duration `yaml:"retryInterval" mapstructure:"retryInterval"`
 
+	// ProbeCount is the number of hosts sampled for each host to probe.
+	ProbeCount int `yaml:"probeCount" mapstructure:"probeCount"`
+
+	// ReservedUploadLoadRatio is the ratio of upload load of host reserved
+	// for high priority peers, other peers can not use the reserved upload load.
+	ReservedUploadLoadRatio float64 `yaml:"reservedUploadLoadRatio" mapstructure:"reservedUploadLoadRatio"`
+
+	// Preemption enables high priority peer to preempt the upload load of
+	// saturated host from low priority child when no parent can be found,
+	// the low priority child is rescheduled to other parents.
+	Preemption bool `yaml:"preemption" mapstructure:"preemption"`
+
+	// CorruptedPieceLimit is the number of corrupted pieces uploaded by peer,
+	// the peer is quarantined when it is reached.
+	CorruptedPieceLimit int `yaml:"corruptedPieceLimit" mapstructure:"corruptedPieceLimit"`
+
+	// HostCorruptedPieceLimit is the number of corrupted pieces uploaded by host,
+	// the peer of host is quarantined on its first corrupted piece when it is reached.
+	HostCorruptedPieceLimit int `yaml:"hostCorruptedPieceLimit" mapstructure:"hostCorruptedPieceLimit"`
+
+	// ObjectCache is the configuration of small object cache.
+	ObjectCache ObjectCacheConfig `yaml:"objectCache" mapstructure:"objectCache"`
+
 	// Task and peer gc configuration.
 	GC *GCConfig `yaml:"gc" mapstructure:"gc"`
 }
 
+type RemoteEvaluatorConfig struct {
+	// Addr is the address of evaluator grpc service.
+	Addr string `yaml:"addr" mapstructure:"addr"`
+
+	// Timeout is the timeout of evaluating candidate parents,
+	// the default algorithm is used when it is exceeded.
+	Timeout time.Duration `yaml:"timeout" mapstructure:"timeout"`
+}
+
+type ObjectCacheConfig struct {
+	// Enable caches the contents of small tasks downloaded by seed peers,
+	// the cached contents are returned to peers directly when they register.
+	Enable bool `yaml:"enable" mapstructure:"enable"`
+
+	// ObjectSizeLimit is the maximum size in bytes of a cached object.
+	ObjectSizeLimit int64 `yaml:"objectSizeLimit" mapstructure:"objectSizeLimit"`
+
+	// SizeLimit is the maximum total size in bytes of cached objects,
+	// the least recently used objects are evicted when it is exceeded.
+	SizeLimit int64 `yaml:"sizeLimit" mapstructure:"sizeLimit"`
+}
+
 type GCConfig struct {
 	// Peer gc interval.
 	PeerGCInterval time.Duration `yaml:"peerGCInterval" mapstructure:"peerGCInterval"`
@@ -299,6 +480,19 @@ type GCConfig struct {
 	// Task time to live.
 	TaskTTL time.Duration `yaml:"taskTTL" mapstructure:"taskTTL"`
 
+	// TaskLimit is the maximum number of tasks, idle tasks are evicted
+	// when it is exceeded, 0 means no limit.
+	TaskLimit int `yaml:"taskLimit" mapstructure:"taskLimit"`
+
+	// TaskMemoryLimit is the maximum approximate memory in megabytes of tasks,
+	// including the direct piece payloads, idle tasks are evicted when
+	// it is exceeded, 0 means no limit.
+	TaskMemoryLimit int `yaml:"taskMemoryLimit" mapstructure:"taskMemoryLimit"`
+
+	// TaskEvictionPolicy is the policy of picking idle tasks to evict,
+	// it is lru or lfu.
+	TaskEvictionPolicy string `yaml:"taskEvictionPolicy" mapstructure:"taskEvictionPolicy"`
+
 	// Host gc interval.
 	HostGCInterval time.Duration `yaml:"hostGCInterval" mapstructure:"hostGCInterval"`
 
@@ -372,6 +566,46 @@ type StorageConfig struct {
 	BufferSize int `yaml:"bufferSize" mapstructure:"bufferSize"`
 }
 
+type SnapshotConfig struct {
+	// Enable saves resource snapshot periodically and restores it
+	// when scheduler starts.
+	Enable bool `yaml:"enable" mapstructure:"enable"`
+
+	// Interval is the interval of saving snapshot.
+	Interval time.Duration `yaml:"interval" mapstructure:"interval"`
+}
+
+type ReplicationConfig struct {
+	// Enable replicates the succeeded peers of tasks owned by the scheduler
+	// to the successor scheduler in the hashring of the scheduler cluster,
+	// and hands off tasks to the new owners when the members of cluster change.
+	Enable bool `yaml:"enable" mapstructure:"enable"`
+
+	// Interval is the interval of replicating succeeded peers.
+	Interval time.Duration `yaml:"interval" mapstructure:"interval"`
+}
+
+type FederationConfig struct {
+	// Enable finds the peers of tasks in the federated scheduler clusters configured
+	// by manager when the tasks are absent in the scheduler cluster, then the peers
+	// are offered as candidate parents instead of downloading from the origin.
+	Enable bool `yaml:"enable" mapstructure:"enable"`
+
+	// Timeout is the timeout of finding the peers of task in federated scheduler clusters.
+	Timeout time.Duration `yaml:"timeout" mapstructure:"timeout"`
+
+	// CostLimit is the maximum cost of traffic from federated scheduler cluster,
+	// the federated scheduler clusters whose cost exceeds it are not queried.
+	CostLimit uint64 `yaml:"costLimit" mapstructure:"costLimit"`
+
+	// PeerLimit is the maximum number of peers found in federated scheduler cluster.
+	PeerLimit int32 `yaml:"peerLimit" mapstructure:"peerLimit"`
+
+	// NegativeCacheTTL is the time to live of the tasks not found in federated
+	// scheduler clusters, the tasks are not queried again before expiration.
+	NegativeCacheTTL time.Duration `yaml:"negativeCacheTTL" mapstructure:"negativeCacheTTL"`
+}
+
 type RedisConfig struct {
 	// Server hostname.
 	Host string `yaml:"host" mapstructure:"host"`
@@ -399,3 +633,12 @@ type MetricsConfig struct {
 	// Enable peer host metrics.
 	EnablePeerHost bool `yaml:"enablePeerHost" mapstructure:"enablePeerHost"`
 }
+
+type AdminConfig struct {
+	// Enable admin service, it is served over http only,
+	// grpc is not supported.
+	Enable bool `yaml:"enable" mapstructure:"enable"`
+
+	// Admin http service address.
+	Addr string `yaml:"addr" mapstructure:"addr"`
+}
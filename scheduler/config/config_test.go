@@ -35,18 +35,37 @@ func TestConfig_Load(t *testing.T) {
 
 	config := &Config{
 		Scheduler: &SchedulerConfig{
-			Algorithm:            "default",
-			BackSourceCount:      3,
-			RetryBackSourceLimit: 2,
-			RetryLimit:           10,
-			RetryInterval:        1 * time.Second,
+			Algorithm:                    "default",
+			ModelFile:                    "foo",
+			BackSourceCount:              3,
+			RetryBackSourceLimit:         2,
+			RetryLimit:                   10,
+			RetryInterval:                1 * time.Second,
+			BackSourceAdmissionWaitLimit: 100,
+			ProbeCount:                   10,
+			ReservedUploadLoadRatio:      0.2,
+			Preemption:                   true,
+			CorruptedPieceLimit:          5,
+			HostCorruptedPieceLimit:      20,
+			RemoteEvaluator: RemoteEvaluatorConfig{
+				Addr:    "127.0.0.1:65002",
+				Timeout: 1 * time.Second,
+			},
+			ObjectCache: ObjectCacheConfig{
+				Enable:          true,
+				ObjectSizeLimit: 1048576,
+				SizeLimit:       134217728,
+			},
 			GC: &GCConfig{
-				PeerGCInterval: 1 * time.Minute,
-				PeerTTL:        5 * time.Minute,
-				TaskGCInterval: 1 * time.Minute,
-				TaskTTL:        10 * time.Minute,
-				HostGCInterval: 1 * time.Minute,
-				HostTTL:        10 * time.Minute,
+				PeerGCInterval:     1 * time.Minute,
+				PeerTTL:            5 * time.Minute,
+				TaskGCInterval:     1 * time.Minute,
+				TaskTTL:            10 * time.Minute,
+				TaskLimit:          10000,
+				TaskMemoryLimit:    1024,
+				TaskEvictionPolicy: "lfu",
+				HostGCInterval:     1 * time.Minute,
+				HostTTL:            10 * time.Minute,
 			},
 		},
 		Server: &ServerConfig{
@@ -94,11 +113,30 @@ func TestConfig_Load(t *testing.T) {
 			MaxBackups: 1,
 			BufferSize: 1,
 		},
+		Snapshot: &SnapshotConfig{
+			Enable:   true,
+			Interval: 1 * time.Minute,
+		},
+		Replication: &ReplicationConfig{
+			Enable:   true,
+			Interval: 5 * time.Second,
+		},
+		Federation: &FederationConfig{
+			Enable:           true,
+			Timeout:          1 * time.Second,
+			CostLimit:        10,
+			PeerLimit:        2,
+			NegativeCacheTTL: 5 * time.Second,
+		},
 		Metrics: &MetricsConfig{
 			Enable:         false,
 			Addr:           ":8000",
 			EnablePeerHost: false,
 		},
+		Admin: &AdminConfig{
+			Enable: true,
+			Addr:   ":8004",
+		},
 	}
 
 	schedulerConfigYAML := &Config{}
@@ -127,18 +165,31 @@ func TestConfig_New(t *testing.T) {
 			Port:   8002,
 		},
 		Scheduler: &SchedulerConfig{
-			Algorithm:            "default",
-			BackSourceCount:      3,
-			RetryBackSourceLimit: 5,
-			RetryLimit:           10,
-			RetryInterval:        50 * time.Millisecond,
+			Algorithm:                    "default",
+			BackSourceCount:              3,
+			RetryBackSourceLimit:         5,
+			RetryLimit:                   10,
+			RetryInterval:                50 * time.Millisecond,
+			BackSourceAdmissionWaitLimit: 600,
+			ProbeCount:                   5,
+			ReservedUploadLoadRatio:      0.1,
+			CorruptedPieceLimit:          3,
+			HostCorruptedPieceLimit:      10,
+			RemoteEvaluator: RemoteEvaluatorConfig{
+				Timeout: 200 * time.Millisecond,
+			},
+			ObjectCache: ObjectCacheConfig{
+				ObjectSizeLimit: 512 * 1024,
+				SizeLimit:       64 * 1024 * 1024,
+			},
 			GC: &GCConfig{
-				PeerGCInterval: 10 * time.Minute,
-				PeerTTL:        24 * time.Hour,
-				TaskGCInterval: 10 * time.Minute,
-				TaskTTL:        24 * time.Hour,
-				HostGCInterval: 30 * time.Minute,
-				HostTTL:        48 * time.Hour,
+				PeerGCInterval:     10 * time.Minute,
+				PeerTTL:            24 * time.Hour,
+				TaskGCInterval:     10 * time.Minute,
+				TaskTTL:            24 * time.Hour,
+				TaskEvictionPolicy: "lru",
+				HostGCInterval:     30 * time.Minute,
+				HostTTL:            48 * time.Hour,
 			},
 		},
 		DynConfig: &DynConfig{
@@ -170,9 +221,27 @@ func TestConfig_New(t *testing.T) {
 			MaxBackups: storage.DefaultMaxBackups,
 			BufferSize: storage.DefaultBufferSize,
 		},
+		Snapshot: &SnapshotConfig{
+			Enable:   true,
+			Interval: 5 * time.Minute,
+		},
+		Replication: &ReplicationConfig{
+			Enable:   false,
+			Interval: 10 * time.Second,
+		},
+		Federation: &FederationConfig{
+			Enable:           false,
+			Timeout:          2 * time.Second,
+			CostLimit:        100,
+			PeerLimit:        4,
+			NegativeCacheTTL: 10 * time.Second,
+		},
 		Metrics: &MetricsConfig{
 			Enable:         false,
 			EnablePeerHost: false,
 		},
+		Admin: &AdminConfig{
+			Enable: false,
+		},
 	})
 }
@@ -33,6 +33,10 @@ const (
 
 	// DefaultSchedulerFilterParentLimit is default limit the number for filter traversals.
 	DefaultSchedulerFilterParentLimit = 3
+
+	// DefaultSchedulerCandidateParentLimit is default limit the number of parents
+	// that a peer downloads from at the same time.
+	DefaultSchedulerCandidateParentLimit = 4
 )
 
 // DefaultServerListen is default listen for server.
@@ -47,6 +51,9 @@ const (
 	// DefaultSchedulerAlgorithm is default algorithm for scheduler.
 	DefaultSchedulerAlgorithm = "default"
 
+	// DefaultSchedulerRemoteEvaluatorTimeout is default timeout of remote evaluator.
+	DefaultSchedulerRemoteEvaluatorTimeout = 200 * time.Millisecond
+
 	// DefaultSchedulerBackSourceCount is default back-to-source count for scheduler.
 	DefaultSchedulerBackSourceCount = 3
 
@@ -59,6 +66,31 @@ const (
 	// DefaultSchedulerRetryInterval is default retry interval for scheduler.
 	DefaultSchedulerRetryInterval = 50 * time.Millisecond
 
+	// DefaultSchedulerBackSourceAdmissionWaitLimit is default times of peer waiting for back-to-source admission.
+	DefaultSchedulerBackSourceAdmissionWaitLimit = 600
+
+	// DefaultSchedulerProbeCount is default number of hosts sampled for each host to probe.
+	DefaultSchedulerProbeCount = 5
+
+	// DefaultSchedulerReservedUploadLoadRatio is default ratio of upload load reserved for high priority peers.
+	DefaultSchedulerReservedUploadLoadRatio = 0.1
+
+	// DefaultSchedulerCorruptedPieceLimit is default number of corrupted pieces uploaded by peer to quarantine it.
+	DefaultSchedulerCorruptedPieceLimit = 3
+
+	// DefaultSchedulerHostCorruptedPieceLimit is default number of corrupted pieces uploaded by host to quarantine its peers.
+	DefaultSchedulerHostCorruptedPieceLimit = 10
+
+	// DefaultSchedulerObjectCacheObjectSizeLimit is default maximum size in bytes of a cached object.
+	DefaultSchedulerObjectCacheObjectSizeLimit = 512 * 1024
+
+	// MaxSchedulerObjectCacheObjectSizeLimit is maximum size in bytes of a cached object, the object is
+	// sent inline in the register result, so it is limited below the default 4MB message size of grpc.
+	MaxSchedulerObjectCacheObjectSizeLimit = 2 * 1024 * 1024
+
+	// DefaultSchedulerObjectCacheSizeLimit is default maximum total size in bytes of cached objects.
+	DefaultSchedulerObjectCacheSizeLimit = 64 * 1024 * 1024
+
 	// DefaultSchedulerPeerGCInterval is default interval for peer gc.
 	DefaultSchedulerPeerGCInterval = 10 * time.Minute
 
@@ -71,6 +103,9 @@ const (
 	// DefaultSchedulerTaskTTL is default ttl for task.
 	DefaultSchedulerTaskTTL = 24 * time.Hour
 
+	// DefaultSchedulerTaskEvictionPolicy is default policy of evicting tasks.
+	DefaultSchedulerTaskEvictionPolicy = TaskEvictionPolicyLRU
+
 	// DefaultSchedulerHostGCInterval is default interval for host gc.
 	DefaultSchedulerHostGCInterval = 30 * time.Minute
 
@@ -78,6 +113,14 @@ const (
 	DefaultSchedulerHostTTL = 48 * time.Hour
 )
 
+const (
+	// TaskEvictionPolicyLRU evicts the least recently used idle tasks.
+	TaskEvictionPolicyLRU = "lru"
+
+	// TaskEvictionPolicyLFU evicts the least frequently used idle tasks.
+	TaskEvictionPolicyLFU = "lfu"
+)
+
 const (
 	// DefaultDynConfigRefreshInterval is default refresh interval for dynamic configuration.
 	DefaultDynConfigRefreshInterval = 10 * time.Second
@@ -91,6 +134,30 @@ const (
 	DefaultManagerKeepAliveInterval = 5 * time.Second
 )
 
+const (
+	// DefaultSnapshotInterval is default interval for saving snapshot.
+	DefaultSnapshotInterval = 5 * time.Minute
+)
+
+const (
+	// DefaultReplicationInterval is default interval for replicating succeeded peers.
+	DefaultReplicationInterval = 10 * time.Second
+)
+
+const (
+	// DefaultFederationTimeout is default timeout of finding the peers of task in federated scheduler clusters.
+	DefaultFederationTimeout = 2 * time.Second
+
+	// DefaultFederationCostLimit is default maximum cost of traffic from federated scheduler cluster.
+	DefaultFederationCostLimit = 100
+
+	// DefaultFederationPeerLimit is default maximum number of peers found in federated scheduler cluster.
+	DefaultFederationPeerLimit = 4
+
+	// DefaultFederationNegativeCacheTTL is default time to live of the tasks not found in federated scheduler clusters.
+	DefaultFederationNegativeCacheTTL = 10 * time.Second
+)
+
 const (
 	// DefaultJobGlobalWorkerNum is default global worker number for job.
 	DefaultJobGlobalWorkerNum = 10
@@ -42,6 +42,23 @@ var (
 type DynconfigData struct {
 	SeedPeers        []*SeedPeer       `yaml:"seedPeers" mapstructure:"seedPeers" json:"seed_peers"`
 	SchedulerCluster *SchedulerCluster `yaml:"schedulerCluster" mapstructure:"schedulerCluster" json:"scheduler_cluster"`
+	Schedulers       []*Scheduler      `yaml:"schedulers" mapstructure:"schedulers" json:"schedulers"`
+
+	FederatedSchedulerClusters []*FederatedSchedulerCluster `yaml:"federatedSchedulerClusters" mapstructure:"federatedSchedulerClusters" json:"federated_scheduler_clusters"`
+}
+
+type FederatedSchedulerCluster struct {
+	ID         uint         `yaml:"id" mapstructure:"id" json:"id"`
+	Name       string       `yaml:"name" mapstructure:"name" json:"name"`
+	Cost       uint64       `yaml:"cost" mapstructure:"cost" json:"cost"`
+	Schedulers []*Scheduler `yaml:"schedulers" mapstructure:"schedulers" json:"schedulers"`
+}
+
+type Scheduler struct {
+	ID       uint   `yaml:"id" mapstructure:"id" json:"id"`
+	Hostname string `yaml:"hostname" mapstructure:"hostname" json:"host_name"`
+	IP       string `yaml:"ip" mapstructure:"ip" json:"ip"`
+	Port     int32  `yaml:"port" mapstructure:"port" json:"port"`
 }
 
 type SeedPeer struct {
@@ -75,8 +92,9 @@ type SeedPeerCluster struct {
 }
 
 type SchedulerCluster struct {
-	Config       []byte `yaml:"config" mapstructure:"config" json:"config"`
-	ClientConfig []byte `yaml:"clientConfig" mapstructure:"clientConfig" json:"client_config"`
+	Config          []byte   `yaml:"config" mapstructure:"config" json:"config"`
+	ClientConfig    []byte   `yaml:"clientConfig" mapstructure:"clientConfig" json:"client_config"`
+	CordonedHostIDs []string `yaml:"cordonedHostIDs" mapstructure:"cordonedHostIDs" json:"cordoned_host_ids"`
 }
 
 type DynconfigInterface interface {
@@ -148,7 +166,7 @@ func (d *dynconfig) GetSchedulerClusterConfig() (types.SchedulerClusterConfig, b
 		return types.SchedulerClusterConfig{}, false
 	}
 
-	if data.SchedulerCluster != nil {
+	if data.SchedulerCluster == nil {
 		return types.SchedulerClusterConfig{}, false
 	}
 
@@ -26,6 +26,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"d7y.io/dragonfly/v2/manager/types"
 	"d7y.io/dragonfly/v2/pkg/rpc/manager"
 	"d7y.io/dragonfly/v2/pkg/rpc/manager/client/mocks"
 )
@@ -70,6 +71,27 @@ func TestDynconfig_GetManagerSourceType(t *testing.T) {
 							DownloadPort: 8003,
 						},
 					},
+					Schedulers: []*manager.Scheduler{
+						{
+							HostName: "foo",
+							Ip:       "127.0.0.1",
+							Port:     8002,
+						},
+					},
+					FederatedSchedulerClusters: []*manager.FederatedSchedulerCluster{
+						{
+							Id:   2,
+							Name: "bar",
+							Cost: 10,
+							Schedulers: []*manager.Scheduler{
+								{
+									HostName: "bar",
+									Ip:       "127.0.0.2",
+									Port:     8002,
+								},
+							},
+						},
+					},
 				}, nil).Times(1)
 			},
 			expect: func(t *testing.T, data *DynconfigData, err error) {
@@ -78,6 +100,12 @@ func TestDynconfig_GetManagerSourceType(t *testing.T) {
 				assert.Equal(data.SeedPeers[0].IP, "127.0.0.1")
 				assert.Equal(data.SeedPeers[0].Port, int32(8001))
 				assert.Equal(data.SeedPeers[0].DownloadPort, int32(8003))
+				assert.Equal(data.Schedulers[0].Hostname, "foo")
+				assert.Equal(data.Schedulers[0].IP, "127.0.0.1")
+				assert.Equal(data.Schedulers[0].Port, int32(8002))
+				assert.Equal(data.FederatedSchedulerClusters[0].ID, uint(2))
+				assert.Equal(data.FederatedSchedulerClusters[0].Cost, uint64(10))
+				assert.Equal(data.FederatedSchedulerClusters[0].Schedulers[0].IP, "127.0.0.2")
 			},
 		},
 		{
@@ -136,3 +164,87 @@ func TestDynconfig_GetManagerSourceType(t *testing.T) {
 		})
 	}
 }
+
+func TestDynconfig_GetSchedulerClusterConfig(t *testing.T) {
+	mockCacheDir := t.TempDir()
+	mockConfig := &Config{
+		DynConfig: &DynConfig{
+			RefreshInterval: 10 * time.Second,
+		},
+		Server: &ServerConfig{
+			Host: "localhost",
+		},
+		Manager: &ManagerConfig{
+			SchedulerClusterID: 1,
+		},
+	}
+
+	mockCachePath := filepath.Join(mockCacheDir, cacheFileName)
+	tests := []struct {
+		name   string
+		mock   func(m *mocks.MockClientMockRecorder)
+		expect func(t *testing.T, config types.SchedulerClusterConfig, ok bool)
+	}{
+		{
+			name: "get scheduler cluster config success",
+			mock: func(m *mocks.MockClientMockRecorder) {
+				m.GetScheduler(gomock.Any()).Return(&manager.Scheduler{
+					SchedulerCluster: &manager.SchedulerCluster{
+						Config: []byte(`{"filter_parent_limit": 10, "evaluator_profile": "foo", "evaluator_profiles": [{"name": "foo", "finished_piece_weight": 1}]}`),
+					},
+				}, nil).Times(1)
+			},
+			expect: func(t *testing.T, config types.SchedulerClusterConfig, ok bool) {
+				assert := assert.New(t)
+				assert.True(ok)
+				assert.Equal(config.FilterParentLimit, uint32(10))
+				assert.Equal(config.EvaluatorProfile, "foo")
+				assert.Equal(config.EvaluatorProfiles[0].FinishedPieceWeight, float64(1))
+			},
+		},
+		{
+			name: "scheduler cluster is empty",
+			mock: func(m *mocks.MockClientMockRecorder) {
+				m.GetScheduler(gomock.Any()).Return(&manager.Scheduler{}, nil).Times(1)
+			},
+			expect: func(t *testing.T, config types.SchedulerClusterConfig, ok bool) {
+				assert := assert.New(t)
+				assert.False(ok)
+			},
+		},
+		{
+			name: "scheduler cluster config is invalid",
+			mock: func(m *mocks.MockClientMockRecorder) {
+				m.GetScheduler(gomock.Any()).Return(&manager.Scheduler{
+					SchedulerCluster: &manager.SchedulerCluster{
+						Config: []byte("foo"),
+					},
+				}, nil).Times(1)
+			},
+			expect: func(t *testing.T, config types.SchedulerClusterConfig, ok bool) {
+				assert := assert.New(t)
+				assert.False(ok)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			mockManagerClient := mocks.NewMockClient(ctl)
+			tc.mock(mockManagerClient.EXPECT())
+
+			d, err := NewDynconfig(mockManagerClient, mockCacheDir, mockConfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			config, ok := d.GetSchedulerClusterConfig()
+			tc.expect(t, config, ok)
+			if err := os.Remove(mockCachePath); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
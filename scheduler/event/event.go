@@ -0,0 +1,217 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package event
+
+import (
+	"sync"
+
+	"go.uber.org/atomic"
+
+	rpcscheduler "d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+	"d7y.io/dragonfly/v2/scheduler/metrics"
+)
+
+const (
+	// DefaultBufferSize is the default number of events buffered for a subscription.
+	DefaultBufferSize = 1024
+)
+
+// Hub dispatches lifecycle events to subscriptions, the events are buffered by
+// each subscription and the subscription is dropped when its buffer is full,
+// so slow subscribers never block scheduling.
+type Hub interface {
+	// Publish publishes the event to the matching subscriptions.
+	Publish(*rpcscheduler.Event)
+
+	// Subscribe subscribes the events matching the request.
+	Subscribe(*rpcscheduler.WatchRequest) Subscription
+
+	// Len returns the number of subscriptions.
+	Len() int
+}
+
+// Subscription is the subscription of events.
+type Subscription interface {
+	// Events returns the channel of events.
+	Events() <-chan *rpcscheduler.Event
+
+	// Dropped returns the channel closed when the subscription is dropped.
+	Dropped() <-chan struct{}
+
+	// Close unsubscribes the events.
+	Close()
+}
+
+// Option is a functional option for configuring the hub.
+type Option func(h *hub)
+
+// WithBufferSize sets the number of events buffered for a subscription.
+func WithBufferSize(size int) Option {
+	return func(h *hub) {
+		h.bufferSize = size
+	}
+}
+
+type hub struct {
+	// bufferSize is the number of events buffered for a subscription.
+	bufferSize int
+
+	// subscriptions is the set of subscriptions.
+	subscriptions map[*subscription]struct{}
+
+	// count is the number of subscriptions, publishing returns
+	// without locking when there is no subscription.
+	count *atomic.Int32
+
+	// mu guards subscriptions.
+	mu sync.RWMutex
+}
+
+// New returns a new Hub.
+func New(options ...Option) Hub {
+	h := &hub{
+		bufferSize:    DefaultBufferSize,
+		subscriptions: map[*subscription]struct{}{},
+		count:         atomic.NewInt32(0),
+	}
+
+	for _, opt := range options {
+		opt(h)
+	}
+
+	return h
+}
+
+// Publish publishes the event to the matching subscriptions,
+// the subscriptions whose buffers are full are dropped.
+func (h *hub) Publish(event *rpcscheduler.Event) {
+	if h.count.Load() == 0 {
+		return
+	}
+
+	var dropped []*subscription
+	h.mu.RLock()
+	for s := range h.subscriptions {
+		if !match(s.req, event) {
+			continue
+		}
+
+		select {
+		case s.events <- event:
+		default:
+			dropped = append(dropped, s)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, s := range dropped {
+		metrics.WatchDroppedCount.Inc()
+		s.drop()
+	}
+}
+
+// Subscribe subscribes the events matching the request.
+func (h *hub) Subscribe(req *rpcscheduler.WatchRequest) Subscription {
+	s := &subscription{
+		hub:     h,
+		req:     req,
+		events:  make(chan *rpcscheduler.Event, h.bufferSize),
+		dropped: make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subscriptions[s] = struct{}{}
+	h.count.Store(int32(len(h.subscriptions)))
+	return s
+}
+
+// Len returns the number of subscriptions.
+func (h *hub) Len() int {
+	return int(h.count.Load())
+}
+
+// unsubscribe deletes the subscription.
+func (h *hub) unsubscribe(s *subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subscriptions, s)
+	h.count.Store(int32(len(h.subscriptions)))
+}
+
+type subscription struct {
+	hub     *hub
+	req     *rpcscheduler.WatchRequest
+	events  chan *rpcscheduler.Event
+	dropped chan struct{}
+	once    sync.Once
+}
+
+// Events returns the channel of events.
+func (s *subscription) Events() <-chan *rpcscheduler.Event {
+	return s.events
+}
+
+// Dropped returns the channel closed when the subscription is dropped.
+func (s *subscription) Dropped() <-chan struct{} {
+	return s.dropped
+}
+
+// Close unsubscribes the events.
+func (s *subscription) Close() {
+	s.hub.unsubscribe(s)
+}
+
+// drop unsubscribes the events and notifies the subscriber,
+// the channel of events is never closed because publishers may send to it.
+func (s *subscription) drop() {
+	s.once.Do(func() {
+		s.hub.unsubscribe(s)
+		close(s.dropped)
+	})
+}
+
+// match determines whether the event matches the request.
+func match(req *rpcscheduler.WatchRequest, event *rpcscheduler.Event) bool {
+	if req.TaskId != "" && req.TaskId != event.TaskId {
+		return false
+	}
+
+	if req.Tag != "" && req.Tag != event.Tag {
+		return false
+	}
+
+	if req.Application != "" && req.Application != event.Application {
+		return false
+	}
+
+	if req.HostId != "" && req.HostId != event.HostId {
+		return false
+	}
+
+	if len(req.Types) == 0 {
+		return true
+	}
+
+	for _, t := range req.Types {
+		if t == event.Type {
+			return true
+		}
+	}
+
+	return false
+}
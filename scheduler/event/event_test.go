@@ -0,0 +1,150 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	rpcscheduler "d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+)
+
+var mockEvent = &rpcscheduler.Event{
+	Type:        rpcscheduler.EventType_PEER_SUCCEEDED,
+	TaskId:      "foo",
+	Tag:         "bar",
+	Application: "baz",
+	PeerId:      "qux",
+	HostId:      "quux",
+}
+
+func TestHub_Publish(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    *rpcscheduler.WatchRequest
+		expect func(t *testing.T, s Subscription)
+	}{
+		{
+			name: "subscribe all events",
+			req:  &rpcscheduler.WatchRequest{},
+			expect: func(t *testing.T, s Subscription) {
+				assert := assert.New(t)
+				assert.Equal(mockEvent, <-s.Events())
+			},
+		},
+		{
+			name: "subscribe events of task",
+			req: &rpcscheduler.WatchRequest{
+				TaskId:      "foo",
+				Tag:         "bar",
+				Application: "baz",
+				HostId:      "quux",
+			},
+			expect: func(t *testing.T, s Subscription) {
+				assert := assert.New(t)
+				assert.Equal(mockEvent, <-s.Events())
+			},
+		},
+		{
+			name: "subscribe events of type",
+			req: &rpcscheduler.WatchRequest{
+				Types: []rpcscheduler.EventType{rpcscheduler.EventType_PEER_FAILED, rpcscheduler.EventType_PEER_SUCCEEDED},
+			},
+			expect: func(t *testing.T, s Subscription) {
+				assert := assert.New(t)
+				assert.Equal(mockEvent, <-s.Events())
+			},
+		},
+		{
+			name: "task id does not match",
+			req:  &rpcscheduler.WatchRequest{TaskId: "bar"},
+			expect: func(t *testing.T, s Subscription) {
+				assert := assert.New(t)
+				assert.Len(s.Events(), 0)
+			},
+		},
+		{
+			name: "application does not match",
+			req:  &rpcscheduler.WatchRequest{Application: "foo"},
+			expect: func(t *testing.T, s Subscription) {
+				assert := assert.New(t)
+				assert.Len(s.Events(), 0)
+			},
+		},
+		{
+			name: "type does not match",
+			req: &rpcscheduler.WatchRequest{
+				Types: []rpcscheduler.EventType{rpcscheduler.EventType_TASK_FAILED},
+			},
+			expect: func(t *testing.T, s Subscription) {
+				assert := assert.New(t)
+				assert.Len(s.Events(), 0)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := New()
+			s := h.Subscribe(tc.req)
+			defer s.Close()
+
+			h.Publish(mockEvent)
+			tc.expect(t, s)
+		})
+	}
+}
+
+func TestHub_Drop(t *testing.T) {
+	assert := assert.New(t)
+	h := New(WithBufferSize(1))
+	slow := h.Subscribe(&rpcscheduler.WatchRequest{})
+	other := h.Subscribe(&rpcscheduler.WatchRequest{TaskId: "bar"})
+	assert.Equal(h.Len(), 2)
+
+	h.Publish(mockEvent)
+	select {
+	case <-slow.Dropped():
+		t.Fatal("subscription should not be dropped")
+	default:
+	}
+
+	h.Publish(mockEvent)
+	<-slow.Dropped()
+	assert.Equal(h.Len(), 1)
+	assert.Equal(mockEvent, <-slow.Events())
+
+	h.Publish(mockEvent)
+	assert.Len(slow.Events(), 0)
+
+	other.Close()
+	assert.Equal(h.Len(), 0)
+}
+
+func TestHub_Close(t *testing.T) {
+	assert := assert.New(t)
+	h := New()
+	s := h.Subscribe(&rpcscheduler.WatchRequest{})
+	assert.Equal(h.Len(), 1)
+
+	s.Close()
+	assert.Equal(h.Len(), 0)
+
+	h.Publish(mockEvent)
+	assert.Len(s.Events(), 0)
+}
@@ -0,0 +1,300 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+//go:generate mockgen -destination mocks/federation_mock.go -source federation.go -package mocks
+
+package federation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"google.golang.org/grpc"
+
+	logger "d7y.io/dragonfly/v2/internal/dflog"
+	"d7y.io/dragonfly/v2/pkg/cache"
+	"d7y.io/dragonfly/v2/pkg/dfnet"
+	rpcscheduler "d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+	schedulerclient "d7y.io/dragonfly/v2/pkg/rpc/scheduler/client"
+	"d7y.io/dragonfly/v2/scheduler/config"
+)
+
+// Federation finds the peers of tasks in the scheduler clusters federated with
+// the scheduler cluster, the links between clusters and their costs are configured
+// by manager. The task is owned by the scheduler of federated cluster through
+// consistent hashing of task id, so the client of cluster picks the owner.
+type Federation interface {
+	// FindPeers finds the succeeded peers of task in federated scheduler clusters,
+	// the clusters are queried in parallel and the peers of the cluster having the task
+	// with the lowest cost are returned. The task not found is cached for a short time.
+	FindPeers(ctx context.Context, taskID string, securityDomain string) ([]*rpcscheduler.AnnounceTaskRequest, error)
+
+	// Clusters returns the federated scheduler clusters within the cost limit.
+	Clusters() []*config.FederatedSchedulerCluster
+
+	// Stop closes the clients of federated scheduler clusters.
+	Stop()
+
+	// Observer is dynconfig observer interface.
+	config.Observer
+}
+
+type federation struct {
+	// config is the federation configuration.
+	config *config.FederationConfig
+
+	// newClient returns the grpc client of schedulers.
+	newClient func(addrs []dfnet.NetAddr) (schedulerclient.Client, error)
+
+	// data is the federated scheduler clusters from dynconfig.
+	data []*config.FederatedSchedulerCluster
+
+	// clusters is the federated scheduler clusters within the cost limit,
+	// which are sorted by cost.
+	clusters []*config.FederatedSchedulerCluster
+
+	// clients is the map of cluster id and grpc client of its schedulers.
+	clients map[uint]*clusterClient
+
+	// misses is the cache of tasks not found in federated scheduler clusters.
+	misses cache.Cache
+
+	// mu guards data, clusters and clients.
+	mu sync.RWMutex
+}
+
+// clusterClient is the grpc client of the schedulers of cluster,
+// it is closed after its in-flight calls are finished.
+type clusterClient struct {
+	schedulerclient.Client
+
+	// wg counts the in-flight calls of client.
+	wg sync.WaitGroup
+}
+
+// findPeersResult is the result of finding peers in federated scheduler cluster.
+type findPeersResult struct {
+	peers []*rpcscheduler.AnnounceTaskRequest
+	err   error
+}
+
+// New returns a new Federation.
+func New(cfg *config.Config, dynconfig config.DynconfigInterface, opts ...grpc.DialOption) (Federation, error) {
+	data, err := dynconfig.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	f := &federation{
+		config: cfg.Federation,
+		newClient: func(addrs []dfnet.NetAddr) (schedulerclient.Client, error) {
+			return schedulerclient.GetClientByAddr(addrs, opts...)
+		},
+		clients: map[uint]*clusterClient{},
+		misses:  cache.New(cfg.Federation.NegativeCacheTTL, cfg.Federation.NegativeCacheTTL),
+	}
+	f.setClusters(data.FederatedSchedulerClusters)
+
+	dynconfig.Register(f)
+	return f, nil
+}
+
+// FindPeers finds the succeeded peers of task in federated scheduler clusters.
+func (f *federation) FindPeers(ctx context.Context, taskID string, securityDomain string) ([]*rpcscheduler.AnnounceTaskRequest, error) {
+	key := fmt.Sprintf("%s:%s", taskID, securityDomain)
+	if _, ok := f.misses.Get(key); ok {
+		logger.WithTaskID(taskID).Debug("task has not been found in federated scheduler clusters recently")
+		return nil, nil
+	}
+
+	// Query the clusters in parallel, the queries in flight are
+	// canceled and waited for when the peers are returned.
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ctx, cancel := context.WithTimeout(ctx, f.config.Timeout)
+	defer cancel()
+
+	clusters := f.Clusters()
+	results := make([]chan findPeersResult, len(clusters))
+	for i, cluster := range clusters {
+		results[i] = make(chan findPeersResult, 1)
+		wg.Add(1)
+		go func(cluster *config.FederatedSchedulerCluster, result chan<- findPeersResult) {
+			defer wg.Done()
+			peers, err := f.findPeers(ctx, cluster, taskID, securityDomain)
+			result <- findPeersResult{peers: peers, err: err}
+		}(cluster, results[i])
+	}
+
+	// Pick the peers in ascending order of cost.
+	var errs error
+	for i, cluster := range clusters {
+		result := <-results[i]
+		if result.err != nil {
+			logger.WithTaskID(taskID).Warnf("find task peers in federated scheduler cluster %s failed: %s", cluster.Name, result.err.Error())
+			errs = multierror.Append(errs, result.err)
+			continue
+		}
+
+		if len(result.peers) > 0 {
+			logger.WithTaskID(taskID).Infof("find %d task peers in federated scheduler cluster %s", len(result.peers), cluster.Name)
+			return result.peers, nil
+		}
+	}
+
+	// The errors of clusters are not cached as misses,
+	// otherwise the outages of clusters are hidden.
+	if errs != nil {
+		return nil, errs
+	}
+
+	f.misses.SetDefault(key, struct{}{})
+	return nil, nil
+}
+
+// findPeers finds the succeeded peers of task in federated scheduler cluster.
+func (f *federation) findPeers(ctx context.Context, cluster *config.FederatedSchedulerCluster, taskID string, securityDomain string) ([]*rpcscheduler.AnnounceTaskRequest, error) {
+	client, err := f.loadOrCreateClient(cluster)
+	if err != nil {
+		return nil, err
+	}
+	defer client.wg.Done()
+
+	resp, err := client.FindTaskPeers(ctx, &rpcscheduler.FindTaskPeersRequest{
+		TaskId:         taskID,
+		SecurityDomain: securityDomain,
+		Limit:          f.config.PeerLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Peers, nil
+}
+
+// Clusters returns the federated scheduler clusters within the cost limit.
+func (f *federation) Clusters() []*config.FederatedSchedulerCluster {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.clusters
+}
+
+// Stop closes the clients of federated scheduler clusters.
+func (f *federation) Stop() {
+	f.mu.Lock()
+	clients := f.clients
+	f.clients = map[uint]*clusterClient{}
+	f.mu.Unlock()
+
+	closeClients(clients)
+}
+
+// OnNotify updates the federated scheduler clusters.
+func (f *federation) OnNotify(data *config.DynconfigData) {
+	f.mu.RLock()
+	changed := !reflect.DeepEqual(f.data, data.FederatedSchedulerClusters)
+	f.mu.RUnlock()
+	if !changed {
+		return
+	}
+
+	f.setClusters(data.FederatedSchedulerClusters)
+	logger.Infof("federated scheduler clusters have been updated: %d clusters", len(f.Clusters()))
+}
+
+// setClusters updates the federated scheduler clusters, and closes the clients
+// because the schedulers of clusters may be changed, the clients are closed
+// in the background after their in-flight calls are finished.
+func (f *federation) setClusters(data []*config.FederatedSchedulerCluster) {
+	var clusters []*config.FederatedSchedulerCluster
+	for _, cluster := range data {
+		if cluster.Cost > f.config.CostLimit {
+			logger.Infof("federated scheduler cluster %s is skipped because its cost %d exceeds the limit", cluster.Name, cluster.Cost)
+			continue
+		}
+
+		if len(cluster.Schedulers) == 0 {
+			continue
+		}
+
+		clusters = append(clusters, cluster)
+	}
+
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return clusters[i].Cost < clusters[j].Cost
+	})
+
+	f.mu.Lock()
+	f.data = data
+	f.clusters = clusters
+	clients := f.clients
+	f.clients = map[uint]*clusterClient{}
+	f.mu.Unlock()
+
+	// The tasks not found may be found in the updated clusters.
+	f.misses.Flush()
+	go closeClients(clients)
+}
+
+// closeClients closes the clients of clusters after their in-flight calls are finished.
+func closeClients(clients map[uint]*clusterClient) {
+	for id, client := range clients {
+		client.wg.Wait()
+		if err := client.Close(); err != nil {
+			logger.Errorf("close federated scheduler cluster %d client failed: %s", id, err.Error())
+		}
+	}
+}
+
+// loadOrCreateClient returns the grpc client of the schedulers of cluster,
+// the caller must call wg.Done of client when the call is finished.
+func (f *federation) loadOrCreateClient(cluster *config.FederatedSchedulerCluster) (*clusterClient, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	client, ok := f.clients[cluster.ID]
+	if !ok {
+		c, err := f.newClient(schedulersToNetAddrs(cluster.Schedulers))
+		if err != nil {
+			return nil, err
+		}
+
+		client = &clusterClient{Client: c}
+		f.clients[cluster.ID] = client
+	}
+
+	client.wg.Add(1)
+	return client, nil
+}
+
+// schedulersToNetAddrs coverts []*config.Scheduler to []dfnet.NetAddr.
+func schedulersToNetAddrs(schedulers []*config.Scheduler) []dfnet.NetAddr {
+	netAddrs := make([]dfnet.NetAddr, 0, len(schedulers))
+	for _, scheduler := range schedulers {
+		netAddrs = append(netAddrs, dfnet.NetAddr{
+			Type: dfnet.TCP,
+			Addr: fmt.Sprintf("%s:%d", scheduler.IP, scheduler.Port),
+		})
+	}
+
+	return netAddrs
+}
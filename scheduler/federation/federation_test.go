@@ -0,0 +1,456 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package federation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"d7y.io/dragonfly/v2/pkg/dfnet"
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	rpcscheduler "d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+	schedulerclient "d7y.io/dragonfly/v2/pkg/rpc/scheduler/client"
+	clientmocks "d7y.io/dragonfly/v2/pkg/rpc/scheduler/client/mocks"
+	"d7y.io/dragonfly/v2/scheduler/config"
+	configmocks "d7y.io/dragonfly/v2/scheduler/config/mocks"
+)
+
+var (
+	mockConfig = &config.Config{
+		Federation: &config.FederationConfig{
+			Enable:           true,
+			Timeout:          time.Second,
+			CostLimit:        10,
+			PeerLimit:        2,
+			NegativeCacheTTL: time.Minute,
+		},
+	}
+
+	mockClusters = []*config.FederatedSchedulerCluster{
+		{
+			ID:         1,
+			Name:       "foo",
+			Cost:       5,
+			Schedulers: []*config.Scheduler{{ID: 1, IP: "127.0.0.1", Port: 8002}},
+		},
+		{
+			ID:         2,
+			Name:       "bar",
+			Cost:       1,
+			Schedulers: []*config.Scheduler{{ID: 2, IP: "127.0.0.2", Port: 8002}},
+		},
+		{
+			ID:         3,
+			Name:       "baz",
+			Cost:       20,
+			Schedulers: []*config.Scheduler{{ID: 3, IP: "127.0.0.3", Port: 8002}},
+		},
+		{
+			ID:   4,
+			Name: "qux",
+			Cost: 1,
+		},
+	}
+
+	mockTaskID = "foo"
+
+	mockPeer = &rpcscheduler.AnnounceTaskRequest{
+		TaskId: mockTaskID,
+		PiecePacket: &base.PiecePacket{
+			DstPid: "bar",
+		},
+	}
+
+	mockFarPeer = &rpcscheduler.AnnounceTaskRequest{
+		TaskId: mockTaskID,
+		PiecePacket: &base.PiecePacket{
+			DstPid: "baz",
+		},
+	}
+)
+
+func TestFederation_New(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(dynconfig *configmocks.MockDynconfigInterfaceMockRecorder)
+		expect func(t *testing.T, f Federation, err error)
+	}{
+		{
+			name: "new federation",
+			mock: func(dynconfig *configmocks.MockDynconfigInterfaceMockRecorder) {
+				gomock.InOrder(
+					dynconfig.Get().Return(&config.DynconfigData{
+						FederatedSchedulerClusters: mockClusters,
+					}, nil).Times(1),
+					dynconfig.Register(gomock.Any()).Return().Times(1),
+				)
+			},
+			expect: func(t *testing.T, f Federation, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.EqualValues(f.Clusters(), []*config.FederatedSchedulerCluster{mockClusters[1], mockClusters[0]})
+			},
+		},
+		{
+			name: "new federation without clusters",
+			mock: func(dynconfig *configmocks.MockDynconfigInterfaceMockRecorder) {
+				gomock.InOrder(
+					dynconfig.Get().Return(&config.DynconfigData{}, nil).Times(1),
+					dynconfig.Register(gomock.Any()).Return().Times(1),
+				)
+			},
+			expect: func(t *testing.T, f Federation, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(len(f.Clusters()), 0)
+			},
+		},
+		{
+			name: "new federation failed because of dynconfig get error data",
+			mock: func(dynconfig *configmocks.MockDynconfigInterfaceMockRecorder) {
+				dynconfig.Get().Return(nil, errors.New("foo")).Times(1)
+			},
+			expect: func(t *testing.T, f Federation, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "foo")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			tc.mock(dynconfig.EXPECT())
+
+			f, err := New(mockConfig, dynconfig)
+			tc.expect(t, f, err)
+		})
+	}
+}
+
+func TestFederation_FindPeers(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(foo, bar *clientmocks.MockClientMockRecorder)
+		expect func(t *testing.T, peers []*rpcscheduler.AnnounceTaskRequest, err error)
+	}{
+		{
+			name: "find peers in the cluster with the lowest cost",
+			mock: func(foo, bar *clientmocks.MockClientMockRecorder) {
+				bar.FindTaskPeers(gomock.Any(), &rpcscheduler.FindTaskPeersRequest{
+					TaskId:         mockTaskID,
+					SecurityDomain: "domain",
+					Limit:          2,
+				}).Return(&rpcscheduler.FindTaskPeersResponse{
+					Peers: []*rpcscheduler.AnnounceTaskRequest{mockPeer},
+				}, nil).Times(1)
+				foo.FindTaskPeers(gomock.Any(), gomock.Any()).Return(&rpcscheduler.FindTaskPeersResponse{
+					Peers: []*rpcscheduler.AnnounceTaskRequest{mockFarPeer},
+				}, nil).Times(1)
+			},
+			expect: func(t *testing.T, peers []*rpcscheduler.AnnounceTaskRequest, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.EqualValues(peers, []*rpcscheduler.AnnounceTaskRequest{mockPeer})
+			},
+		},
+		{
+			name: "find peers in the next cluster when task is not found",
+			mock: func(foo, bar *clientmocks.MockClientMockRecorder) {
+				bar.FindTaskPeers(gomock.Any(), gomock.Any()).Return(&rpcscheduler.FindTaskPeersResponse{}, nil).Times(1)
+				foo.FindTaskPeers(gomock.Any(), gomock.Any()).Return(&rpcscheduler.FindTaskPeersResponse{
+					Peers: []*rpcscheduler.AnnounceTaskRequest{mockPeer},
+				}, nil).Times(1)
+			},
+			expect: func(t *testing.T, peers []*rpcscheduler.AnnounceTaskRequest, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.EqualValues(peers, []*rpcscheduler.AnnounceTaskRequest{mockPeer})
+			},
+		},
+		{
+			name: "find peers in the next cluster when cluster is unavailable",
+			mock: func(foo, bar *clientmocks.MockClientMockRecorder) {
+				bar.FindTaskPeers(gomock.Any(), gomock.Any()).Return(nil, errors.New("foo")).Times(1)
+				foo.FindTaskPeers(gomock.Any(), gomock.Any()).Return(&rpcscheduler.FindTaskPeersResponse{
+					Peers: []*rpcscheduler.AnnounceTaskRequest{mockPeer},
+				}, nil).Times(1)
+			},
+			expect: func(t *testing.T, peers []*rpcscheduler.AnnounceTaskRequest, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.EqualValues(peers, []*rpcscheduler.AnnounceTaskRequest{mockPeer})
+			},
+		},
+		{
+			name: "task is not found in clusters",
+			mock: func(foo, bar *clientmocks.MockClientMockRecorder) {
+				bar.FindTaskPeers(gomock.Any(), gomock.Any()).Return(&rpcscheduler.FindTaskPeersResponse{}, nil).Times(1)
+				foo.FindTaskPeers(gomock.Any(), gomock.Any()).Return(&rpcscheduler.FindTaskPeersResponse{}, nil).Times(1)
+			},
+			expect: func(t *testing.T, peers []*rpcscheduler.AnnounceTaskRequest, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(len(peers), 0)
+			},
+		},
+		{
+			name: "clusters are unavailable",
+			mock: func(foo, bar *clientmocks.MockClientMockRecorder) {
+				bar.FindTaskPeers(gomock.Any(), gomock.Any()).Return(nil, errors.New("bar")).Times(1)
+				foo.FindTaskPeers(gomock.Any(), gomock.Any()).Return(nil, errors.New("foo")).Times(1)
+			},
+			expect: func(t *testing.T, peers []*rpcscheduler.AnnounceTaskRequest, err error) {
+				assert := assert.New(t)
+				assert.Error(err)
+				assert.Contains(err.Error(), "bar")
+				assert.Contains(err.Error(), "foo")
+				assert.Equal(len(peers), 0)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			foo := clientmocks.NewMockClient(ctl)
+			bar := clientmocks.NewMockClient(ctl)
+			dynconfig.EXPECT().Get().Return(&config.DynconfigData{FederatedSchedulerClusters: mockClusters}, nil).Times(1)
+			dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1)
+			tc.mock(foo.EXPECT(), bar.EXPECT())
+
+			f, err := New(mockConfig, dynconfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fd := f.(*federation)
+			fd.newClient = func(addrs []dfnet.NetAddr) (schedulerclient.Client, error) {
+				if addrs[0].Addr == "127.0.0.1:8002" {
+					return foo, nil
+				}
+				return bar, nil
+			}
+
+			peers, err := f.FindPeers(context.Background(), mockTaskID, "domain")
+			tc.expect(t, peers, err)
+		})
+	}
+}
+
+func TestFederation_FindPeersWithNegativeCache(t *testing.T) {
+	tests := []struct {
+		name string
+		mock func(foo, bar *clientmocks.MockClientMockRecorder)
+		run  func(t *testing.T, f Federation)
+	}{
+		{
+			name: "task not found is cached",
+			mock: func(foo, bar *clientmocks.MockClientMockRecorder) {
+				bar.FindTaskPeers(gomock.Any(), gomock.Any()).Return(&rpcscheduler.FindTaskPeersResponse{}, nil).Times(1)
+				foo.FindTaskPeers(gomock.Any(), gomock.Any()).Return(&rpcscheduler.FindTaskPeersResponse{}, nil).Times(1)
+			},
+			run: func(t *testing.T, f Federation) {
+				assert := assert.New(t)
+				for i := 0; i < 2; i++ {
+					peers, err := f.FindPeers(context.Background(), mockTaskID, "domain")
+					assert.NoError(err)
+					assert.Equal(len(peers), 0)
+				}
+			},
+		},
+		{
+			name: "task not found is cached by security domain",
+			mock: func(foo, bar *clientmocks.MockClientMockRecorder) {
+				bar.FindTaskPeers(gomock.Any(), gomock.Any()).Return(&rpcscheduler.FindTaskPeersResponse{}, nil).Times(2)
+				foo.FindTaskPeers(gomock.Any(), gomock.Any()).Return(&rpcscheduler.FindTaskPeersResponse{}, nil).Times(2)
+			},
+			run: func(t *testing.T, f Federation) {
+				assert := assert.New(t)
+				_, err := f.FindPeers(context.Background(), mockTaskID, "foo")
+				assert.NoError(err)
+				_, err = f.FindPeers(context.Background(), mockTaskID, "bar")
+				assert.NoError(err)
+			},
+		},
+		{
+			name: "unavailable clusters are not cached",
+			mock: func(foo, bar *clientmocks.MockClientMockRecorder) {
+				bar.FindTaskPeers(gomock.Any(), gomock.Any()).Return(&rpcscheduler.FindTaskPeersResponse{}, nil).Times(2)
+				foo.FindTaskPeers(gomock.Any(), gomock.Any()).Return(nil, errors.New("foo")).Times(2)
+			},
+			run: func(t *testing.T, f Federation) {
+				assert := assert.New(t)
+				for i := 0; i < 2; i++ {
+					peers, err := f.FindPeers(context.Background(), mockTaskID, "domain")
+					assert.Error(err)
+					assert.Equal(len(peers), 0)
+				}
+			},
+		},
+		{
+			name: "cache is flushed when clusters are changed",
+			mock: func(foo, bar *clientmocks.MockClientMockRecorder) {
+				bar.FindTaskPeers(gomock.Any(), gomock.Any()).Return(&rpcscheduler.FindTaskPeersResponse{}, nil).Times(1)
+				bar.Close().Return(nil).AnyTimes()
+				gomock.InOrder(
+					foo.FindTaskPeers(gomock.Any(), gomock.Any()).Return(&rpcscheduler.FindTaskPeersResponse{}, nil).Times(1),
+					foo.FindTaskPeers(gomock.Any(), gomock.Any()).Return(&rpcscheduler.FindTaskPeersResponse{
+						Peers: []*rpcscheduler.AnnounceTaskRequest{mockPeer},
+					}, nil).Times(1),
+				)
+				foo.Close().Return(nil).AnyTimes()
+			},
+			run: func(t *testing.T, f Federation) {
+				assert := assert.New(t)
+				_, err := f.FindPeers(context.Background(), mockTaskID, "domain")
+				assert.NoError(err)
+
+				f.OnNotify(&config.DynconfigData{
+					FederatedSchedulerClusters: []*config.FederatedSchedulerCluster{mockClusters[0]},
+				})
+				peers, err := f.FindPeers(context.Background(), mockTaskID, "domain")
+				assert.NoError(err)
+				assert.EqualValues(peers, []*rpcscheduler.AnnounceTaskRequest{mockPeer})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			foo := clientmocks.NewMockClient(ctl)
+			bar := clientmocks.NewMockClient(ctl)
+			dynconfig.EXPECT().Get().Return(&config.DynconfigData{FederatedSchedulerClusters: mockClusters}, nil).Times(1)
+			dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1)
+			tc.mock(foo.EXPECT(), bar.EXPECT())
+
+			f, err := New(mockConfig, dynconfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fd := f.(*federation)
+			fd.newClient = func(addrs []dfnet.NetAddr) (schedulerclient.Client, error) {
+				if addrs[0].Addr == "127.0.0.1:8002" {
+					return foo, nil
+				}
+				return bar, nil
+			}
+
+			tc.run(t, f)
+		})
+	}
+}
+
+func TestFederation_OnNotify(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   *config.DynconfigData
+		mock   func(client *clusterClient, mc *clientmocks.MockClientMockRecorder, done chan struct{})
+		expect func(t *testing.T, f *federation, client *clusterClient, done chan struct{})
+	}{
+		{
+			name: "clusters are changed",
+			data: &config.DynconfigData{
+				FederatedSchedulerClusters: []*config.FederatedSchedulerCluster{mockClusters[0]},
+			},
+			mock: func(client *clusterClient, mc *clientmocks.MockClientMockRecorder, done chan struct{}) {
+				mc.Close().DoAndReturn(func() error {
+					close(done)
+					return nil
+				}).Times(1)
+			},
+			expect: func(t *testing.T, f *federation, client *clusterClient, done chan struct{}) {
+				assert := assert.New(t)
+				<-done
+				assert.EqualValues(f.Clusters(), []*config.FederatedSchedulerCluster{mockClusters[0]})
+				assert.Equal(len(f.clients), 0)
+			},
+		},
+		{
+			name: "clients are closed after in-flight calls are finished",
+			data: &config.DynconfigData{
+				FederatedSchedulerClusters: []*config.FederatedSchedulerCluster{mockClusters[0]},
+			},
+			mock: func(client *clusterClient, mc *clientmocks.MockClientMockRecorder, done chan struct{}) {
+				client.wg.Add(1)
+				mc.Close().DoAndReturn(func() error {
+					close(done)
+					return nil
+				}).Times(1)
+			},
+			expect: func(t *testing.T, f *federation, client *clusterClient, done chan struct{}) {
+				assert := assert.New(t)
+				select {
+				case <-done:
+					t.Fatal("client is closed with in-flight calls")
+				case <-time.After(100 * time.Millisecond):
+				}
+
+				client.wg.Done()
+				<-done
+				assert.Equal(len(f.clients), 0)
+			},
+		},
+		{
+			name: "clusters are not changed",
+			data: &config.DynconfigData{
+				FederatedSchedulerClusters: mockClusters,
+			},
+			mock: func(client *clusterClient, mc *clientmocks.MockClientMockRecorder, done chan struct{}) {},
+			expect: func(t *testing.T, f *federation, client *clusterClient, done chan struct{}) {
+				assert := assert.New(t)
+				assert.EqualValues(f.Clusters(), []*config.FederatedSchedulerCluster{mockClusters[1], mockClusters[0]})
+				assert.Equal(len(f.clients), 1)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			mockClient := clientmocks.NewMockClient(ctl)
+			dynconfig.EXPECT().Get().Return(&config.DynconfigData{FederatedSchedulerClusters: mockClusters}, nil).Times(1)
+			dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1)
+			client := &clusterClient{Client: mockClient}
+			done := make(chan struct{})
+			tc.mock(client, mockClient.EXPECT(), done)
+
+			f, err := New(mockConfig, dynconfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fd := f.(*federation)
+			fd.clients[mockClusters[0].ID] = client
+
+			f.OnNotify(tc.data)
+			tc.expect(t, fd, client, done)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: federation.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	scheduler "d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+	config "d7y.io/dragonfly/v2/scheduler/config"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFederation is a mock of Federation interface.
+type MockFederation struct {
+	ctrl     *gomock.Controller
+	recorder *MockFederationMockRecorder
+}
+
+// MockFederationMockRecorder is the mock recorder for MockFederation.
+type MockFederationMockRecorder struct {
+	mock *MockFederation
+}
+
+// NewMockFederation creates a new mock instance.
+func NewMockFederation(ctrl *gomock.Controller) *MockFederation {
+	mock := &MockFederation{ctrl: ctrl}
+	mock.recorder = &MockFederationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFederation) EXPECT() *MockFederationMockRecorder {
+	return m.recorder
+}
+
+// Clusters mocks base method.
+func (m *MockFederation) Clusters() []*config.FederatedSchedulerCluster {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clusters")
+	ret0, _ := ret[0].([]*config.FederatedSchedulerCluster)
+	return ret0
+}
+
+// Clusters indicates an expected call of Clusters.
+func (mr *MockFederationMockRecorder) Clusters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clusters", reflect.TypeOf((*MockFederation)(nil).Clusters))
+}
+
+// FindPeers mocks base method.
+func (m *MockFederation) FindPeers(ctx context.Context, taskID, securityDomain string) ([]*scheduler.AnnounceTaskRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPeers", ctx, taskID, securityDomain)
+	ret0, _ := ret[0].([]*scheduler.AnnounceTaskRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPeers indicates an expected call of FindPeers.
+func (mr *MockFederationMockRecorder) FindPeers(ctx, taskID, securityDomain interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPeers", reflect.TypeOf((*MockFederation)(nil).FindPeers), ctx, taskID, securityDomain)
+}
+
+// OnNotify mocks base method.
+func (m *MockFederation) OnNotify(arg0 *config.DynconfigData) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnNotify", arg0)
+}
+
+// OnNotify indicates an expected call of OnNotify.
+func (mr *MockFederationMockRecorder) OnNotify(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnNotify", reflect.TypeOf((*MockFederation)(nil).OnNotify), arg0)
+}
+
+// Stop mocks base method.
+func (m *MockFederation) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockFederationMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockFederation)(nil).Stop))
+}
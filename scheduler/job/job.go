@@ -21,20 +21,57 @@ package job
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
+	machineryv1tasks "github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/go-http-utils/headers"
 	"github.com/go-playground/validator/v10"
+	"golang.org/x/sync/errgroup"
 
 	logger "d7y.io/dragonfly/v2/internal/dflog"
 	internaljob "d7y.io/dragonfly/v2/internal/job"
+	"d7y.io/dragonfly/v2/pkg/container/set"
+	"d7y.io/dragonfly/v2/pkg/dfnet"
 	"d7y.io/dragonfly/v2/pkg/idgen"
 	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	"d7y.io/dragonfly/v2/pkg/rpc/base/common"
 	"d7y.io/dragonfly/v2/pkg/rpc/cdnsystem"
+	"d7y.io/dragonfly/v2/pkg/rpc/dfdaemon"
+	dfdaemonclient "d7y.io/dragonfly/v2/pkg/rpc/dfdaemon/client"
 	"d7y.io/dragonfly/v2/scheduler/config"
 	"d7y.io/dragonfly/v2/scheduler/resource"
+	"d7y.io/dragonfly/v2/scheduler/scheduler"
 )
 
+const (
+	// preheatProgressInterval is the interval of reporting preheat progress
+	// and checking whether the preheat has been canceled.
+	preheatProgressInterval = 5 * time.Second
+
+	// drainHostInterval is the interval of rescheduling the children of draining host
+	// and reporting drain progress.
+	drainHostInterval = 5 * time.Second
+
+	// drainHostTimeout is the timeout of waiting for the draining host to have no children.
+	drainHostTimeout = 10 * time.Minute
+
+	// purgeTaskTimeout is the timeout of notifying dfdaemon to delete the task data.
+	purgeTaskTimeout = 30 * time.Second
+
+	// purgeTaskConcurrency is the max number of hosts purged concurrently.
+	purgeTaskConcurrency = 16
+)
+
+// errPreheatCanceled is the error of preheat canceled by manager.
+var errPreheatCanceled = errors.New("preheat has been canceled")
+
+// errDrainHostCanceled is the error of draining host canceled by manager.
+var errDrainHostCanceled = errors.New("drain host has been canceled")
+
 type Job interface {
 	Serve()
 	Stop()
@@ -45,10 +82,28 @@ type job struct {
 	schedulerJob *internaljob.Job
 	localJob     *internaljob.Job
 	resource     resource.Resource
+	scheduler    scheduler.Scheduler
 	config       *config.Config
+
+	// newDaemonClient returns the client of dfdaemon by address.
+	newDaemonClient func(addr string) (dfdaemonclient.DaemonClient, error)
+
+	// objectCache caches the contents of small tasks, it is nil when disabled.
+	objectCache resource.ObjectCache
 }
 
-func New(cfg *config.Config, resource resource.Resource) (Job, error) {
+// Option is a functional option for configuring the job.
+type Option func(j *job)
+
+// WithObjectCache sets the object cache shared with scheduler service,
+// the cached contents of purged tasks are deleted.
+func WithObjectCache(objectCache resource.ObjectCache) Option {
+	return func(j *job) {
+		j.objectCache = objectCache
+	}
+}
+
+func New(cfg *config.Config, resource resource.Resource, scheduler scheduler.Scheduler, options ...Option) (Job, error) {
 	redisConfig := &internaljob.Config{
 		Host:      cfg.Job.Redis.Host,
 		Port:      cfg.Job.Redis.Port,
@@ -89,15 +144,28 @@ func New(cfg *config.Config, resource resource.Resource) (Job, error) {
 		schedulerJob: schedulerJob,
 		localJob:     localJob,
 		resource:     resource,
+		scheduler:    scheduler,
 		config:       cfg,
+		newDaemonClient: func(addr string) (dfdaemonclient.DaemonClient, error) {
+			return dfdaemonclient.GetClientByAddr([]dfnet.NetAddr{{Type: dfnet.TCP, Addr: addr}})
+		},
+	}
+
+	for _, opt := range options {
+		opt(t)
 	}
 
 	namedJobFuncs := map[string]any{
-		internaljob.PreheatJob: t.preheat,
+		internaljob.PreheatJob:      t.preheat,
+		internaljob.CordonHostJob:   t.cordonHost,
+		internaljob.UncordonHostJob: t.uncordonHost,
+		internaljob.DrainHostJob:    t.drainHost,
+		internaljob.PurgeTaskJob:    t.purgeTask,
+		internaljob.GetTaskJob:      t.getTask,
 	}
 
 	if err := localJob.RegisterJob(namedJobFuncs); err != nil {
-		logger.Errorf("register preheat job to local queue error: %s", err.Error())
+		logger.Errorf("register jobs to local queue error: %s", err.Error())
 		return nil, err
 	}
 
@@ -128,9 +196,15 @@ func (j *job) Serve() {
 }
 
 func (j *job) Stop() {
-	j.globalJob.Worker.Quit()
-	j.schedulerJob.Worker.Quit()
-	j.localJob.Worker.Quit()
+	for _, job := range []*internaljob.Job{j.globalJob, j.schedulerJob, j.localJob} {
+		if job.Worker != nil {
+			job.Worker.Quit()
+		}
+
+		if err := job.Close(); err != nil {
+			logger.Errorf("close job queue %s failed: %s", job.Queue, err.Error())
+		}
+	}
 }
 
 func (j *job) preheat(ctx context.Context, req string) error {
@@ -149,18 +223,9 @@ func (j *job) preheat(ctx context.Context, req string) error {
 		return err
 	}
 
-	urlMeta := &base.UrlMeta{
-		Header: request.Headers,
-		Tag:    request.Tag,
-		Filter: request.Filter,
-		Digest: request.Digest,
-	}
-	if request.Headers != nil {
-		if r, ok := request.Headers[headers.Range]; ok {
-			// Range in dragonfly is without "bytes=".
-			urlMeta.Range = strings.TrimLeft(r, "bytes=")
-		}
-	}
+	urlMeta := newURLMeta(request.Headers, request.Tag, request.Filter, request.Digest)
+	// Preheat is the background download.
+	urlMeta.Priority = base.Priority_LOW_PRIORITY
 
 	taskID := idgen.TaskID(request.URL, urlMeta)
 
@@ -168,12 +233,28 @@ func (j *job) preheat(ctx context.Context, req string) error {
 	log := logger.WithTaskIDAndURL(taskID, request.URL)
 	log.Infof("preheat %s headers: %#v, tag: %s, range: %s, filter: %s, digest: %s",
 		request.URL, urlMeta.Header, urlMeta.Tag, urlMeta.Range, urlMeta.Filter, urlMeta.Digest)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	progress := &preheatProgress{}
+	done := make(chan struct{})
+	defer close(done)
+	if signature := machineryv1tasks.SignatureFromContext(ctx); signature != nil && signature.GroupUUID != "" {
+		go j.watchPreheat(ctx, cancel, signature, progress, done)
+		defer j.reportPreheatProgress(signature, progress)
+	}
+
 	stream, err := j.resource.SeedPeer().Client().ObtainSeeds(ctx, &cdnsystem.SeedRequest{
 		TaskId:  taskID,
 		Url:     request.URL,
 		UrlMeta: urlMeta,
 	})
 	if err != nil {
+		if progress.canceled() {
+			log.Warn("preheat has been canceled")
+			return errPreheatCanceled
+		}
+
 		log.Errorf("preheat failed: %s", err.Error())
 		return err
 	}
@@ -181,13 +262,487 @@ func (j *job) preheat(ctx context.Context, req string) error {
 	for {
 		piece, err := stream.Recv()
 		if err != nil {
+			if progress.canceled() {
+				log.Warn("preheat has been canceled")
+				return errPreheatCanceled
+			}
+
 			log.Errorf("preheat recive piece failed: %s", err.Error())
 			return err
 		}
 
+		progress.update(piece)
 		if piece.Done == true {
 			log.Info("preheat succeeded")
 			return nil
 		}
 	}
 }
+
+// newURLMeta returns the url meta of task, it is same as the url meta of dfdaemon
+// downloading the url, so that the task id is matched.
+func newURLMeta(header map[string]string, tag, filter, digest string) *base.UrlMeta {
+	urlMeta := &base.UrlMeta{
+		Header: header,
+		Tag:    tag,
+		Filter: filter,
+		Digest: digest,
+	}
+	if header != nil {
+		if r, ok := header[headers.Range]; ok {
+			// Range in dragonfly is without "bytes=".
+			urlMeta.Range = strings.TrimLeft(r, "bytes=")
+		}
+	}
+
+	return urlMeta
+}
+
+// watchPreheat reports the progress of preheat periodically, and cancels
+// the download of seed peer when the preheat is canceled by manager.
+func (j *job) watchPreheat(ctx context.Context, cancel context.CancelFunc, signature *machineryv1tasks.Signature, progress *preheatProgress, done <-chan struct{}) {
+	tick := time.NewTicker(preheatProgressInterval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-tick.C:
+			j.reportPreheatProgress(signature, progress)
+
+			canceled, err := j.localJob.IsGroupJobCanceled(ctx, signature.GroupUUID)
+			if err != nil {
+				logger.Errorf("get preheat group job %s canceled failed: %s", signature.GroupUUID, err.Error())
+				continue
+			}
+
+			if canceled {
+				logger.Infof("preheat group job %s has been canceled", signature.GroupUUID)
+				progress.cancel()
+				cancel()
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// reportPreheatProgress stores the progress of preheat into job backend.
+func (j *job) reportPreheatProgress(signature *machineryv1tasks.Signature, progress *preheatProgress) {
+	if err := j.localJob.SetJobProgress(context.Background(), signature.GroupUUID, signature.UUID, progress.load()); err != nil {
+		logger.Errorf("report preheat job %s progress failed: %s", signature.UUID, err.Error())
+	}
+}
+
+// preheatProgress is the progress of preheat, updated by the pieces
+// received from seed peer.
+type preheatProgress struct {
+	progress   internaljob.PreheatProgress
+	isCanceled bool
+	mu         sync.RWMutex
+}
+
+// update updates the progress by the piece received from seed peer.
+func (p *preheatProgress) update(piece *cdnsystem.PieceSeed) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.progress.SeedPeerID = piece.PeerId
+	p.progress.SeedPeerHostID = piece.HostId
+	p.progress.TotalPieceCount = piece.TotalPieceCount
+	p.progress.ContentLength = piece.ContentLength
+	p.progress.Done = piece.Done
+	p.progress.UpdatedAt = time.Now()
+	if piece.PieceInfo != nil && piece.PieceInfo.PieceNum != common.BeginOfPiece {
+		p.progress.FinishedPieceCount++
+		p.progress.CompletedLength += int64(piece.PieceInfo.RangeSize)
+	}
+}
+
+// load returns the snapshot of progress.
+func (p *preheatProgress) load() internaljob.PreheatProgress {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.progress
+}
+
+// cancel marks the preheat as canceled.
+func (p *preheatProgress) cancel() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.isCanceled = true
+}
+
+// canceled returns whether the preheat has been canceled.
+func (p *preheatProgress) canceled() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.isCanceled
+}
+
+// cordonHost excludes the host from candidate parents.
+func (j *job) cordonHost(ctx context.Context, req string) error {
+	request, err := unmarshalHostRequest(req)
+	if err != nil {
+		return err
+	}
+
+	j.resource.HostManager().Cordon(request.HostID)
+	logger.WithHostID(request.HostID).Info("cordon host")
+	return nil
+}
+
+// uncordonHost makes the host available as candidate parent again.
+func (j *job) uncordonHost(ctx context.Context, req string) error {
+	request, err := unmarshalHostRequest(req)
+	if err != nil {
+		return err
+	}
+
+	j.resource.HostManager().Uncordon(request.HostID)
+	logger.WithHostID(request.HostID).Info("uncordon host")
+	return nil
+}
+
+// drainHost cordons the host and reschedules its children to the parents on other hosts,
+// the job succeeds when the host has no more children.
+func (j *job) drainHost(ctx context.Context, req string) error {
+	request, err := unmarshalHostRequest(req)
+	if err != nil {
+		return err
+	}
+
+	log := logger.WithHostID(request.HostID)
+	j.resource.HostManager().Cordon(request.HostID)
+	log.Info("drain host")
+
+	signature := machineryv1tasks.SignatureFromContext(ctx)
+	ctx, cancel := context.WithTimeout(ctx, drainHostTimeout)
+	defer cancel()
+
+	tick := time.NewTicker(drainHostInterval)
+	defer tick.Stop()
+	for {
+		childCount := j.rescheduleChildren(ctx, request.HostID)
+		if signature != nil && signature.GroupUUID != "" {
+			if err := j.localJob.SetJobProgress(context.Background(), signature.GroupUUID, signature.UUID, internaljob.DrainHostProgress{
+				HostID:     request.HostID,
+				ChildCount: childCount,
+				Done:       childCount == 0,
+				UpdatedAt:  time.Now(),
+			}); err != nil {
+				log.Errorf("report drain host job %s progress failed: %s", signature.UUID, err.Error())
+			}
+		}
+
+		if childCount == 0 {
+			log.Info("host has been drained")
+			return nil
+		}
+
+		select {
+		case <-tick.C:
+			if signature == nil || signature.GroupUUID == "" {
+				continue
+			}
+
+			canceled, err := j.localJob.IsGroupJobCanceled(ctx, signature.GroupUUID)
+			if err != nil {
+				log.Errorf("get drain host group job %s canceled failed: %s", signature.GroupUUID, err.Error())
+				continue
+			}
+
+			if canceled {
+				log.Warn("drain host has been canceled")
+				return errDrainHostCanceled
+			}
+		case <-ctx.Done():
+			log.Errorf("drain host failed: %d children left", childCount)
+			return ctx.Err()
+		}
+	}
+}
+
+// rescheduleChildren reschedules the running children of peers on the host
+// to other parents, and returns the count of children left.
+func (j *job) rescheduleChildren(ctx context.Context, hostID string) int {
+	// Succeeded peers are deleted from the peers of host,
+	// so the parents are found by ranging all peers.
+	var childCount int
+	j.resource.PeerManager().Range(func(_, value any) bool {
+		parent, ok := value.(*resource.Peer)
+		if !ok || parent.Host.ID != hostID {
+			return true
+		}
+
+		for _, child := range parent.Children() {
+			if child.FSM.Is(resource.PeerStateRunning) {
+				blocklist := set.NewSafeSet()
+				blocklist.Add(parent.ID)
+				if _, ok := j.scheduler.NotifyAndFindParent(ctx, child, blocklist); ok {
+					child.Log.Infof("reschedule peer from draining host %s", hostID)
+					continue
+				}
+			}
+
+			childCount++
+		}
+
+		return true
+	})
+
+	return childCount
+}
+
+// unmarshalHostRequest unmarshals and validates the request of host jobs.
+func unmarshalHostRequest(req string) (*internaljob.HostRequest, error) {
+	request := &internaljob.HostRequest{}
+	if err := internaljob.UnmarshalRequest(req, request); err != nil {
+		logger.Errorf("unmarshal request err: %s, request body: %s", err.Error(), req)
+		return nil, err
+	}
+
+	if err := validator.New().Struct(request); err != nil {
+		logger.Errorf("host request %#v validate failed: %s", request, err.Error())
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// purgeTask deletes the task from all hosts holding it, and forgets the finished peers of
+// purged hosts. The task is forgotten only when all hosts have been purged and no running
+// peers are left, so that the task is downloaded again from source or seed peer, and the
+// hosts failed to purge can be purged again by retrying the job.
+func (j *job) purgeTask(ctx context.Context, req string) error {
+	taskID, err := unmarshalTaskRequest(req)
+	if err != nil {
+		return err
+	}
+
+	log := logger.WithTaskID(taskID)
+	progress := internaljob.PurgeTaskProgress{TaskID: taskID}
+	task, ok := j.resource.TaskManager().Load(taskID)
+	if ok {
+		progress.Hosts = j.purgeHosts(ctx, task)
+	} else {
+		log.Info("task not found, skip purge")
+	}
+
+	if j.objectCache != nil {
+		j.objectCache.Delete(taskID)
+	}
+
+	var failedCount int
+	for _, host := range progress.Hosts {
+		if !host.Success {
+			failedCount++
+		}
+	}
+
+	if ok && failedCount == 0 {
+		if peerCount := task.PeerCount.Load(); peerCount > 0 {
+			log.Infof("task is kept for %d running peers", peerCount)
+		} else {
+			j.resource.TaskManager().Delete(task.ID)
+		}
+	}
+
+	progress.Done = true
+	progress.UpdatedAt = time.Now()
+	if signature := machineryv1tasks.SignatureFromContext(ctx); signature != nil && signature.GroupUUID != "" {
+		if err := j.localJob.SetJobProgress(context.Background(), signature.GroupUUID, signature.UUID, progress); err != nil {
+			log.Errorf("report purge task job %s progress failed: %s", signature.UUID, err.Error())
+		}
+	}
+
+	if failedCount > 0 {
+		log.Errorf("purge task failed: %d of %d hosts failed", failedCount, len(progress.Hosts))
+		return fmt.Errorf("purge task %s failed on %d hosts", taskID, failedCount)
+	}
+
+	log.Infof("purge task succeeded: %d hosts", len(progress.Hosts))
+	return nil
+}
+
+// purgeHosts notifies the dfdaemon of hosts holding peers of the task to delete the task data,
+// and returns the purge result of each host.
+func (j *job) purgeHosts(ctx context.Context, task *resource.Task) []internaljob.PurgeTaskHostResult {
+	peersByHost := map[string][]*resource.Peer{}
+	task.Peers.Range(func(_, value any) bool {
+		peer, ok := value.(*resource.Peer)
+		if !ok {
+			return true
+		}
+
+		peersByHost[peer.Host.ID] = append(peersByHost[peer.Host.ID], peer)
+		return true
+	})
+
+	var (
+		results []internaljob.PurgeTaskHostResult
+		mu      sync.Mutex
+	)
+	eg := errgroup.Group{}
+	eg.SetLimit(purgeTaskConcurrency)
+	for _, peers := range peersByHost {
+		peers := peers
+		eg.Go(func() error {
+			host := peers[0].Host
+			result := internaljob.PurgeTaskHostResult{
+				HostID:   host.ID,
+				Hostname: host.Hostname,
+				IP:       host.IP,
+				Success:  true,
+			}
+
+			if err := j.deleteTask(ctx, host, task); err != nil {
+				host.Log.Errorf("purge task %s failed: %s", task.ID, err.Error())
+				result.Success = false
+				result.Error = err.Error()
+			} else {
+				j.deletePeers(peers)
+			}
+
+			mu.Lock()
+			results = append(results, result)
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	// Purge results of hosts are returned without error.
+	_ = eg.Wait()
+
+	sort.Slice(results, func(i, k int) bool {
+		return results[i].HostID < results[k].HostID
+	})
+
+	return results
+}
+
+// deleteTask notifies the dfdaemon of host to delete the task data.
+func (j *job) deleteTask(ctx context.Context, host *resource.Host, task *resource.Task) error {
+	client, err := j.newDaemonClient(fmt.Sprintf("%s:%d", host.IP, host.Port))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, purgeTaskTimeout)
+	defer cancel()
+
+	return client.DeleteTask(ctx, &dfdaemon.DeleteTaskRequest{
+		Url:     task.URL,
+		UrlMeta: task.URLMeta,
+	})
+}
+
+// deletePeers deletes the finished peers of the purged host, the running peers are
+// kept to finish their downloads, the children of peers are rescheduled when they
+// fail to download pieces from the peers.
+func (j *job) deletePeers(peers []*resource.Peer) {
+	for _, peer := range peers {
+		if !peer.FSM.Can(resource.PeerEventLeave) {
+			continue
+		}
+
+		if err := peer.FSM.Event(resource.PeerEventLeave); err != nil {
+			peer.Log.Errorf("peer fsm event failed: %s", err.Error())
+			continue
+		}
+
+		peer.Task.DeletePeerOutEdges(peer.ID)
+		j.resource.PeerManager().Delete(peer.ID)
+		peer.Log.Info("peer has been purged")
+	}
+}
+
+// getTask reports the task and the peers holding it.
+func (j *job) getTask(ctx context.Context, req string) error {
+	taskID, err := unmarshalTaskRequest(req)
+	if err != nil {
+		return err
+	}
+
+	log := logger.WithTaskID(taskID)
+	progress := j.loadTaskProgress(taskID)
+	if signature := machineryv1tasks.SignatureFromContext(ctx); signature != nil && signature.GroupUUID != "" {
+		if err := j.localJob.SetJobProgress(context.Background(), signature.GroupUUID, signature.UUID, progress); err != nil {
+			log.Errorf("report get task job %s progress failed: %s", signature.UUID, err.Error())
+			return err
+		}
+	}
+
+	log.Infof("get task succeeded: %d peers", len(progress.Peers))
+	return nil
+}
+
+// loadTaskProgress returns the task and the peers holding it, the peers
+// are sorted by id and include the seed peers.
+func (j *job) loadTaskProgress(taskID string) internaljob.GetTaskProgress {
+	progress := internaljob.GetTaskProgress{
+		TaskID:    taskID,
+		Done:      true,
+		UpdatedAt: time.Now(),
+	}
+
+	task, ok := j.resource.TaskManager().Load(taskID)
+	if !ok {
+		logger.WithTaskID(taskID).Info("task not found")
+		return progress
+	}
+
+	progress.Found = true
+	progress.State = task.FSM.Current()
+	progress.ContentLength = task.ContentLength.Load()
+	progress.TotalPieceCount = task.TotalPieceCount.Load()
+	task.Peers.Range(func(_, value any) bool {
+		peer, ok := value.(*resource.Peer)
+		if !ok {
+			return true
+		}
+
+		progress.Peers = append(progress.Peers, internaljob.GetTaskPeer{
+			ID:                 peer.ID,
+			State:              peer.FSM.Current(),
+			FinishedPieceCount: int32(peer.Pieces.Count()),
+			HostID:             peer.Host.ID,
+			HostType:           peer.Host.Type.Name(),
+			Hostname:           peer.Host.Hostname,
+			IP:                 peer.Host.IP,
+			IDC:                peer.Host.IDC,
+			Location:           peer.Host.Location,
+		})
+		return true
+	})
+
+	sort.Slice(progress.Peers, func(i, k int) bool {
+		return progress.Peers[i].ID < progress.Peers[k].ID
+	})
+
+	return progress
+}
+
+// unmarshalTaskRequest unmarshals and validates the request of task jobs,
+// and returns the task id of request.
+func unmarshalTaskRequest(req string) (string, error) {
+	request := &internaljob.TaskRequest{}
+	if err := internaljob.UnmarshalRequest(req, request); err != nil {
+		logger.Errorf("unmarshal request err: %s, request body: %s", err.Error(), req)
+		return "", err
+	}
+
+	if err := validator.New().Struct(request); err != nil {
+		logger.Errorf("task request %#v validate failed: %s", request, err.Error())
+		return "", err
+	}
+
+	if request.TaskID != "" {
+		return request.TaskID, nil
+	}
+
+	return idgen.TaskID(request.URL, newURLMeta(request.Headers, request.Tag, request.Filter, request.Digest)), nil
+}
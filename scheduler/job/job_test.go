@@ -0,0 +1,650 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	internaljob "d7y.io/dragonfly/v2/internal/job"
+	"d7y.io/dragonfly/v2/pkg/container/set"
+	"d7y.io/dragonfly/v2/pkg/idgen"
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	"d7y.io/dragonfly/v2/pkg/rpc/dfdaemon"
+	dfdaemonclient "d7y.io/dragonfly/v2/pkg/rpc/dfdaemon/client"
+	dfdaemonclientmocks "d7y.io/dragonfly/v2/pkg/rpc/dfdaemon/client/mocks"
+	rpcscheduler "d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+	"d7y.io/dragonfly/v2/scheduler/resource"
+	schedulermocks "d7y.io/dragonfly/v2/scheduler/scheduler/mocks"
+)
+
+var (
+	mockRawHost = &rpcscheduler.PeerHost{
+		Id:             idgen.HostID("hostname", 8003),
+		Ip:             "127.0.0.1",
+		RpcPort:        8003,
+		DownPort:       8001,
+		HostName:       "hostname",
+		SecurityDomain: "security_domain",
+		Location:       "location",
+		Idc:            "idc",
+		NetTopology:    "net_topology",
+	}
+
+	mockRawSeedHost = &rpcscheduler.PeerHost{
+		Id:             idgen.HostID("hostname_seed", 8004),
+		Ip:             "127.0.0.1",
+		RpcPort:        8004,
+		DownPort:       8001,
+		HostName:       "hostname_seed",
+		SecurityDomain: "security_domain",
+		Location:       "location",
+		Idc:            "idc",
+		NetTopology:    "net_topology",
+	}
+
+	mockTaskURLMeta = &base.UrlMeta{
+		Tag:    "tag",
+		Filter: "filter",
+	}
+
+	mockTaskURL    = "http://example.com/foo"
+	mockTaskID     = idgen.TaskID(mockTaskURL, mockTaskURLMeta)
+	mockPeerID     = idgen.PeerID("127.0.0.1")
+	mockSeedPeerID = idgen.SeedPeerID("127.0.0.1")
+)
+
+// mockTaskRequest returns the request of task jobs.
+func mockTaskRequest(t *testing.T, request *internaljob.TaskRequest) string {
+	args, err := internaljob.MarshalRequest(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return args[0].Value.(string)
+}
+
+func TestJob_purgeTask(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *internaljob.TaskRequest
+		mock    func(t *testing.T, task *resource.Task, peer, seedPeer *resource.Peer, client, seedClient *dfdaemonclientmocks.MockDaemonClientMockRecorder,
+			mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder)
+		expect func(t *testing.T, task *resource.Task, peer, seedPeer *resource.Peer, err error)
+	}{
+		{
+			name:    "request is invalid",
+			request: &internaljob.TaskRequest{},
+			mock: func(t *testing.T, task *resource.Task, peer, seedPeer *resource.Peer, client, seedClient *dfdaemonclientmocks.MockDaemonClientMockRecorder,
+				mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder) {
+			},
+			expect: func(t *testing.T, task *resource.Task, peer, seedPeer *resource.Peer, err error) {
+				assert := assert.New(t)
+				assert.Error(err)
+			},
+		},
+		{
+			name:    "task not found",
+			request: &internaljob.TaskRequest{TaskID: mockTaskID},
+			mock: func(t *testing.T, task *resource.Task, peer, seedPeer *resource.Peer, client, seedClient *dfdaemonclientmocks.MockDaemonClientMockRecorder,
+				mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder) {
+				mt.Load(gomock.Eq(mockTaskID)).Return(nil, false).Times(1)
+				mt.Delete(gomock.Any()).Times(0)
+			},
+			expect: func(t *testing.T, task *resource.Task, peer, seedPeer *resource.Peer, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+			},
+		},
+		{
+			name:    "all hosts are purged",
+			request: &internaljob.TaskRequest{URL: mockTaskURL, Tag: mockTaskURLMeta.Tag, Filter: mockTaskURLMeta.Filter},
+			mock: func(t *testing.T, task *resource.Task, peer, seedPeer *resource.Peer, client, seedClient *dfdaemonclientmocks.MockDaemonClientMockRecorder,
+				mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder) {
+				peer.FSM.SetState(resource.PeerStateSucceeded)
+				seedPeer.FSM.SetState(resource.PeerStateSucceeded)
+				req := &dfdaemon.DeleteTaskRequest{Url: task.URL, UrlMeta: task.URLMeta}
+				client.DeleteTask(gomock.Any(), gomock.Eq(req)).Return(nil).Times(1)
+				client.Close().Return(nil).Times(1)
+				seedClient.DeleteTask(gomock.Any(), gomock.Eq(req)).Return(nil).Times(1)
+				seedClient.Close().Return(nil).Times(1)
+				mt.Load(gomock.Eq(mockTaskID)).Return(task, true).Times(1)
+				mp.Delete(gomock.Any()).Do(func(key string) { task.DeletePeer(key) }).Times(2)
+				mt.Delete(gomock.Eq(mockTaskID)).Return().Times(1)
+			},
+			expect: func(t *testing.T, task *resource.Task, peer, seedPeer *resource.Peer, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.True(peer.FSM.Is(resource.PeerStateLeave))
+				assert.True(seedPeer.FSM.Is(resource.PeerStateLeave))
+				assert.Equal(task.PeerCount.Load(), int32(0))
+			},
+		},
+		{
+			name:    "host failed to purge",
+			request: &internaljob.TaskRequest{TaskID: mockTaskID},
+			mock: func(t *testing.T, task *resource.Task, peer, seedPeer *resource.Peer, client, seedClient *dfdaemonclientmocks.MockDaemonClientMockRecorder,
+				mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder) {
+				peer.FSM.SetState(resource.PeerStateSucceeded)
+				seedPeer.FSM.SetState(resource.PeerStateSucceeded)
+				client.DeleteTask(gomock.Any(), gomock.Any()).Return(errors.New("foo")).Times(1)
+				client.Close().Return(nil).Times(1)
+				seedClient.DeleteTask(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				seedClient.Close().Return(nil).Times(1)
+				mt.Load(gomock.Eq(mockTaskID)).Return(task, true).Times(1)
+				mp.Delete(gomock.Eq(mockSeedPeerID)).Do(func(key string) { task.DeletePeer(key) }).Times(1)
+				mt.Delete(gomock.Any()).Times(0)
+			},
+			expect: func(t *testing.T, task *resource.Task, peer, seedPeer *resource.Peer, err error) {
+				assert := assert.New(t)
+				assert.Error(err)
+				assert.True(peer.FSM.Is(resource.PeerStateSucceeded))
+				assert.True(seedPeer.FSM.Is(resource.PeerStateLeave))
+				_, ok := task.LoadPeer(mockPeerID)
+				assert.True(ok)
+			},
+		},
+		{
+			name:    "running peer is kept",
+			request: &internaljob.TaskRequest{TaskID: mockTaskID},
+			mock: func(t *testing.T, task *resource.Task, peer, seedPeer *resource.Peer, client, seedClient *dfdaemonclientmocks.MockDaemonClientMockRecorder,
+				mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder) {
+				peer.FSM.SetState(resource.PeerStateRunning)
+				seedPeer.FSM.SetState(resource.PeerStateSucceeded)
+				client.DeleteTask(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				client.Close().Return(nil).Times(1)
+				seedClient.DeleteTask(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				seedClient.Close().Return(nil).Times(1)
+				mt.Load(gomock.Eq(mockTaskID)).Return(task, true).Times(1)
+				mp.Delete(gomock.Eq(mockSeedPeerID)).Do(func(key string) { task.DeletePeer(key) }).Times(1)
+				mt.Delete(gomock.Any()).Times(0)
+			},
+			expect: func(t *testing.T, task *resource.Task, peer, seedPeer *resource.Peer, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.True(peer.FSM.Is(resource.PeerStateRunning))
+				assert.Equal(task.PeerCount.Load(), int32(1))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			res := resource.NewMockResource(ctl)
+			taskManager := resource.NewMockTaskManager(ctl)
+			peerManager := resource.NewMockPeerManager(ctl)
+			res.EXPECT().TaskManager().Return(taskManager).AnyTimes()
+			res.EXPECT().PeerManager().Return(peerManager).AnyTimes()
+			client := dfdaemonclientmocks.NewMockDaemonClient(ctl)
+			seedClient := dfdaemonclientmocks.NewMockDaemonClient(ctl)
+
+			task := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+			host := resource.NewHost(mockRawHost)
+			seedHost := resource.NewHost(mockRawSeedHost, resource.WithHostType(resource.HostTypeSuperSeed))
+			peer := resource.NewPeer(mockPeerID, task, host)
+			seedPeer := resource.NewPeer(mockSeedPeerID, task, seedHost)
+			task.StorePeer(peer)
+			task.StorePeer(seedPeer)
+
+			j := &job{
+				resource: res,
+				newDaemonClient: func(addr string) (dfdaemonclient.DaemonClient, error) {
+					switch addr {
+					case fmt.Sprintf("%s:%d", host.IP, host.Port):
+						return client, nil
+					case fmt.Sprintf("%s:%d", seedHost.IP, seedHost.Port):
+						return seedClient, nil
+					}
+
+					return nil, errors.New("unknown address")
+				},
+			}
+
+			tc.mock(t, task, peer, seedPeer, client.EXPECT(), seedClient.EXPECT(), res.EXPECT(), taskManager.EXPECT(), peerManager.EXPECT())
+			tc.expect(t, task, peer, seedPeer, j.purgeTask(context.Background(), mockTaskRequest(t, tc.request)))
+		})
+	}
+}
+
+func TestJob_purgeHosts(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(task *resource.Task, peer, seedPeer *resource.Peer, client *dfdaemonclientmocks.MockDaemonClientMockRecorder, mp *resource.MockPeerManagerMockRecorder)
+		expect func(t *testing.T, results []internaljob.PurgeTaskHostResult)
+	}{
+		{
+			name: "task has no peers",
+			mock: func(task *resource.Task, peer, seedPeer *resource.Peer, client *dfdaemonclientmocks.MockDaemonClientMockRecorder, mp *resource.MockPeerManagerMockRecorder) {
+			},
+			expect: func(t *testing.T, results []internaljob.PurgeTaskHostResult) {
+				assert := assert.New(t)
+				assert.Empty(results)
+			},
+		},
+		{
+			name: "peers on the same host are purged once",
+			mock: func(task *resource.Task, peer, seedPeer *resource.Peer, client *dfdaemonclientmocks.MockDaemonClientMockRecorder, mp *resource.MockPeerManagerMockRecorder) {
+				peer.FSM.SetState(resource.PeerStateSucceeded)
+				seedPeer.FSM.SetState(resource.PeerStateFailed)
+				task.StorePeer(peer)
+				task.StorePeer(seedPeer)
+				client.DeleteTask(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				client.Close().Return(nil).Times(1)
+				mp.Delete(gomock.Any()).Times(2)
+			},
+			expect: func(t *testing.T, results []internaljob.PurgeTaskHostResult) {
+				assert := assert.New(t)
+				assert.Equal(results, []internaljob.PurgeTaskHostResult{{
+					HostID:   mockRawHost.Id,
+					Hostname: mockRawHost.HostName,
+					IP:       mockRawHost.Ip,
+					Success:  true,
+				}})
+			},
+		},
+		{
+			name: "host failed to purge",
+			mock: func(task *resource.Task, peer, seedPeer *resource.Peer, client *dfdaemonclientmocks.MockDaemonClientMockRecorder, mp *resource.MockPeerManagerMockRecorder) {
+				peer.FSM.SetState(resource.PeerStateSucceeded)
+				task.StorePeer(peer)
+				client.DeleteTask(gomock.Any(), gomock.Any()).Return(errors.New("foo")).Times(1)
+				client.Close().Return(nil).Times(1)
+				mp.Delete(gomock.Any()).Times(0)
+			},
+			expect: func(t *testing.T, results []internaljob.PurgeTaskHostResult) {
+				assert := assert.New(t)
+				assert.Equal(results, []internaljob.PurgeTaskHostResult{{
+					HostID:   mockRawHost.Id,
+					Hostname: mockRawHost.HostName,
+					IP:       mockRawHost.Ip,
+					Error:    "foo",
+				}})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			res := resource.NewMockResource(ctl)
+			peerManager := resource.NewMockPeerManager(ctl)
+			res.EXPECT().PeerManager().Return(peerManager).AnyTimes()
+			client := dfdaemonclientmocks.NewMockDaemonClient(ctl)
+
+			task := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+			host := resource.NewHost(mockRawHost)
+			peer := resource.NewPeer(mockPeerID, task, host)
+			seedPeer := resource.NewPeer(mockSeedPeerID, task, host)
+
+			j := &job{
+				resource: res,
+				newDaemonClient: func(addr string) (dfdaemonclient.DaemonClient, error) {
+					return client, nil
+				},
+			}
+
+			tc.mock(task, peer, seedPeer, client.EXPECT(), peerManager.EXPECT())
+			tc.expect(t, j.purgeHosts(context.Background(), task))
+		})
+	}
+}
+
+func TestJob_loadTaskProgress(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(task *resource.Task, peer, seedPeer *resource.Peer, mt *resource.MockTaskManagerMockRecorder)
+		expect func(t *testing.T, progress internaljob.GetTaskProgress)
+	}{
+		{
+			name: "task not found",
+			mock: func(task *resource.Task, peer, seedPeer *resource.Peer, mt *resource.MockTaskManagerMockRecorder) {
+				mt.Load(gomock.Eq(mockTaskID)).Return(nil, false).Times(1)
+			},
+			expect: func(t *testing.T, progress internaljob.GetTaskProgress) {
+				assert := assert.New(t)
+				assert.Equal(progress.TaskID, mockTaskID)
+				assert.False(progress.Found)
+				assert.True(progress.Done)
+				assert.Empty(progress.Peers)
+			},
+		},
+		{
+			name: "task has no peers",
+			mock: func(task *resource.Task, peer, seedPeer *resource.Peer, mt *resource.MockTaskManagerMockRecorder) {
+				mt.Load(gomock.Eq(mockTaskID)).Return(task, true).Times(1)
+			},
+			expect: func(t *testing.T, progress internaljob.GetTaskProgress) {
+				assert := assert.New(t)
+				assert.True(progress.Found)
+				assert.Equal(progress.State, resource.TaskStatePending)
+				assert.Empty(progress.Peers)
+			},
+		},
+		{
+			name: "task has peers",
+			mock: func(task *resource.Task, peer, seedPeer *resource.Peer, mt *resource.MockTaskManagerMockRecorder) {
+				task.FSM.SetState(resource.TaskStateSucceeded)
+				task.TotalPieceCount.Store(2)
+				task.ContentLength.Store(1024)
+				peer.FSM.SetState(resource.PeerStateRunning)
+				peer.Pieces.Set(0)
+				seedPeer.FSM.SetState(resource.PeerStateSucceeded)
+				seedPeer.Pieces.Set(0)
+				seedPeer.Pieces.Set(1)
+				task.StorePeer(peer)
+				task.StorePeer(seedPeer)
+				mt.Load(gomock.Eq(mockTaskID)).Return(task, true).Times(1)
+			},
+			expect: func(t *testing.T, progress internaljob.GetTaskProgress) {
+				assert := assert.New(t)
+				assert.True(progress.Found)
+				assert.Equal(progress.State, resource.TaskStateSucceeded)
+				assert.Equal(progress.TotalPieceCount, int32(2))
+				assert.Equal(progress.ContentLength, int64(1024))
+				assert.ElementsMatch(progress.Peers, []internaljob.GetTaskPeer{
+					{
+						ID:                 mockPeerID,
+						State:              resource.PeerStateRunning,
+						FinishedPieceCount: 1,
+						HostID:             mockRawHost.Id,
+						HostType:           resource.HostTypeNormalName,
+						Hostname:           mockRawHost.HostName,
+						IP:                 mockRawHost.Ip,
+						IDC:                mockRawHost.Idc,
+						Location:           mockRawHost.Location,
+					},
+					{
+						ID:                 mockSeedPeerID,
+						State:              resource.PeerStateSucceeded,
+						FinishedPieceCount: 2,
+						HostID:             mockRawSeedHost.Id,
+						HostType:           resource.HostTypeSuperSeed.Name(),
+						Hostname:           mockRawSeedHost.HostName,
+						IP:                 mockRawSeedHost.Ip,
+						IDC:                mockRawSeedHost.Idc,
+						Location:           mockRawSeedHost.Location,
+					},
+				})
+				assert.True(progress.Peers[0].ID < progress.Peers[1].ID)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			res := resource.NewMockResource(ctl)
+			taskManager := resource.NewMockTaskManager(ctl)
+			res.EXPECT().TaskManager().Return(taskManager).AnyTimes()
+
+			task := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+			peer := resource.NewPeer(mockPeerID, task, resource.NewHost(mockRawHost))
+			seedPeer := resource.NewPeer(mockSeedPeerID, task, resource.NewHost(mockRawSeedHost, resource.WithHostType(resource.HostTypeSuperSeed)))
+
+			j := &job{resource: res}
+			tc.mock(task, peer, seedPeer, taskManager.EXPECT())
+			tc.expect(t, j.loadTaskProgress(mockTaskID))
+		})
+	}
+}
+
+// mockHostRequest returns the request of host jobs.
+func mockHostRequest(t *testing.T, request *internaljob.HostRequest) string {
+	args, err := internaljob.MarshalRequest(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return args[0].Value.(string)
+}
+
+func TestJob_drainHost(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *internaljob.HostRequest
+		ctx     func() context.Context
+		mock    func(parent, child *resource.Peer, mh *resource.MockHostManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder, ms *schedulermocks.MockSchedulerMockRecorder)
+		expect  func(t *testing.T, err error)
+	}{
+		{
+			name:    "request is invalid",
+			request: &internaljob.HostRequest{},
+			ctx:     context.Background,
+			mock: func(parent, child *resource.Peer, mh *resource.MockHostManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder, ms *schedulermocks.MockSchedulerMockRecorder) {
+				mh.Cordon(gomock.Any()).Times(0)
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.Error(err)
+			},
+		},
+		{
+			name:    "host has no children",
+			request: &internaljob.HostRequest{HostID: mockRawHost.Id},
+			ctx:     context.Background,
+			mock: func(parent, child *resource.Peer, mh *resource.MockHostManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder, ms *schedulermocks.MockSchedulerMockRecorder) {
+				gomock.InOrder(
+					mh.Cordon(gomock.Eq(mockRawHost.Id)).Return().Times(1),
+					mp.Range(gomock.Any()).Do(func(f func(any, any) bool) {
+						f(parent.ID, parent)
+					}).Times(1),
+				)
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+			},
+		},
+		{
+			name:    "children are rescheduled",
+			request: &internaljob.HostRequest{HostID: mockRawHost.Id},
+			ctx:     context.Background,
+			mock: func(parent, child *resource.Peer, mh *resource.MockHostManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder, ms *schedulermocks.MockSchedulerMockRecorder) {
+				child.FSM.SetState(resource.PeerStateRunning)
+				if err := parent.StoreChild(child); err != nil {
+					t.Fatal(err)
+				}
+
+				gomock.InOrder(
+					mh.Cordon(gomock.Eq(mockRawHost.Id)).Return().Times(1),
+					mp.Range(gomock.Any()).Do(func(f func(any, any) bool) {
+						f(parent.ID, parent)
+						f(child.ID, child)
+					}).Times(1),
+					ms.NotifyAndFindParent(gomock.Any(), gomock.Eq(child), gomock.Any()).Return([]*resource.Peer{}, true).Times(1),
+				)
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+			},
+		},
+		{
+			name:    "context is done with children left",
+			request: &internaljob.HostRequest{HostID: mockRawHost.Id},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			},
+			mock: func(parent, child *resource.Peer, mh *resource.MockHostManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder, ms *schedulermocks.MockSchedulerMockRecorder) {
+				child.FSM.SetState(resource.PeerStateRunning)
+				if err := parent.StoreChild(child); err != nil {
+					t.Fatal(err)
+				}
+
+				gomock.InOrder(
+					mh.Cordon(gomock.Eq(mockRawHost.Id)).Return().Times(1),
+					mp.Range(gomock.Any()).Do(func(f func(any, any) bool) {
+						f(parent.ID, parent)
+					}).Times(1),
+					ms.NotifyAndFindParent(gomock.Any(), gomock.Eq(child), gomock.Any()).Return(nil, false).Times(1),
+				)
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.ErrorIs(err, context.Canceled)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			res := resource.NewMockResource(ctl)
+			hostManager := resource.NewMockHostManager(ctl)
+			peerManager := resource.NewMockPeerManager(ctl)
+			res.EXPECT().HostManager().Return(hostManager).AnyTimes()
+			res.EXPECT().PeerManager().Return(peerManager).AnyTimes()
+			scheduler := schedulermocks.NewMockScheduler(ctl)
+
+			task := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+			parent := resource.NewPeer(mockPeerID, task, resource.NewHost(mockRawHost))
+			child := resource.NewPeer(mockSeedPeerID, task, resource.NewHost(mockRawSeedHost))
+			task.StorePeer(parent)
+			task.StorePeer(child)
+
+			j := &job{resource: res, scheduler: scheduler}
+			tc.mock(parent, child, hostManager.EXPECT(), peerManager.EXPECT(), scheduler.EXPECT())
+			tc.expect(t, j.drainHost(tc.ctx(), mockHostRequest(t, tc.request)))
+		})
+	}
+}
+
+func TestJob_rescheduleChildren(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(parent, child, otherChild *resource.Peer, mp *resource.MockPeerManagerMockRecorder, ms *schedulermocks.MockSchedulerMockRecorder)
+		expect func(t *testing.T, childCount int)
+	}{
+		{
+			name: "peers on other hosts are skipped",
+			mock: func(parent, child, otherChild *resource.Peer, mp *resource.MockPeerManagerMockRecorder, ms *schedulermocks.MockSchedulerMockRecorder) {
+				if err := child.StoreParent(otherChild); err != nil {
+					t.Fatal(err)
+				}
+
+				mp.Range(gomock.Any()).Do(func(f func(any, any) bool) {
+					f(child.ID, child)
+					f(otherChild.ID, otherChild)
+				}).Times(1)
+				ms.NotifyAndFindParent(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			expect: func(t *testing.T, childCount int) {
+				assert := assert.New(t)
+				assert.Equal(childCount, 0)
+			},
+		},
+		{
+			name: "running child is rescheduled without the parent on host",
+			mock: func(parent, child, otherChild *resource.Peer, mp *resource.MockPeerManagerMockRecorder, ms *schedulermocks.MockSchedulerMockRecorder) {
+				child.FSM.SetState(resource.PeerStateRunning)
+				if err := parent.StoreChild(child); err != nil {
+					t.Fatal(err)
+				}
+
+				mp.Range(gomock.Any()).Do(func(f func(any, any) bool) {
+					f(parent.ID, parent)
+				}).Times(1)
+				ms.NotifyAndFindParent(gomock.Any(), gomock.Eq(child), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, peer *resource.Peer, blocklist set.SafeSet) ([]*resource.Peer, bool) {
+						assert.True(t, blocklist.Contains(parent.ID))
+						return []*resource.Peer{otherChild}, true
+					}).Times(1)
+			},
+			expect: func(t *testing.T, childCount int) {
+				assert := assert.New(t)
+				assert.Equal(childCount, 0)
+			},
+		},
+		{
+			name: "running child is failed to reschedule",
+			mock: func(parent, child, otherChild *resource.Peer, mp *resource.MockPeerManagerMockRecorder, ms *schedulermocks.MockSchedulerMockRecorder) {
+				child.FSM.SetState(resource.PeerStateRunning)
+				if err := parent.StoreChild(child); err != nil {
+					t.Fatal(err)
+				}
+
+				mp.Range(gomock.Any()).Do(func(f func(any, any) bool) {
+					f(parent.ID, parent)
+				}).Times(1)
+				ms.NotifyAndFindParent(gomock.Any(), gomock.Eq(child), gomock.Any()).Return(nil, false).Times(1)
+			},
+			expect: func(t *testing.T, childCount int) {
+				assert := assert.New(t)
+				assert.Equal(childCount, 1)
+			},
+		},
+		{
+			name: "child not running is counted without rescheduling",
+			mock: func(parent, child, otherChild *resource.Peer, mp *resource.MockPeerManagerMockRecorder, ms *schedulermocks.MockSchedulerMockRecorder) {
+				child.FSM.SetState(resource.PeerStateRunning)
+				otherChild.FSM.SetState(resource.PeerStateReceivedNormal)
+				if err := parent.StoreChild(child); err != nil {
+					t.Fatal(err)
+				}
+
+				if err := parent.StoreChild(otherChild); err != nil {
+					t.Fatal(err)
+				}
+
+				mp.Range(gomock.Any()).Do(func(f func(any, any) bool) {
+					f(parent.ID, parent)
+				}).Times(1)
+				ms.NotifyAndFindParent(gomock.Any(), gomock.Eq(child), gomock.Any()).Return([]*resource.Peer{}, true).Times(1)
+			},
+			expect: func(t *testing.T, childCount int) {
+				assert := assert.New(t)
+				assert.Equal(childCount, 1)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			res := resource.NewMockResource(ctl)
+			peerManager := resource.NewMockPeerManager(ctl)
+			res.EXPECT().PeerManager().Return(peerManager).AnyTimes()
+			scheduler := schedulermocks.NewMockScheduler(ctl)
+
+			task := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+			seedHost := resource.NewHost(mockRawSeedHost)
+			parent := resource.NewPeer(mockPeerID, task, resource.NewHost(mockRawHost))
+			child := resource.NewPeer(mockSeedPeerID, task, seedHost)
+			otherChild := resource.NewPeer(idgen.PeerID("127.0.0.2"), task, seedHost)
+			task.StorePeer(parent)
+			task.StorePeer(child)
+			task.StorePeer(otherChild)
+
+			j := &job{resource: res, scheduler: scheduler}
+			tc.mock(parent, child, otherChild, peerManager.EXPECT(), scheduler.EXPECT())
+			tc.expect(t, j.rescheduleChildren(context.Background(), mockRawHost.Id))
+		})
+	}
+}
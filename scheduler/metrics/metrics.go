@@ -79,6 +79,41 @@ var (
 		Help:      "Counter of the number of failed of the downloading.",
 	}, []string{"tag", "type"})
 
+	BackToSourceNotAdmittedCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "back_to_source_not_admitted_total",
+		Help:      "Counter of the number of the back-to-source not admitted by origin host.",
+	})
+
+	PreemptPeerCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "preempt_peer_total",
+		Help:      "Counter of the number of the low priority peers preempted by high priority peers.",
+	})
+
+	CorruptedPieceCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "corrupted_piece_total",
+		Help:      "Counter of the number of the pieces whose digest does not match.",
+	})
+
+	QuarantinePeerCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "quarantine_peer_total",
+		Help:      "Counter of the number of the peers quarantined because of corrupted data.",
+	})
+
+	QuarantineHostCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "quarantine_host_total",
+		Help:      "Counter of the number of the hosts quarantined because of corrupted data.",
+	})
+
 	StatTaskCount = promauto.NewCounter(prometheus.CounterOpts{
 		Namespace: constants.MetricsNamespace,
 		Subsystem: constants.SchedulerMetricsName,
@@ -121,6 +156,118 @@ var (
 		Help:      "Counter of the number of failed of the leaving task.",
 	}, []string{"tag"})
 
+	SyncProbesCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "sync_probes_total",
+		Help:      "Counter of the number of the syncing probes.",
+	})
+
+	SyncProbesFailureCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "sync_probes_failure_total",
+		Help:      "Counter of the number of failed of the syncing probes.",
+	})
+
+	AnnounceHostCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "announce_host_total",
+		Help:      "Counter of the number of the announcing host.",
+	})
+
+	AnnounceHostFailureCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "announce_host_failure_total",
+		Help:      "Counter of the number of failed of the announcing host.",
+	})
+
+	FindTaskPeersCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "find_task_peers_total",
+		Help:      "Counter of the number of the finding task peers by federated scheduler.",
+	})
+
+	FindTaskPeersFailureCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "find_task_peers_failure_total",
+		Help:      "Counter of the number of failed of the finding task peers by federated scheduler.",
+	})
+
+	FederatedPeerCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "federated_peer_total",
+		Help:      "Counter of the number of the peers found in federated scheduler clusters.",
+	})
+
+	FederatedPeerFailureCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "federated_peer_failure_total",
+		Help:      "Counter of the number of failed of the finding peers in federated scheduler clusters.",
+	})
+
+	ConcurrentWatchGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "concurrent_watch_total",
+		Help:      "Gauge of the number of concurrent of the watching.",
+	})
+
+	WatchDroppedCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "watch_dropped_total",
+		Help:      "Counter of the number of the dropped watchers which are too slow to receive events.",
+	})
+
+	RemoteEvaluateCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "remote_evaluate_total",
+		Help:      "Counter of the number of the evaluating by remote evaluator.",
+	})
+
+	RemoteEvaluateFailureCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "remote_evaluate_failure_total",
+		Help:      "Counter of the number of failed of the evaluating by remote evaluator.",
+	})
+
+	ReplicatePeerCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "replicate_peer_total",
+		Help:      "Counter of the number of the replicating peer to other scheduler.",
+	})
+
+	ReplicatePeerFailureCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "replicate_peer_failure_total",
+		Help:      "Counter of the number of failed of the replicating peer to other scheduler.",
+	})
+
+	EvictTaskCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "evict_task_total",
+		Help:      "Counter of the number of the evicted task.",
+	}, []string{"policy"})
+
+	TaskMemoryUsage = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "task_memory_usage_bytes",
+		Help:      "Gauge of the approximate memory usage of tasks.",
+	})
+
 	Traffic = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: constants.MetricsNamespace,
 		Subsystem: constants.SchedulerMetricsName,
@@ -150,6 +297,20 @@ var (
 		Buckets:   []float64{100, 200, 500, 1000, 1500, 2 * 1000, 3 * 1000, 5 * 1000, 10 * 1000, 20 * 1000, 60 * 1000, 120 * 1000, 300 * 1000},
 	}, []string{"tag"})
 
+	ObjectCacheHitCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "object_cache_hit_total",
+		Help:      "Counter of the number of peers registered with cached object.",
+	})
+
+	ObjectCacheStoreCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.MetricsNamespace,
+		Subsystem: constants.SchedulerMetricsName,
+		Name:      "object_cache_store_total",
+		Help:      "Counter of the number of objects stored in cache.",
+	})
+
 	ConcurrentScheduleGauge = promauto.NewGauge(prometheus.GaugeOpts{
 		Namespace: constants.MetricsNamespace,
 		Subsystem: constants.SchedulerMetricsName,
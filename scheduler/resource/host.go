@@ -23,6 +23,7 @@ import (
 	"go.uber.org/atomic"
 
 	logger "d7y.io/dragonfly/v2/internal/dflog"
+	"d7y.io/dragonfly/v2/manager/model"
 	"d7y.io/dragonfly/v2/pkg/rpc/scheduler"
 	"d7y.io/dragonfly/v2/scheduler/config"
 )
@@ -43,6 +44,25 @@ const (
 	HostTypeWeakSeed
 )
 
+const (
+	// HostTypeNormalName is the name of normal host type.
+	HostTypeNormalName = "normal"
+)
+
+// Name returns the name of host type.
+func (h HostType) Name() string {
+	switch h {
+	case HostTypeSuperSeed:
+		return model.SeedPeerTypeSuperSeed
+	case HostTypeStrongSeed:
+		return model.SeedPeerTypeStrongSeed
+	case HostTypeWeakSeed:
+		return model.SeedPeerTypeWeakSeed
+	}
+
+	return HostTypeNormalName
+}
+
 // HostOption is a functional option for configuring the host.
 type HostOption func(h *Host) *Host
 
@@ -104,9 +124,30 @@ type Host struct {
 	// Peer sync map.
 	Peers *sync.Map
 
+	// UploadPeers is the peers of host uploading to children across tasks,
+	// it includes the succeeded and back-to-source peers deleted from Peers.
+	UploadPeers *sync.Map
+
 	// PeerCount is peer count.
 	PeerCount *atomic.Int32
 
+	// Probes is the probe results from host to other hosts.
+	Probes *Probes
+
+	// Stats is the load announced by host.
+	Stats *HostStats
+
+	// Cordoned is whether host is excluded from candidate parents for maintenance.
+	Cordoned *atomic.Bool
+
+	// CorruptedPieceCount is the number of corrupted pieces uploaded by host,
+	// it is the integrity score of host.
+	CorruptedPieceCount *atomic.Int32
+
+	// Quarantined is whether host is excluded from candidate parents
+	// because it has uploaded too many corrupted pieces.
+	Quarantined *atomic.Bool
+
 	// CreateAt is host create time.
 	CreateAt *atomic.Time
 
@@ -120,23 +161,29 @@ type Host struct {
 // New host instance.
 func NewHost(rawHost *scheduler.PeerHost, options ...HostOption) *Host {
 	h := &Host{
-		ID:              rawHost.Id,
-		Type:            HostTypeNormal,
-		IP:              rawHost.Ip,
-		Hostname:        rawHost.HostName,
-		Port:            rawHost.RpcPort,
-		DownloadPort:    rawHost.DownPort,
-		SecurityDomain:  rawHost.SecurityDomain,
-		IDC:             rawHost.Idc,
-		NetTopology:     rawHost.NetTopology,
-		Location:        rawHost.Location,
-		UploadLoadLimit: atomic.NewInt32(config.DefaultClientLoadLimit),
-		UploadPeerCount: atomic.NewInt32(0),
-		Peers:           &sync.Map{},
-		PeerCount:       atomic.NewInt32(0),
-		CreateAt:        atomic.NewTime(time.Now()),
-		UpdateAt:        atomic.NewTime(time.Now()),
-		Log:             logger.WithHostID(rawHost.Id),
+		ID:                  rawHost.Id,
+		Type:                HostTypeNormal,
+		IP:                  rawHost.Ip,
+		Hostname:            rawHost.HostName,
+		Port:                rawHost.RpcPort,
+		DownloadPort:        rawHost.DownPort,
+		SecurityDomain:      rawHost.SecurityDomain,
+		IDC:                 rawHost.Idc,
+		NetTopology:         rawHost.NetTopology,
+		Location:            rawHost.Location,
+		UploadLoadLimit:     atomic.NewInt32(config.DefaultClientLoadLimit),
+		UploadPeerCount:     atomic.NewInt32(0),
+		Peers:               &sync.Map{},
+		UploadPeers:         &sync.Map{},
+		PeerCount:           atomic.NewInt32(0),
+		Probes:              NewProbes(DefaultProbesLimit),
+		Stats:               NewHostStats(DefaultHostStatsWindow),
+		Cordoned:            atomic.NewBool(false),
+		CorruptedPieceCount: atomic.NewInt32(0),
+		Quarantined:         atomic.NewBool(false),
+		CreateAt:            atomic.NewTime(time.Now()),
+		UpdateAt:            atomic.NewTime(time.Now()),
+		Log:                 logger.WithHostID(rawHost.Id),
 	}
 
 	for _, opt := range options {
@@ -22,6 +22,7 @@ import (
 	"sync"
 	"time"
 
+	"d7y.io/dragonfly/v2/pkg/container/set"
 	pkggc "d7y.io/dragonfly/v2/pkg/gc"
 	"d7y.io/dragonfly/v2/scheduler/config"
 )
@@ -46,14 +47,36 @@ type HostManager interface {
 	// Delete deletes host for a key.
 	Delete(string)
 
+	// Range calls f sequentially for each key and host present in the map.
+	// If f returns false, range stops the iteration.
+	Range(f func(any, any) bool)
+
+	// Cordon excludes host from candidate parents, the host registered
+	// later is cordoned as well.
+	Cordon(string)
+
+	// Uncordon makes host available as candidate parent again.
+	Uncordon(string)
+
 	// Try to reclaim host.
 	RunGC() error
+
+	// Observer is dynconfig observer interface, the cordoned
+	// hosts stored in manager are synchronized by dynconfig.
+	config.Observer
 }
 
 type hostManager struct {
 	// Host sync map.
 	*sync.Map
 
+	// cordoned is the set of cordoned host ids.
+	cordoned *sync.Map
+
+	// dynconfigCordoned is the set of cordoned host ids
+	// in the latest dynconfig data.
+	dynconfigCordoned set.Set
+
 	// Host time to live.
 	ttl time.Duration
 }
@@ -61,8 +84,10 @@ type hostManager struct {
 // New host manager interface.
 func newHostManager(cfg *config.GCConfig, gc pkggc.GC) (HostManager, error) {
 	h := &hostManager{
-		Map: &sync.Map{},
-		ttl: cfg.HostTTL,
+		Map:               &sync.Map{},
+		cordoned:          &sync.Map{},
+		dynconfigCordoned: set.New(),
+		ttl:               cfg.HostTTL,
 	}
 
 	if err := gc.Add(pkggc.Task{
@@ -87,14 +112,63 @@ func (h *hostManager) Load(key string) (*Host, bool) {
 }
 
 func (h *hostManager) Store(host *Host) {
+	if _, ok := h.cordoned.Load(host.ID); ok {
+		host.Cordoned.Store(true)
+	}
+
 	h.Map.Store(host.ID, host)
 }
 
 func (h *hostManager) LoadOrStore(host *Host) (*Host, bool) {
+	if _, ok := h.cordoned.Load(host.ID); ok {
+		host.Cordoned.Store(true)
+	}
+
 	rawHost, loaded := h.Map.LoadOrStore(host.ID, host)
 	return rawHost.(*Host), loaded
 }
 
+func (h *hostManager) Cordon(key string) {
+	h.cordoned.Store(key, struct{}{})
+	if host, ok := h.Load(key); ok {
+		host.Cordoned.Store(true)
+		host.Log.Info("host has been cordoned")
+	}
+}
+
+func (h *hostManager) Uncordon(key string) {
+	h.cordoned.Delete(key)
+	if host, ok := h.Load(key); ok {
+		host.Cordoned.Store(false)
+		host.Log.Info("host has been uncordoned")
+	}
+}
+
+// OnNotify cordons the hosts added to and uncordons the hosts removed from
+// the cordoned hosts of dynconfig. Only the changes are applied, so the hosts
+// cordoned by jobs are kept when dynconfig has not been refreshed yet.
+func (h *hostManager) OnNotify(data *config.DynconfigData) {
+	if data.SchedulerCluster == nil {
+		return
+	}
+
+	cordoned := set.New()
+	for _, id := range data.SchedulerCluster.CordonedHostIDs {
+		cordoned.Add(id)
+		if !h.dynconfigCordoned.Contains(id) {
+			h.Cordon(id)
+		}
+	}
+
+	for _, id := range h.dynconfigCordoned.Values() {
+		if !cordoned.Contains(id) {
+			h.Uncordon(id.(string))
+		}
+	}
+
+	h.dynconfigCordoned = cordoned
+}
+
 func (h *hostManager) Delete(key string) {
 	h.Map.Delete(key)
 }
@@ -7,6 +7,7 @@ package resource
 import (
 	reflect "reflect"
 
+	config "d7y.io/dragonfly/v2/scheduler/config"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -33,6 +34,18 @@ func (m *MockHostManager) EXPECT() *MockHostManagerMockRecorder {
 	return m.recorder
 }
 
+// Cordon mocks base method.
+func (m *MockHostManager) Cordon(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Cordon", arg0)
+}
+
+// Cordon indicates an expected call of Cordon.
+func (mr *MockHostManagerMockRecorder) Cordon(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cordon", reflect.TypeOf((*MockHostManager)(nil).Cordon), arg0)
+}
+
 // Delete mocks base method.
 func (m *MockHostManager) Delete(arg0 string) {
 	m.ctrl.T.Helper()
@@ -75,6 +88,30 @@ func (mr *MockHostManagerMockRecorder) LoadOrStore(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadOrStore", reflect.TypeOf((*MockHostManager)(nil).LoadOrStore), arg0)
 }
 
+// OnNotify mocks base method.
+func (m *MockHostManager) OnNotify(arg0 *config.DynconfigData) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnNotify", arg0)
+}
+
+// OnNotify indicates an expected call of OnNotify.
+func (mr *MockHostManagerMockRecorder) OnNotify(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnNotify", reflect.TypeOf((*MockHostManager)(nil).OnNotify), arg0)
+}
+
+// Range mocks base method.
+func (m *MockHostManager) Range(f func(any, any) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Range", f)
+}
+
+// Range indicates an expected call of Range.
+func (mr *MockHostManagerMockRecorder) Range(f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Range", reflect.TypeOf((*MockHostManager)(nil).Range), f)
+}
+
 // RunGC mocks base method.
 func (m *MockHostManager) RunGC() error {
 	m.ctrl.T.Helper()
@@ -100,3 +137,15 @@ func (mr *MockHostManagerMockRecorder) Store(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockHostManager)(nil).Store), arg0)
 }
+
+// Uncordon mocks base method.
+func (m *MockHostManager) Uncordon(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Uncordon", arg0)
+}
+
+// Uncordon indicates an expected call of Uncordon.
+func (mr *MockHostManagerMockRecorder) Uncordon(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Uncordon", reflect.TypeOf((*MockHostManager)(nil).Uncordon), arg0)
+}
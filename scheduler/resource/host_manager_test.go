@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"d7y.io/dragonfly/v2/pkg/gc"
+	"d7y.io/dragonfly/v2/pkg/idgen"
 	"d7y.io/dragonfly/v2/pkg/rpc/base"
 	"d7y.io/dragonfly/v2/scheduler/config"
 )
@@ -299,6 +300,161 @@ func TestHostManager_Delete(t *testing.T) {
 	}
 }
 
+func TestHostManager_Cordon(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(m *gc.MockGCMockRecorder)
+		expect func(t *testing.T, hostManager HostManager, mockHost *Host)
+	}{
+		{
+			name: "cordon host",
+			mock: func(m *gc.MockGCMockRecorder) {
+				m.Add(gomock.Any()).Return(nil).Times(1)
+			},
+			expect: func(t *testing.T, hostManager HostManager, mockHost *Host) {
+				assert := assert.New(t)
+				hostManager.Store(mockHost)
+				hostManager.Cordon(mockHost.ID)
+				assert.True(mockHost.Cordoned.Load())
+			},
+		},
+		{
+			name: "cordon host before it is registered",
+			mock: func(m *gc.MockGCMockRecorder) {
+				m.Add(gomock.Any()).Return(nil).Times(1)
+			},
+			expect: func(t *testing.T, hostManager HostManager, mockHost *Host) {
+				assert := assert.New(t)
+				hostManager.Cordon(mockHost.ID)
+				host, loaded := hostManager.LoadOrStore(mockHost)
+				assert.False(loaded)
+				assert.True(host.Cordoned.Load())
+			},
+		},
+		{
+			name: "uncordon host",
+			mock: func(m *gc.MockGCMockRecorder) {
+				m.Add(gomock.Any()).Return(nil).Times(1)
+			},
+			expect: func(t *testing.T, hostManager HostManager, mockHost *Host) {
+				assert := assert.New(t)
+				hostManager.Cordon(mockHost.ID)
+				hostManager.Store(mockHost)
+				assert.True(mockHost.Cordoned.Load())
+				hostManager.Uncordon(mockHost.ID)
+				assert.False(mockHost.Cordoned.Load())
+
+				host := NewHost(mockRawHost)
+				hostManager.Store(host)
+				assert.False(host.Cordoned.Load())
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			gc := gc.NewMockGC(ctl)
+			tc.mock(gc.EXPECT())
+
+			mockHost := NewHost(mockRawHost)
+			hostManager, err := newHostManager(mockHostGCConfig, gc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tc.expect(t, hostManager, mockHost)
+		})
+	}
+}
+
+func TestHostManager_OnNotify(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect func(t *testing.T, hostManager HostManager, mockHost *Host)
+	}{
+		{
+			name: "cordon host stored in manager",
+			expect: func(t *testing.T, hostManager HostManager, mockHost *Host) {
+				assert := assert.New(t)
+				hostManager.Store(mockHost)
+				hostManager.OnNotify(&config.DynconfigData{
+					SchedulerCluster: &config.SchedulerCluster{CordonedHostIDs: []string{mockHost.ID}},
+				})
+				assert.True(mockHost.Cordoned.Load())
+			},
+		},
+		{
+			name: "cordon host registered after restart",
+			expect: func(t *testing.T, hostManager HostManager, mockHost *Host) {
+				assert := assert.New(t)
+				hostManager.OnNotify(&config.DynconfigData{
+					SchedulerCluster: &config.SchedulerCluster{CordonedHostIDs: []string{mockHost.ID}},
+				})
+				host, loaded := hostManager.LoadOrStore(mockHost)
+				assert.False(loaded)
+				assert.True(host.Cordoned.Load())
+			},
+		},
+		{
+			name: "uncordon host removed from manager",
+			expect: func(t *testing.T, hostManager HostManager, mockHost *Host) {
+				assert := assert.New(t)
+				hostManager.Store(mockHost)
+				hostManager.OnNotify(&config.DynconfigData{
+					SchedulerCluster: &config.SchedulerCluster{CordonedHostIDs: []string{mockHost.ID}},
+				})
+				hostManager.OnNotify(&config.DynconfigData{
+					SchedulerCluster: &config.SchedulerCluster{},
+				})
+				assert.False(mockHost.Cordoned.Load())
+			},
+		},
+		{
+			name: "host cordoned by job is kept before dynconfig is refreshed",
+			expect: func(t *testing.T, hostManager HostManager, mockHost *Host) {
+				assert := assert.New(t)
+				hostManager.Store(mockHost)
+				hostManager.Cordon(mockHost.ID)
+				hostManager.OnNotify(&config.DynconfigData{
+					SchedulerCluster: &config.SchedulerCluster{},
+				})
+				assert.True(mockHost.Cordoned.Load())
+			},
+		},
+		{
+			name: "scheduler cluster is empty",
+			expect: func(t *testing.T, hostManager HostManager, mockHost *Host) {
+				assert := assert.New(t)
+				hostManager.Store(mockHost)
+				hostManager.OnNotify(&config.DynconfigData{
+					SchedulerCluster: &config.SchedulerCluster{CordonedHostIDs: []string{mockHost.ID}},
+				})
+				hostManager.OnNotify(&config.DynconfigData{})
+				assert.True(mockHost.Cordoned.Load())
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			gc := gc.NewMockGC(ctl)
+			gc.EXPECT().Add(gomock.Any()).Return(nil).Times(1)
+
+			mockHost := NewHost(mockRawHost)
+			hostManager, err := newHostManager(mockHostGCConfig, gc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tc.expect(t, hostManager, mockHost)
+		})
+	}
+}
+
 func TestHostManager_RunGC(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -347,7 +503,7 @@ func TestHostManager_RunGC(t *testing.T) {
 				hostManager.Store(mockHost)
 				mockHost.StorePeer(mockPeer)
 				mockHost.PeerCount.Add(0)
-				mockPeer.StoreParent(mockPeer)
+				mockPeer.StoreParent(NewPeer(idgen.PeerID("127.0.0.2"), mockPeer.Task, mockPeer.Host))
 				err := hostManager.RunGC()
 				assert.NoError(err)
 
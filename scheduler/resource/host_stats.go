@@ -0,0 +1,147 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resource
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultHostStatsWindow is the default number of stats kept by host.
+	DefaultHostStatsWindow = 10
+)
+
+// HostStat is the load of host announced by dfdaemon.
+type HostStat struct {
+	// RxBandwidth is network receive rate in bytes per second.
+	RxBandwidth uint64
+
+	// TxBandwidth is network transmit rate in bytes per second.
+	TxBandwidth uint64
+
+	// UploadBandwidthLimit is upload bandwidth capacity in bytes per second,
+	// zero means unlimited.
+	UploadBandwidthLimit uint64
+
+	// DiskFree is free disk space of data directory in bytes.
+	DiskFree uint64
+
+	// DiskTotal is total disk space of data directory in bytes.
+	DiskTotal uint64
+
+	// CPURatio is cpu usage ratio.
+	CPURatio float64
+
+	// MemoryRatio is memory usage ratio.
+	MemoryRatio float64
+
+	// CreateAt is stat create time.
+	CreateAt time.Time
+}
+
+// HostStats is the rolling window of stats announced by host,
+// the oldest stat is dropped when the window is full.
+type HostStats struct {
+	// window is the maximum number of stats.
+	window int
+
+	// stats is the stats in order of creation.
+	stats []HostStat
+
+	// mu guards stats.
+	mu sync.RWMutex
+}
+
+// NewHostStats returns a new HostStats with the window size.
+func NewHostStats(window int) *HostStats {
+	return &HostStats{
+		window: window,
+		stats:  make([]HostStat, 0, window),
+	}
+}
+
+// Store appends the stat to the window.
+func (h *HostStats) Store(stat HostStat) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if stat.CreateAt.IsZero() {
+		stat.CreateAt = time.Now()
+	}
+
+	if h.window > 0 && len(h.stats) >= h.window {
+		h.stats = append(h.stats[:0], h.stats[len(h.stats)-h.window+1:]...)
+	}
+
+	h.stats = append(h.stats, stat)
+}
+
+// Latest returns the latest stat.
+func (h *HostStats) Latest() (HostStat, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if len(h.stats) == 0 {
+		return HostStat{}, false
+	}
+
+	return h.stats[len(h.stats)-1], true
+}
+
+// Average returns the average of rates and ratios in the window, the capacities
+// and CreateAt are taken from the latest stat.
+func (h *HostStats) Average() (HostStat, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	n := len(h.stats)
+	if n == 0 {
+		return HostStat{}, false
+	}
+
+	var (
+		rx, tx   uint64
+		cpu, mem float64
+	)
+	for _, stat := range h.stats {
+		rx += stat.RxBandwidth
+		tx += stat.TxBandwidth
+		cpu += stat.CPURatio
+		mem += stat.MemoryRatio
+	}
+
+	latest := h.stats[n-1]
+	return HostStat{
+		RxBandwidth:          rx / uint64(n),
+		TxBandwidth:          tx / uint64(n),
+		UploadBandwidthLimit: latest.UploadBandwidthLimit,
+		DiskFree:             latest.DiskFree,
+		DiskTotal:            latest.DiskTotal,
+		CPURatio:             cpu / float64(n),
+		MemoryRatio:          mem / float64(n),
+		CreateAt:             latest.CreateAt,
+	}, true
+}
+
+// Len returns the number of stats in the window.
+func (h *HostStats) Len() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.stats)
+}
@@ -0,0 +1,120 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostStats_Store(t *testing.T) {
+	tests := []struct {
+		name   string
+		window int
+		expect func(t *testing.T, h *HostStats)
+	}{
+		{
+			name:   "store stat",
+			window: 2,
+			expect: func(t *testing.T, h *HostStats) {
+				assert := assert.New(t)
+				h.Store(HostStat{TxBandwidth: 10})
+				stat, ok := h.Latest()
+				assert.True(ok)
+				assert.Equal(stat.TxBandwidth, uint64(10))
+				assert.NotEqual(stat.CreateAt, time.Time{})
+				assert.Equal(h.Len(), 1)
+			},
+		},
+		{
+			name:   "drop the oldest stat",
+			window: 2,
+			expect: func(t *testing.T, h *HostStats) {
+				assert := assert.New(t)
+				h.Store(HostStat{TxBandwidth: 10})
+				h.Store(HostStat{TxBandwidth: 20})
+				h.Store(HostStat{TxBandwidth: 30})
+				stat, ok := h.Latest()
+				assert.True(ok)
+				assert.Equal(stat.TxBandwidth, uint64(30))
+				stat, ok = h.Average()
+				assert.True(ok)
+				assert.Equal(stat.TxBandwidth, uint64(25))
+				assert.Equal(h.Len(), 2)
+			},
+		},
+		{
+			name:   "stats have no window",
+			window: 0,
+			expect: func(t *testing.T, h *HostStats) {
+				assert := assert.New(t)
+				h.Store(HostStat{})
+				h.Store(HostStat{})
+				h.Store(HostStat{})
+				assert.Equal(h.Len(), 3)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expect(t, NewHostStats(tc.window))
+		})
+	}
+}
+
+func TestHostStats_Average(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect func(t *testing.T, h *HostStats)
+	}{
+		{
+			name: "average of stats",
+			expect: func(t *testing.T, h *HostStats) {
+				assert := assert.New(t)
+				h.Store(HostStat{RxBandwidth: 10, TxBandwidth: 20, UploadBandwidthLimit: 100, DiskFree: 10, CPURatio: 0.2, MemoryRatio: 0.4})
+				h.Store(HostStat{RxBandwidth: 30, TxBandwidth: 40, UploadBandwidthLimit: 200, DiskFree: 20, CPURatio: 0.4, MemoryRatio: 0.6})
+				stat, ok := h.Average()
+				assert.True(ok)
+				assert.Equal(stat.RxBandwidth, uint64(20))
+				assert.Equal(stat.TxBandwidth, uint64(30))
+				assert.Equal(stat.UploadBandwidthLimit, uint64(200))
+				assert.Equal(stat.DiskFree, uint64(20))
+				assert.InDelta(stat.CPURatio, 0.3, 1e-9)
+				assert.InDelta(stat.MemoryRatio, 0.5, 1e-9)
+			},
+		},
+		{
+			name: "stats are empty",
+			expect: func(t *testing.T, h *HostStats) {
+				assert := assert.New(t)
+				_, ok := h.Average()
+				assert.False(ok)
+				_, ok = h.Latest()
+				assert.False(ok)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expect(t, NewHostStats(DefaultHostStatsWindow))
+		})
+	}
+}
@@ -77,6 +77,7 @@ func TestHost_NewHost(t *testing.T) {
 				assert.Equal(host.NetTopology, mockRawHost.NetTopology)
 				assert.Equal(host.UploadLoadLimit.Load(), int32(config.DefaultClientLoadLimit))
 				assert.Equal(host.PeerCount.Load(), int32(0))
+				assert.Equal(host.Probes.Len(), 0)
 				assert.NotEqual(host.CreateAt.Load(), 0)
 				assert.NotEqual(host.UpdateAt.Load(), 0)
 				assert.NotNil(host.Log)
@@ -100,6 +101,7 @@ func TestHost_NewHost(t *testing.T) {
 				assert.Equal(host.NetTopology, mockRawSeedHost.NetTopology)
 				assert.Equal(host.UploadLoadLimit.Load(), int32(config.DefaultClientLoadLimit))
 				assert.Equal(host.PeerCount.Load(), int32(0))
+				assert.Equal(host.Probes.Len(), 0)
 				assert.NotEqual(host.CreateAt.Load(), 0)
 				assert.NotEqual(host.UpdateAt.Load(), 0)
 				assert.NotNil(host.Log)
@@ -123,6 +125,7 @@ func TestHost_NewHost(t *testing.T) {
 				assert.Equal(host.NetTopology, mockRawHost.NetTopology)
 				assert.Equal(host.UploadLoadLimit.Load(), int32(200))
 				assert.Equal(host.PeerCount.Load(), int32(0))
+				assert.Equal(host.Probes.Len(), 0)
 				assert.NotEqual(host.CreateAt.Load(), 0)
 				assert.NotEqual(host.UpdateAt.Load(), 0)
 				assert.NotNil(host.Log)
@@ -382,11 +385,12 @@ func TestHost_FreeUploadLoad(t *testing.T) {
 			rawHost: mockRawHost,
 			expect: func(t *testing.T, host *Host, mockPeer *Peer) {
 				assert := assert.New(t)
-				mockPeer.StoreParent(mockPeer)
+				mockParentPeer := NewPeer(idgen.PeerID("127.0.0.2"), mockPeer.Task, mockPeer.Host)
+				mockPeer.StoreParent(mockParentPeer)
 				assert.Equal(host.FreeUploadLoad(), int32(config.DefaultClientLoadLimit-1))
-				mockPeer.StoreParent(mockPeer)
+				mockPeer.StoreParent(mockParentPeer)
 				assert.Equal(host.FreeUploadLoad(), int32(config.DefaultClientLoadLimit-1))
-				mockPeer.DeleteParent()
+				mockPeer.DeleteParents()
 				assert.Equal(host.FreeUploadLoad(), int32(config.DefaultClientLoadLimit))
 			},
 		},
@@ -410,3 +414,50 @@ func TestHost_FreeUploadLoad(t *testing.T) {
 		})
 	}
 }
+
+func TestHostType_Name(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostType HostType
+		expect   func(t *testing.T, name string)
+	}{
+		{
+			name:     "host type is normal",
+			hostType: HostTypeNormal,
+			expect: func(t *testing.T, name string) {
+				assert := assert.New(t)
+				assert.Equal(name, HostTypeNormalName)
+			},
+		},
+		{
+			name:     "host type is super seed",
+			hostType: HostTypeSuperSeed,
+			expect: func(t *testing.T, name string) {
+				assert := assert.New(t)
+				assert.Equal(name, "super")
+			},
+		},
+		{
+			name:     "host type is strong seed",
+			hostType: HostTypeStrongSeed,
+			expect: func(t *testing.T, name string) {
+				assert := assert.New(t)
+				assert.Equal(name, "strong")
+			},
+		},
+		{
+			name:     "host type is weak seed",
+			hostType: HostTypeWeakSeed,
+			expect: func(t *testing.T, name string) {
+				assert := assert.New(t)
+				assert.Equal(name, "weak")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expect(t, tc.hostType.Name())
+		})
+	}
+}
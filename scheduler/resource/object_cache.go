@@ -0,0 +1,149 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resource
+
+import (
+	"container/list"
+	"sync"
+)
+
+// ObjectCache is the size bounded cache of small task contents,
+// the contents are returned to peers directly when they register.
+type ObjectCache interface {
+	// Load returns the content of task and marks it as recently used.
+	Load(taskID string) ([]byte, bool)
+
+	// Store stores the content of task, it returns false
+	// when the content exceeds the size limit of object.
+	Store(taskID string, data []byte) bool
+
+	// Delete deletes the content of task.
+	Delete(taskID string)
+
+	// Len returns the number of cached objects.
+	Len() int
+
+	// Size returns the total size of cached objects in bytes.
+	Size() int64
+}
+
+type object struct {
+	taskID string
+	data   []byte
+}
+
+type objectCache struct {
+	// objectSizeLimit is the maximum size of an object in bytes.
+	objectSizeLimit int64
+
+	// sizeLimit is the maximum total size of objects in bytes.
+	sizeLimit int64
+
+	// size is the total size of objects in bytes.
+	size int64
+
+	// objects is the map of task id and element of lru list.
+	objects map[string]*list.Element
+
+	// lru is the list of objects, the front is the most recently used.
+	lru *list.List
+
+	// mu guards objects, lru and size.
+	mu sync.Mutex
+}
+
+// NewObjectCache returns a new ObjectCache, the least recently used
+// objects are evicted when the total size limit is exceeded.
+func NewObjectCache(objectSizeLimit, sizeLimit int64) ObjectCache {
+	return &objectCache{
+		objectSizeLimit: objectSizeLimit,
+		sizeLimit:       sizeLimit,
+		objects:         map[string]*list.Element{},
+		lru:             list.New(),
+	}
+}
+
+// Load returns the content of task and marks it as recently used.
+func (c *objectCache) Load(taskID string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.objects[taskID]
+	if !ok {
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*object).data, true
+}
+
+// Store stores the content of task, it returns false
+// when the content exceeds the size limit of object.
+func (c *objectCache) Store(taskID string, data []byte) bool {
+	size := int64(len(data))
+	if size == 0 || size > c.objectSizeLimit || size > c.sizeLimit {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.objects[taskID]; ok {
+		c.remove(elem)
+	}
+
+	c.objects[taskID] = c.lru.PushFront(&object{taskID: taskID, data: data})
+	c.size += size
+	for c.size > c.sizeLimit {
+		c.remove(c.lru.Back())
+	}
+
+	return true
+}
+
+// Delete deletes the content of task.
+func (c *objectCache) Delete(taskID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.objects[taskID]; ok {
+		c.remove(elem)
+	}
+}
+
+// Len returns the number of cached objects.
+func (c *objectCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+// Size returns the total size of cached objects in bytes.
+func (c *objectCache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.size
+}
+
+// remove removes the element from cache, the caller must hold the lock.
+func (c *objectCache) remove(elem *list.Element) {
+	obj := c.lru.Remove(elem).(*object)
+	delete(c.objects, obj.taskID)
+	c.size -= int64(len(obj.data))
+}
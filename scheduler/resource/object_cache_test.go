@@ -0,0 +1,117 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectCache_Store(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect func(t *testing.T, c ObjectCache)
+	}{
+		{
+			name: "store object",
+			expect: func(t *testing.T, c ObjectCache) {
+				assert := assert.New(t)
+				assert.True(c.Store("foo", []byte("bar")))
+				data, ok := c.Load("foo")
+				assert.True(ok)
+				assert.Equal(data, []byte("bar"))
+				assert.Equal(c.Len(), 1)
+				assert.Equal(c.Size(), int64(3))
+			},
+		},
+		{
+			name: "object exceeds object size limit",
+			expect: func(t *testing.T, c ObjectCache) {
+				assert := assert.New(t)
+				assert.False(c.Store("foo", []byte("foobarbaz")))
+				_, ok := c.Load("foo")
+				assert.False(ok)
+				assert.Equal(c.Len(), 0)
+			},
+		},
+		{
+			name: "object is empty",
+			expect: func(t *testing.T, c ObjectCache) {
+				assert := assert.New(t)
+				assert.False(c.Store("foo", []byte{}))
+				assert.Equal(c.Len(), 0)
+			},
+		},
+		{
+			name: "replace object",
+			expect: func(t *testing.T, c ObjectCache) {
+				assert := assert.New(t)
+				assert.True(c.Store("foo", []byte("bar")))
+				assert.True(c.Store("foo", []byte("quux")))
+				data, ok := c.Load("foo")
+				assert.True(ok)
+				assert.Equal(data, []byte("quux"))
+				assert.Equal(c.Len(), 1)
+				assert.Equal(c.Size(), int64(4))
+			},
+		},
+		{
+			name: "evict least recently used objects",
+			expect: func(t *testing.T, c ObjectCache) {
+				assert := assert.New(t)
+				assert.True(c.Store("foo", []byte("foo")))
+				assert.True(c.Store("bar", []byte("bar")))
+				_, ok := c.Load("foo")
+				assert.True(ok)
+				assert.True(c.Store("baz", []byte("baz")))
+				assert.True(c.Store("qux", []byte("qux")))
+
+				_, ok = c.Load("bar")
+				assert.False(ok)
+				_, ok = c.Load("foo")
+				assert.False(ok)
+				_, ok = c.Load("baz")
+				assert.True(ok)
+				_, ok = c.Load("qux")
+				assert.True(ok)
+				assert.Equal(c.Len(), 2)
+				assert.Equal(c.Size(), int64(6))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expect(t, NewObjectCache(8, 8))
+		})
+	}
+}
+
+func TestObjectCache_Delete(t *testing.T) {
+	assert := assert.New(t)
+	c := NewObjectCache(8, 8)
+	assert.True(c.Store("foo", []byte("bar")))
+	c.Delete("foo")
+	_, ok := c.Load("foo")
+	assert.False(ok)
+	assert.Equal(c.Len(), 0)
+	assert.Equal(c.Size(), int64(0))
+
+	c.Delete("bar")
+	assert.Equal(c.Len(), 0)
+}
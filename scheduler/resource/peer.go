@@ -22,7 +22,6 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"sync"
 	"time"
 
 	"github.com/bits-and-blooms/bitset"
@@ -32,8 +31,9 @@ import (
 
 	logger "d7y.io/dragonfly/v2/internal/dflog"
 	"d7y.io/dragonfly/v2/pkg/container/set"
+	"d7y.io/dragonfly/v2/pkg/dag"
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
 	"d7y.io/dragonfly/v2/pkg/rpc/scheduler"
-	"d7y.io/dragonfly/v2/pkg/slices"
 )
 
 const (
@@ -110,6 +110,14 @@ func WithTag(tag string) PeerOption {
 	}
 }
 
+// WithPriority sets peer's Priority.
+func WithPriority(priority base.Priority) PeerOption {
+	return func(p *Peer) *Peer {
+		p.Priority = priority
+		return p
+	}
+}
+
 type Peer struct {
 	// ID is peer id.
 	ID string
@@ -117,6 +125,9 @@ type Peer struct {
 	// Tag is peer tag.
 	Tag string
 
+	// Priority is the download priority of peer.
+	Priority base.Priority
+
 	// Pieces is piece bitset.
 	Pieces *bitset.BitSet
 
@@ -135,11 +146,9 @@ type Peer struct {
 	// Host is peer host.
 	Host *Host
 
-	// Parent is peer parent.
-	Parent *atomic.Value
-
-	// Children is peer children.
-	Children *sync.Map
+	// mainParent is the main parent of peer, peer downloads pieces
+	// from main parent first and other parents are steal peers.
+	mainParent *atomic.Value
 
 	// ChildCount is child count.
 	ChildCount *atomic.Int32
@@ -164,15 +173,27 @@ type Peer struct {
 	// IsBackToSource is set to true.
 	IsBackToSource *atomic.Bool
 
+	// NeedValidation needs to be validated by downloading.
+	//
+	// When peer is restored from snapshot, the data of peer may
+	// have been removed, NeedValidation is set to true
+	// until a child downloads piece from the peer.
+	NeedValidation *atomic.Bool
+
+	// CorruptedPieceCount is the number of corrupted pieces uploaded by peer,
+	// it is the integrity score of peer.
+	CorruptedPieceCount *atomic.Int32
+
+	// Quarantined is whether peer is excluded from candidate parents
+	// because its data is corrupted.
+	Quarantined *atomic.Bool
+
 	// CreateAt is peer create time.
 	CreateAt *atomic.Time
 
 	// UpdateAt is peer update time.
 	UpdateAt *atomic.Time
 
-	// Peer mutex.
-	mu *sync.RWMutex
-
 	// Peer log.
 	Log *logger.SugaredLoggerOnWith
 }
@@ -180,24 +201,25 @@ type Peer struct {
 // New Peer instance.
 func NewPeer(id string, task *Task, host *Host, options ...PeerOption) *Peer {
 	p := &Peer{
-		ID:               id,
-		Tag:              DefaultTag,
-		Pieces:           &bitset.BitSet{},
-		pieceCosts:       []int64{},
-		Stream:           &atomic.Value{},
-		Task:             task,
-		Host:             host,
-		Parent:           &atomic.Value{},
-		Children:         &sync.Map{},
-		ChildCount:       atomic.NewInt32(0),
-		StealPeers:       set.NewSafeSet(),
-		BlockPeers:       set.NewSafeSet(),
-		NeedBackToSource: atomic.NewBool(false),
-		IsBackToSource:   atomic.NewBool(false),
-		CreateAt:         atomic.NewTime(time.Now()),
-		UpdateAt:         atomic.NewTime(time.Now()),
-		mu:               &sync.RWMutex{},
-		Log:              logger.WithTaskAndPeerID(task.ID, id),
+		ID:                  id,
+		Tag:                 DefaultTag,
+		Pieces:              &bitset.BitSet{},
+		pieceCosts:          []int64{},
+		Stream:              &atomic.Value{},
+		Task:                task,
+		Host:                host,
+		mainParent:          &atomic.Value{},
+		ChildCount:          atomic.NewInt32(0),
+		StealPeers:          set.NewSafeSet(),
+		BlockPeers:          set.NewSafeSet(),
+		NeedBackToSource:    atomic.NewBool(false),
+		IsBackToSource:      atomic.NewBool(false),
+		NeedValidation:      atomic.NewBool(false),
+		CorruptedPieceCount: atomic.NewInt32(0),
+		Quarantined:         atomic.NewBool(false),
+		CreateAt:            atomic.NewTime(time.Now()),
+		UpdateAt:            atomic.NewTime(time.Now()),
+		Log:                 logger.WithTaskAndPeerID(task.ID, id),
 	}
 
 	// Initialize state machine.
@@ -225,37 +247,43 @@ func NewPeer(id string, task *Task, host *Host, options ...PeerOption) *Peer {
 			PeerEventRegisterTiny: func(e *fsm.Event) {
 				p.UpdateAt.Store(time.Now())
 				p.Log.Infof("peer state is %s", e.FSM.Current())
+				p.publishEvent(scheduler.EventType_PEER_REGISTERED, e.FSM.Current())
 			},
 			PeerEventRegisterSmall: func(e *fsm.Event) {
 				p.UpdateAt.Store(time.Now())
 				p.Log.Infof("peer state is %s", e.FSM.Current())
+				p.publishEvent(scheduler.EventType_PEER_REGISTERED, e.FSM.Current())
 			},
 			PeerEventRegisterNormal: func(e *fsm.Event) {
 				p.UpdateAt.Store(time.Now())
 				p.Log.Infof("peer state is %s", e.FSM.Current())
+				p.publishEvent(scheduler.EventType_PEER_REGISTERED, e.FSM.Current())
 			},
 			PeerEventDownload: func(e *fsm.Event) {
 				p.UpdateAt.Store(time.Now())
 				p.Log.Infof("peer state is %s", e.FSM.Current())
+				p.publishEvent(scheduler.EventType_PEER_STARTED, e.FSM.Current())
 			},
 			PeerEventDownloadFromBackToSource: func(e *fsm.Event) {
 				p.IsBackToSource.Store(true)
 				p.Task.BackToSourcePeers.Add(p)
-				p.DeleteParent()
+				p.DeleteParents()
 				p.Host.DeletePeer(p.ID)
 				p.UpdateAt.Store(time.Now())
 				p.Log.Infof("peer state is %s", e.FSM.Current())
+				p.publishEvent(scheduler.EventType_PEER_BACK_TO_SOURCE, e.FSM.Current())
 			},
 			PeerEventDownloadSucceeded: func(e *fsm.Event) {
 				if e.Src == PeerStateBackToSource {
 					p.Task.BackToSourcePeers.Delete(p)
 				}
 
-				p.DeleteParent()
+				p.DeleteParents()
 				p.Host.DeletePeer(p.ID)
 				p.Task.PeerFailedCount.Store(0)
 				p.UpdateAt.Store(time.Now())
 				p.Log.Infof("peer state is %s", e.FSM.Current())
+				p.publishEvent(scheduler.EventType_PEER_SUCCEEDED, e.FSM.Current())
 			},
 			PeerEventDownloadFailed: func(e *fsm.Event) {
 				if e.Src == PeerStateBackToSource {
@@ -263,15 +291,17 @@ func NewPeer(id string, task *Task, host *Host, options ...PeerOption) *Peer {
 					p.Task.BackToSourcePeers.Delete(p)
 				}
 
-				p.DeleteParent()
+				p.DeleteParents()
 				p.Host.DeletePeer(p.ID)
 				p.UpdateAt.Store(time.Now())
 				p.Log.Infof("peer state is %s", e.FSM.Current())
+				p.publishEvent(scheduler.EventType_PEER_FAILED, e.FSM.Current())
 			},
 			PeerEventLeave: func(e *fsm.Event) {
-				p.DeleteParent()
+				p.DeleteParents()
 				p.Host.DeletePeer(p.ID)
 				p.Log.Infof("peer state is %s", e.FSM.Current())
+				p.publishEvent(scheduler.EventType_PEER_LEFT, e.FSM.Current())
 			},
 		},
 	)
@@ -283,149 +313,209 @@ func NewPeer(id string, task *Task, host *Host, options ...PeerOption) *Peer {
 	return p
 }
 
+// publishEvent publishes the lifecycle event of peer.
+func (p *Peer) publishEvent(eventType scheduler.EventType, state string) {
+	if p.Task.EventHub == nil {
+		return
+	}
+
+	p.Task.EventHub.Publish(&scheduler.Event{
+		Type:        eventType,
+		TaskId:      p.Task.ID,
+		Url:         p.Task.URL,
+		Tag:         p.Task.URLMeta.GetTag(),
+		Application: p.Task.URLMeta.GetApplication(),
+		PeerId:      p.ID,
+		HostId:      p.Host.ID,
+		State:       state,
+		CreatedAt:   time.Now().UnixNano(),
+	})
+}
+
 // LoadChild return peer child for a key.
 func (p *Peer) LoadChild(key string) (*Peer, bool) {
-	rawChild, ok := p.Children.Load(key)
-	if !ok {
-		return nil, false
+	for _, child := range p.Children() {
+		if child.ID == key {
+			return child, true
+		}
 	}
 
-	return rawChild.(*Peer), ok
+	return nil, false
 }
 
-// StoreChild set peer child.
-func (p *Peer) StoreChild(child *Peer) {
-	p.mu.Lock()
-	defer p.mu.Unlock()
-
-	if _, loaded := p.Children.LoadOrStore(child.ID, child); !loaded {
-		p.ChildCount.Inc()
-		p.Host.UploadPeerCount.Inc()
-	}
+// Children returns peer children.
+func (p *Peer) Children() []*Peer {
+	return p.Task.PeerChildren(p.ID)
+}
 
-	child.Parent.Store(p)
+// StoreChild set peer child.
+func (p *Peer) StoreChild(child *Peer) error {
+	return child.StoreParent(p)
 }
 
 // DeleteChild deletes peer child for a key.
 func (p *Peer) DeleteChild(key string) {
-	p.mu.Lock()
-	defer p.mu.Unlock()
-
 	child, ok := p.LoadChild(key)
 	if !ok {
 		return
 	}
 
-	if _, loaded := p.Children.LoadAndDelete(child.ID); loaded {
-		p.ChildCount.Dec()
-		p.Host.UploadPeerCount.Dec()
-	}
-
-	child.Parent = &atomic.Value{}
+	p.Task.DeletePeerEdge(p, child)
 }
 
-// LoadParent return peer parent.
+// LoadParent return peer main parent.
 func (p *Peer) LoadParent() (*Peer, bool) {
-	rawParent := p.Parent.Load()
-	if rawParent == nil {
+	mainParent, ok := p.mainParent.Load().(*Peer)
+	if !ok || mainParent == nil {
 		return nil, false
 	}
 
-	return rawParent.(*Peer), true
+	return p.LoadParentByID(mainParent.ID)
+}
+
+// LoadParentByID return peer parent for a key.
+func (p *Peer) LoadParentByID(key string) (*Peer, bool) {
+	for _, parent := range p.Parents() {
+		if parent.ID == key {
+			return parent, true
+		}
+	}
+
+	return nil, false
 }
 
-// StoreParent set peer parent.
-func (p *Peer) StoreParent(parent *Peer) {
-	p.mu.Lock()
-	defer p.mu.Unlock()
+// Parents returns peer parents, including main parent.
+func (p *Peer) Parents() []*Peer {
+	return p.Task.PeerParents(p.ID)
+}
 
-	p.Parent.Store(parent)
-	if _, loaded := parent.Children.LoadOrStore(p.ID, p); !loaded {
-		parent.ChildCount.Inc()
-		parent.Host.UploadPeerCount.Inc()
+// StoreParent set peer main parent.
+func (p *Peer) StoreParent(parent *Peer) error {
+	if err := p.AddParent(parent); err != nil {
+		return err
 	}
+
+	p.mainParent.Store(parent)
+	return nil
 }
 
-// DeleteParent deletes peer parent.
-func (p *Peer) DeleteParent() {
-	p.mu.Lock()
-	defer p.mu.Unlock()
+// AddParent adds peer parent, the parent is not the
+// main parent unless peer has no parent.
+func (p *Peer) AddParent(parent *Peer) error {
+	if _, ok := p.LoadParentByID(parent.ID); ok {
+		return nil
+	}
 
-	parent, ok := p.LoadParent()
-	if !ok {
-		return
+	if err := p.Task.AddPeerEdge(parent, p); err != nil {
+		return err
 	}
-	p.Parent = &atomic.Value{}
 
-	if _, loaded := parent.Children.LoadAndDelete(p.ID); loaded {
-		parent.ChildCount.Dec()
-		parent.Host.UploadPeerCount.Dec()
+	if _, ok := p.LoadParent(); !ok {
+		p.mainParent.Store(parent)
 	}
+
+	return nil
 }
 
-// ReplaceParent replaces peer parent.
-func (p *Peer) ReplaceParent(parent *Peer) {
-	p.DeleteParent()
-	p.StoreParent(parent)
+// DeleteParent deletes peer parent for a key.
+func (p *Peer) DeleteParent(key string) {
+	parent, ok := p.LoadParentByID(key)
+	if !ok {
+		return
+	}
+
+	p.Task.DeletePeerEdge(parent, p)
 }
 
-// Depth represents depth of tree.
-func (p *Peer) Depth() int {
-	p.mu.RLock()
-	defer p.mu.RUnlock()
+// DeleteParents deletes all peer parents.
+func (p *Peer) DeleteParents() {
+	p.Task.DeletePeerInEdges(p.ID)
+}
 
-	var (
-		node      = p
-		ancestors = []string{p.ID}
-	)
-	for node != nil {
-		if node.Host.Type != HostTypeNormal {
-			break
-		}
+// ReplaceParents replaces peer parents, the first parent is the main parent.
+func (p *Peer) ReplaceParents(parents []*Peer) {
+	p.DeleteParents()
+	for i, parent := range parents {
+		if i == 0 {
+			if err := p.StoreParent(parent); err != nil {
+				p.Log.Errorf("store main parent %s failed: %s", parent.ID, err.Error())
+			}
 
-		parent, ok := node.LoadParent()
-		if !ok {
-			break
+			continue
 		}
 
-		ancestors = append(ancestors, parent.ID)
-
-		// Prevent traversal tree from infinite loop.
-		if _, found := slices.FindDuplicate(ancestors); found {
-			p.Log.Error("tree structure produces an infinite loop")
-			break
+		if err := p.AddParent(parent); err != nil {
+			p.Log.Errorf("add parent %s failed: %s", parent.ID, err.Error())
 		}
+	}
+}
 
-		node = parent
+// Depth represents the longest path from peer to the root of DAG,
+// seed peer is regarded as the root.
+func (p *Peer) Depth() int {
+	vertex, err := p.Task.DAG.GetVertex(p.ID)
+	if err != nil {
+		return 1
 	}
 
-	return len(ancestors)
+	return depth(vertex, map[string]int{})
 }
 
-// Ancestors returns peer's ancestors.
-func (p *Peer) Ancestors() []string {
-	p.mu.RLock()
-	defer p.mu.RUnlock()
+// depth returns the longest path from vertex to the root of DAG.
+func depth(vertex *dag.Vertex, depths map[string]int) int {
+	if d, ok := depths[vertex.ID]; ok {
+		return d
+	}
 
-	var (
-		node      = p
-		ancestors = []string{p.ID}
-	)
-	for node != nil {
-		parent, ok := node.LoadParent()
+	// Prevent traversal graph from infinite loop.
+	depths[vertex.ID] = 1
+	if peer, ok := vertex.Value.(*Peer); ok && peer.Host.Type != HostTypeNormal {
+		return 1
+	}
+
+	var max int
+	for _, value := range vertex.Parents.Values() {
+		parent, ok := value.(*dag.Vertex)
 		if !ok {
-			return ancestors
+			continue
 		}
 
-		ancestors = append(ancestors, parent.ID)
-
-		// Prevent traversal tree from infinite loop.
-		if _, found := slices.FindDuplicate(ancestors); found {
-			p.Log.Error("tree structure produces an infinite loop")
-			break
+		if d := depth(parent, depths); d > max {
+			max = d
 		}
+	}
+
+	depths[vertex.ID] = max + 1
+	return max + 1
+}
+
+// Ancestors returns peer's ancestors, the first one is peer itself.
+func (p *Peer) Ancestors() []string {
+	ancestors := []string{p.ID}
+	vertex, err := p.Task.DAG.GetVertex(p.ID)
+	if err != nil {
+		return ancestors
+	}
 
-		node = parent
+	visited := map[string]struct{}{p.ID: {}}
+	queue := []*dag.Vertex{vertex}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, value := range node.Parents.Values() {
+			parent, ok := value.(*dag.Vertex)
+			if !ok {
+				continue
+			}
+
+			if _, ok := visited[parent.ID]; ok {
+				continue
+			}
+
+			visited[parent.ID] = struct{}{}
+			ancestors = append(ancestors, parent.ID)
+			queue = append(queue, parent)
+		}
 	}
 
 	return ancestors
@@ -433,45 +523,12 @@ func (p *Peer) Ancestors() []string {
 
 // IsDescendant determines whether it is ancestor of peer.
 func (p *Peer) IsDescendant(ancestor *Peer) bool {
-	return ancestor.isDescendant(p)
+	return p.Task.DAG.IsReachable(ancestor.ID, p.ID)
 }
 
 // IsAncestor determines whether it is descendant of peer.
 func (p *Peer) IsAncestor(descendant *Peer) bool {
-	return p.isDescendant(descendant)
-}
-
-// isDescendant determines whether it is ancestor of peer.
-func (p *Peer) isDescendant(descendant *Peer) bool {
-	p.mu.RLock()
-	defer p.mu.RUnlock()
-
-	var (
-		node      = descendant
-		ancestors = []string{descendant.ID}
-	)
-	for node != nil {
-		parent, ok := node.LoadParent()
-		if !ok {
-			return false
-		}
-
-		if parent.ID == p.ID {
-			return true
-		}
-
-		ancestors = append(ancestors, parent.ID)
-
-		// Prevent traversal tree from infinite loop.
-		if _, found := slices.FindDuplicate(ancestors); found {
-			p.Log.Error("tree structure produces an infinite loop")
-			break
-		}
-
-		node = parent
-	}
-
-	return false
+	return p.Task.DAG.IsReachable(p.ID, descendant.ID)
 }
 
 // AppendPieceCost append piece cost to costs slice.
@@ -46,6 +46,10 @@ type PeerManager interface {
 	// Delete deletes peer for a key.
 	Delete(string)
 
+	// Range calls f sequentially for each key and peer present in the map.
+	// If f returns false, range stops the iteration.
+	Range(f func(any, any) bool)
+
 	// Try to reclaim peer.
 	RunGC() error
 }
@@ -132,7 +136,7 @@ func (p *peerManager) RunGC() error {
 			// If the status is PeerStateLeave,
 			// clear peer information.
 			if peer.FSM.Is(PeerStateLeave) {
-				peer.DeleteParent()
+				peer.DeleteParents()
 				p.Delete(peer.ID)
 				peer.Log.Info("peer has been reclaimed")
 				return true
@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"d7y.io/dragonfly/v2/pkg/gc"
+	"d7y.io/dragonfly/v2/pkg/idgen"
 	"d7y.io/dragonfly/v2/pkg/rpc/base"
 	"d7y.io/dragonfly/v2/scheduler/config"
 )
@@ -362,7 +363,7 @@ func TestPeerManager_RunGC(t *testing.T) {
 				assert := assert.New(t)
 				peerManager.Store(mockPeer)
 				mockPeer.FSM.SetState(PeerStateSucceeded)
-				mockPeer.StoreChild(mockPeer)
+				mockPeer.StoreChild(NewPeer(idgen.PeerID("127.0.0.2"), mockPeer.Task, mockPeer.Host))
 				err := peerManager.RunGC()
 				assert.NoError(err)
 
@@ -380,7 +381,7 @@ func TestPeerManager_RunGC(t *testing.T) {
 				assert := assert.New(t)
 				peerManager.Store(mockPeer)
 				mockPeer.FSM.SetState(PeerStatePending)
-				mockPeer.StoreChild(mockPeer)
+				mockPeer.StoreChild(NewPeer(idgen.PeerID("127.0.0.2"), mockPeer.Task, mockPeer.Host))
 				err := peerManager.RunGC()
 				assert.NoError(err)
 
@@ -34,6 +34,7 @@ import (
 	"d7y.io/dragonfly/v2/pkg/rpc/base"
 	"d7y.io/dragonfly/v2/pkg/rpc/scheduler"
 	"d7y.io/dragonfly/v2/pkg/rpc/scheduler/mocks"
+	"d7y.io/dragonfly/v2/scheduler/event"
 )
 
 var (
@@ -60,8 +61,10 @@ func TestPeer_NewPeer(t *testing.T) {
 				assert.Equal(peer.FSM.Current(), PeerStatePending)
 				assert.EqualValues(peer.Task, mockTask)
 				assert.EqualValues(peer.Host, mockHost)
-				assert.Empty(peer.Parent)
-				assert.Empty(peer.Children)
+				_, ok := peer.LoadParent()
+				assert.False(ok)
+				assert.Empty(peer.Parents())
+				assert.Empty(peer.Children())
 				assert.NotEqual(peer.CreateAt.Load(), 0)
 				assert.NotEqual(peer.UpdateAt.Load(), 0)
 				assert.NotNil(peer.Log)
@@ -81,13 +84,27 @@ func TestPeer_NewPeer(t *testing.T) {
 				assert.Equal(peer.FSM.Current(), PeerStatePending)
 				assert.EqualValues(peer.Task, mockTask)
 				assert.EqualValues(peer.Host, mockHost)
-				assert.Empty(peer.Parent)
-				assert.Empty(peer.Children)
+				_, ok := peer.LoadParent()
+				assert.False(ok)
+				assert.Empty(peer.Parents())
+				assert.Empty(peer.Children())
 				assert.NotEqual(peer.CreateAt.Load(), 0)
 				assert.NotEqual(peer.UpdateAt.Load(), 0)
 				assert.NotNil(peer.Log)
 			},
 		},
+		{
+			name:    "new peer with priority",
+			id:      mockPeerID,
+			options: []PeerOption{WithPriority(base.Priority_HIGH_PRIORITY)},
+			expect: func(t *testing.T, peer *Peer, mockTask *Task, mockHost *Host) {
+				assert := assert.New(t)
+				assert.Equal(peer.ID, mockPeerID)
+				assert.Equal(peer.Tag, DefaultTag)
+				assert.Equal(peer.Priority, base.Priority_HIGH_PRIORITY)
+				assert.Equal(peer.FSM.Current(), PeerStatePending)
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -383,7 +400,7 @@ func TestPeer_DeleteParent(t *testing.T) {
 			parentID: idgen.PeerID("127.0.0.1"),
 			expect: func(t *testing.T, peer *Peer, mockParentPeer *Peer) {
 				peer.StoreParent(mockParentPeer)
-				peer.DeleteParent()
+				peer.DeleteParent(mockParentPeer.ID)
 				assert := assert.New(t)
 
 				var ok bool
@@ -391,13 +408,33 @@ func TestPeer_DeleteParent(t *testing.T) {
 				assert.Equal(ok, false)
 				_, ok = mockParentPeer.LoadChild(peer.ID)
 				assert.Equal(ok, false)
+				assert.Equal(mockParentPeer.ChildCount.Load(), int32(0))
+				assert.Equal(mockParentPeer.Host.UploadPeerCount.Load(), int32(0))
+				_, ok = mockParentPeer.Host.UploadPeers.Load(mockParentPeer.ID)
+				assert.False(ok)
+			},
+		},
+		{
+			name:     "delete main parent and promote another parent",
+			parentID: idgen.PeerID("127.0.0.1"),
+			expect: func(t *testing.T, peer *Peer, mockParentPeer *Peer) {
+				mockStealPeer := NewPeer(idgen.PeerID("127.0.0.2"), peer.Task, mockParentPeer.Host)
+				peer.StoreParent(mockParentPeer)
+				peer.AddParent(mockStealPeer)
+				peer.DeleteParent(mockParentPeer.ID)
+				assert := assert.New(t)
+
+				parent, ok := peer.LoadParent()
+				assert.Equal(ok, true)
+				assert.Equal(parent.ID, mockStealPeer.ID)
+				assert.Equal(len(peer.Parents()), 1)
 			},
 		},
 		{
 			name:     "parent does not exist",
 			parentID: idgen.PeerID("127.0.0.1"),
 			expect: func(t *testing.T, peer *Peer, mockParentPeer *Peer) {
-				peer.DeleteParent()
+				peer.DeleteParent(mockParentPeer.ID)
 				assert := assert.New(t)
 
 				var ok bool
@@ -421,7 +458,7 @@ func TestPeer_DeleteParent(t *testing.T) {
 	}
 }
 
-func TestPeer_ReplaceParent(t *testing.T) {
+func TestPeer_ReplaceParents(t *testing.T) {
 	tests := []struct {
 		name        string
 		oldParentID string
@@ -434,7 +471,7 @@ func TestPeer_ReplaceParent(t *testing.T) {
 			newParentID: idgen.PeerID("127.0.0.1"),
 			expect: func(t *testing.T, peer *Peer, mockOldParentPeer *Peer, mockNewParentPeer *Peer) {
 				peer.StoreParent(mockOldParentPeer)
-				peer.ReplaceParent(mockNewParentPeer)
+				peer.ReplaceParents([]*Peer{mockNewParentPeer})
 				assert := assert.New(t)
 
 				var (
@@ -457,7 +494,7 @@ func TestPeer_ReplaceParent(t *testing.T) {
 			oldParentID: idgen.PeerID("127.0.0.1"),
 			newParentID: idgen.PeerID("127.0.0.1"),
 			expect: func(t *testing.T, peer *Peer, mockOldParentPeer *Peer, mockNewParentPeer *Peer) {
-				peer.ReplaceParent(mockNewParentPeer)
+				peer.ReplaceParents([]*Peer{mockNewParentPeer})
 				assert := assert.New(t)
 
 				var (
@@ -475,6 +512,24 @@ func TestPeer_ReplaceParent(t *testing.T) {
 				assert.Equal(child.ID, peer.ID)
 			},
 		},
+		{
+			name:        "replace parents with multiple parents",
+			oldParentID: idgen.PeerID("127.0.0.1"),
+			newParentID: idgen.PeerID("127.0.0.1"),
+			expect: func(t *testing.T, peer *Peer, mockOldParentPeer *Peer, mockNewParentPeer *Peer) {
+				peer.StoreParent(mockOldParentPeer)
+				peer.ReplaceParents([]*Peer{mockNewParentPeer, mockOldParentPeer})
+				assert := assert.New(t)
+
+				parent, ok := peer.LoadParent()
+				assert.Equal(ok, true)
+				assert.Equal(parent.ID, mockNewParentPeer.ID)
+				assert.Equal(len(peer.Parents()), 2)
+				_, ok = mockOldParentPeer.LoadChild(peer.ID)
+				assert.Equal(ok, true)
+				assert.Equal(peer.Host.UploadPeerCount.Load(), int32(2))
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -490,6 +545,97 @@ func TestPeer_ReplaceParent(t *testing.T) {
 	}
 }
 
+func TestPeer_AddParent(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect func(t *testing.T, peer *Peer, mockParentPeer *Peer, mockStealPeer *Peer)
+	}{
+		{
+			name: "add parent without main parent",
+			expect: func(t *testing.T, peer *Peer, mockParentPeer *Peer, mockStealPeer *Peer) {
+				assert := assert.New(t)
+				assert.NoError(peer.AddParent(mockParentPeer))
+				parent, ok := peer.LoadParent()
+				assert.Equal(ok, true)
+				assert.Equal(parent.ID, mockParentPeer.ID)
+			},
+		},
+		{
+			name: "add parent with main parent",
+			expect: func(t *testing.T, peer *Peer, mockParentPeer *Peer, mockStealPeer *Peer) {
+				assert := assert.New(t)
+				assert.NoError(peer.StoreParent(mockParentPeer))
+				assert.NoError(peer.AddParent(mockStealPeer))
+				parent, ok := peer.LoadParent()
+				assert.Equal(ok, true)
+				assert.Equal(parent.ID, mockParentPeer.ID)
+				assert.Equal(len(peer.Parents()), 2)
+				assert.Equal(mockParentPeer.ChildCount.Load(), int32(1))
+				assert.Equal(mockStealPeer.ChildCount.Load(), int32(1))
+				assert.Equal(peer.Host.UploadPeerCount.Load(), int32(2))
+				_, ok = peer.Host.UploadPeers.Load(mockParentPeer.ID)
+				assert.True(ok)
+				_, ok = peer.Host.UploadPeers.Load(mockStealPeer.ID)
+				assert.True(ok)
+			},
+		},
+		{
+			name: "parent already exists",
+			expect: func(t *testing.T, peer *Peer, mockParentPeer *Peer, mockStealPeer *Peer) {
+				assert := assert.New(t)
+				assert.NoError(peer.AddParent(mockParentPeer))
+				assert.NoError(peer.AddParent(mockParentPeer))
+				assert.Equal(len(peer.Parents()), 1)
+				assert.Equal(peer.Host.UploadPeerCount.Load(), int32(1))
+			},
+		},
+		{
+			name: "parent is descendant",
+			expect: func(t *testing.T, peer *Peer, mockParentPeer *Peer, mockStealPeer *Peer) {
+				assert := assert.New(t)
+				assert.NoError(mockParentPeer.AddParent(peer))
+				assert.NoError(mockStealPeer.AddParent(mockParentPeer))
+				assert.Error(peer.AddParent(mockStealPeer))
+				assert.Equal(len(peer.Parents()), 0)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockHost := NewHost(mockRawHost)
+			mockTask := NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, WithBackToSourceLimit(mockTaskBackToSourceLimit))
+			peer := NewPeer(mockPeerID, mockTask, mockHost)
+			mockParentPeer := NewPeer(idgen.PeerID("127.0.0.1"), mockTask, mockHost)
+			mockStealPeer := NewPeer(idgen.PeerID("127.0.0.2"), mockTask, mockHost)
+
+			tc.expect(t, peer, mockParentPeer, mockStealPeer)
+		})
+	}
+}
+
+func TestPeer_DeleteParents(t *testing.T) {
+	mockHost := NewHost(mockRawHost)
+	mockTask := NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, WithBackToSourceLimit(mockTaskBackToSourceLimit))
+	peer := NewPeer(mockPeerID, mockTask, mockHost)
+	mockParentPeer := NewPeer(idgen.PeerID("127.0.0.1"), mockTask, mockHost)
+	mockStealPeer := NewPeer(idgen.PeerID("127.0.0.2"), mockTask, mockHost)
+
+	assert := assert.New(t)
+	assert.NoError(peer.StoreParent(mockParentPeer))
+	assert.NoError(peer.AddParent(mockStealPeer))
+	peer.DeleteParents()
+
+	_, ok := peer.LoadParent()
+	assert.Equal(ok, false)
+	assert.Equal(len(peer.Parents()), 0)
+	assert.Equal(len(mockParentPeer.Children()), 0)
+	assert.Equal(len(mockStealPeer.Children()), 0)
+	assert.Equal(mockHost.UploadPeerCount.Load(), int32(0))
+	_, ok = mockHost.UploadPeers.Load(mockParentPeer.ID)
+	assert.False(ok)
+}
+
 func TestPeer_Depth(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -521,12 +667,22 @@ func TestPeer_Depth(t *testing.T) {
 			},
 		},
 		{
-			name: "node parent is itself",
+			name: "node has multiple parents",
 			expect: func(t *testing.T, peer *Peer, parent *Peer, seedPeerParent *Peer) {
-				peer.StoreParent(peer)
+				parent.StoreParent(seedPeerParent)
+				peer.StoreParent(seedPeerParent)
+				peer.AddParent(parent)
 
 				assert := assert.New(t)
-				assert.Equal(peer.Depth(), 2)
+				assert.Equal(peer.Depth(), 3)
+			},
+		},
+		{
+			name: "node parent is itself",
+			expect: func(t *testing.T, peer *Peer, parent *Peer, seedPeerParent *Peer) {
+				assert := assert.New(t)
+				assert.Error(peer.StoreParent(peer))
+				assert.Equal(peer.Depth(), 1)
 			},
 		},
 	}
@@ -561,6 +717,18 @@ func TestPeer_Ancestors(t *testing.T) {
 				assert.EqualValues(mockChildPeer.Ancestors(), []string{mockChildPeer.ID, peer.ID})
 			},
 		},
+		{
+			name:    "ancestors of multiple parents",
+			childID: idgen.PeerID("127.0.0.1"),
+			expect: func(t *testing.T, peer *Peer, mockChildPeer *Peer) {
+				assert := assert.New(t)
+				mockParentPeer := NewPeer(idgen.PeerID("127.0.0.2"), peer.Task, peer.Host)
+				mockParentPeer.StoreChild(peer)
+				peer.StoreChild(mockChildPeer)
+				mockParentPeer.StoreChild(mockChildPeer)
+				assert.ElementsMatch(mockChildPeer.Ancestors(), []string{mockChildPeer.ID, peer.ID, mockParentPeer.ID})
+			},
+		},
 		{
 			name:    "child has no parent",
 			childID: idgen.PeerID("127.0.0.1"),
@@ -575,9 +743,9 @@ func TestPeer_Ancestors(t *testing.T) {
 			childID: idgen.PeerID("127.0.0.1"),
 			expect: func(t *testing.T, peer *Peer, mockChildPeer *Peer) {
 				assert := assert.New(t)
-				peer.StoreChild(peer)
-				assert.Equal(len(peer.Ancestors()), 2)
-				assert.Equal(peer.Ancestors(), []string{peer.ID, peer.ID})
+				assert.Error(peer.StoreChild(peer))
+				assert.Equal(len(peer.Ancestors()), 1)
+				assert.Equal(peer.Ancestors(), []string{peer.ID})
 			},
 		},
 	}
@@ -857,6 +1025,53 @@ func TestPeer_DeleteStream(t *testing.T) {
 	}
 }
 
+func TestPeer_publishEvent(t *testing.T) {
+	tests := []struct {
+		name   string
+		hub    event.Hub
+		expect func(t *testing.T, peer *Peer, s event.Subscription)
+	}{
+		{
+			name: "publish event to hub of task",
+			hub:  event.New(),
+			expect: func(t *testing.T, peer *Peer, s event.Subscription) {
+				assert := assert.New(t)
+				assert.NoError(peer.FSM.Event(PeerEventRegisterNormal))
+				e := <-s.Events()
+				assert.Equal(e.Type, scheduler.EventType_PEER_REGISTERED)
+				assert.Equal(e.TaskId, mockTaskID)
+				assert.Equal(e.PeerId, mockPeerID)
+				assert.Equal(e.HostId, mockRawHost.Id)
+				assert.Equal(e.State, PeerStateReceivedNormal)
+			},
+		},
+		{
+			name: "task has no hub",
+			expect: func(t *testing.T, peer *Peer, s event.Subscription) {
+				assert := assert.New(t)
+				assert.NoError(peer.FSM.Event(PeerEventRegisterNormal))
+				assert.Len(s.Events(), 0)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := event.New().Subscribe(&scheduler.WatchRequest{})
+			options := []Option{WithBackToSourceLimit(mockTaskBackToSourceLimit)}
+			if tc.hub != nil {
+				s = tc.hub.Subscribe(&scheduler.WatchRequest{TaskId: mockTaskID})
+				options = append(options, WithEventHub(tc.hub))
+			}
+			defer s.Close()
+
+			mockHost := NewHost(mockRawHost)
+			mockTask := NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, options...)
+			tc.expect(t, NewPeer(mockPeerID, mockTask, mockHost), s)
+		})
+	}
+}
+
 func TestPeer_DownloadTinyFile(t *testing.T) {
 	testData := []byte("./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz" +
 		"./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz")
@@ -0,0 +1,132 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resource
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultProbesLimit is the default limit of probed hosts kept by host.
+	DefaultProbesLimit = 50
+
+	// probeSmoothingFactor is the weight of the latest probe result,
+	// probe results are smoothed by exponentially weighted moving average.
+	probeSmoothingFactor = 0.3
+)
+
+// Probe is the smoothed probe result from host to the target host.
+type Probe struct {
+	// RTT is the round-trip time to the target host.
+	RTT time.Duration
+
+	// Loss is the packet loss rate to the target host.
+	Loss float64
+
+	// UpdateAt is probe update time.
+	UpdateAt time.Time
+}
+
+// Probes is the bounded probe results from host to other hosts,
+// the least recently updated probe is evicted when it reaches the limit.
+type Probes struct {
+	// limit is the maximum number of probed hosts.
+	limit int
+
+	// probes is the map of target host id and probe result.
+	probes map[string]*Probe
+
+	// mu guards probes.
+	mu sync.RWMutex
+}
+
+// NewProbes returns a new Probes with the limit of probed hosts.
+func NewProbes(limit int) *Probes {
+	return &Probes{
+		limit:  limit,
+		probes: map[string]*Probe{},
+	}
+}
+
+// Load returns the probe result of the target host.
+func (p *Probes) Load(hostID string) (Probe, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	probe, ok := p.probes[hostID]
+	if !ok {
+		return Probe{}, false
+	}
+
+	return *probe, true
+}
+
+// Store smooths the probe result into the target host.
+func (p *Probes) Store(hostID string, rtt time.Duration, loss float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if probe, ok := p.probes[hostID]; ok {
+		probe.RTT = time.Duration(probeSmoothingFactor*float64(rtt) + (1-probeSmoothingFactor)*float64(probe.RTT))
+		probe.Loss = probeSmoothingFactor*loss + (1-probeSmoothingFactor)*probe.Loss
+		probe.UpdateAt = time.Now()
+		return
+	}
+
+	if p.limit > 0 && len(p.probes) >= p.limit {
+		p.evict()
+	}
+
+	p.probes[hostID] = &Probe{
+		RTT:      rtt,
+		Loss:     loss,
+		UpdateAt: time.Now(),
+	}
+}
+
+// Delete deletes the probe result of the target host.
+func (p *Probes) Delete(hostID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.probes, hostID)
+}
+
+// Len returns the number of probed hosts.
+func (p *Probes) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return len(p.probes)
+}
+
+// evict deletes the least recently updated probe.
+func (p *Probes) evict() {
+	var (
+		evictedHostID string
+		evictedAt     time.Time
+	)
+	for hostID, probe := range p.probes {
+		if evictedHostID == "" || probe.UpdateAt.Before(evictedAt) {
+			evictedHostID = hostID
+			evictedAt = probe.UpdateAt
+		}
+	}
+
+	delete(p.probes, evictedHostID)
+}
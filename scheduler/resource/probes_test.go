@@ -0,0 +1,129 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbes_Store(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  int
+		expect func(t *testing.T, p *Probes)
+	}{
+		{
+			name:  "store probe",
+			limit: 2,
+			expect: func(t *testing.T, p *Probes) {
+				assert := assert.New(t)
+				p.Store("foo", 10*time.Millisecond, 0.1)
+				probe, ok := p.Load("foo")
+				assert.True(ok)
+				assert.Equal(probe.RTT, 10*time.Millisecond)
+				assert.Equal(probe.Loss, 0.1)
+				assert.NotEqual(probe.UpdateAt, time.Time{})
+				assert.Equal(p.Len(), 1)
+			},
+		},
+		{
+			name:  "smooth probe of the same host",
+			limit: 2,
+			expect: func(t *testing.T, p *Probes) {
+				assert := assert.New(t)
+				p.Store("foo", 10*time.Millisecond, 0)
+				p.Store("foo", 20*time.Millisecond, 1)
+				probe, ok := p.Load("foo")
+				assert.True(ok)
+				assert.Equal(probe.RTT, 13*time.Millisecond)
+				assert.InDelta(probe.Loss, 0.3, 1e-9)
+				assert.Equal(p.Len(), 1)
+			},
+		},
+		{
+			name:  "evict the least recently updated probe",
+			limit: 2,
+			expect: func(t *testing.T, p *Probes) {
+				assert := assert.New(t)
+				p.Store("foo", 10*time.Millisecond, 0)
+				p.Store("bar", 10*time.Millisecond, 0)
+				p.Store("foo", 10*time.Millisecond, 0)
+				p.Store("baz", 10*time.Millisecond, 0)
+				_, ok := p.Load("bar")
+				assert.False(ok)
+				_, ok = p.Load("foo")
+				assert.True(ok)
+				_, ok = p.Load("baz")
+				assert.True(ok)
+				assert.Equal(p.Len(), 2)
+			},
+		},
+		{
+			name:  "probes have no limit",
+			limit: 0,
+			expect: func(t *testing.T, p *Probes) {
+				assert := assert.New(t)
+				p.Store("foo", 10*time.Millisecond, 0)
+				p.Store("bar", 10*time.Millisecond, 0)
+				p.Store("baz", 10*time.Millisecond, 0)
+				assert.Equal(p.Len(), 3)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expect(t, NewProbes(tc.limit))
+		})
+	}
+}
+
+func TestProbes_Delete(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect func(t *testing.T, p *Probes)
+	}{
+		{
+			name: "delete probe",
+			expect: func(t *testing.T, p *Probes) {
+				assert := assert.New(t)
+				p.Store("foo", 10*time.Millisecond, 0)
+				p.Delete("foo")
+				_, ok := p.Load("foo")
+				assert.False(ok)
+				assert.Equal(p.Len(), 0)
+			},
+		},
+		{
+			name: "delete probe that does not exist",
+			expect: func(t *testing.T, p *Probes) {
+				assert := assert.New(t)
+				p.Delete("foo")
+				assert.Equal(p.Len(), 0)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expect(t, NewProbes(DefaultProbesLimit))
+		})
+	}
+}
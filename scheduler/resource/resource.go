@@ -23,6 +23,7 @@ import (
 
 	"d7y.io/dragonfly/v2/pkg/gc"
 	"d7y.io/dragonfly/v2/scheduler/config"
+	"d7y.io/dragonfly/v2/scheduler/event"
 )
 
 type Resource interface {
@@ -37,6 +38,9 @@ type Resource interface {
 
 	// Task manager interface.
 	TaskManager() TaskManager
+
+	// Event hub interface.
+	EventHub() event.Hub
 }
 
 type resource struct {
@@ -51,10 +55,40 @@ type resource struct {
 
 	// Task manager interface.
 	taskManager TaskManager
+
+	// Event hub interface.
+	eventHub event.Hub
+
+	// Dial options of seed peer client.
+	dialOptions []grpc.DialOption
+}
+
+// ResourceOption is a functional option for configuring the resource.
+type ResourceOption func(r *resource)
+
+// WithResourceEventHub sets the hub which lifecycle events are published to.
+func WithResourceEventHub(hub event.Hub) ResourceOption {
+	return func(r *resource) {
+		r.eventHub = hub
+	}
 }
 
-func New(cfg *config.Config, gc gc.GC, dynconfig config.DynconfigInterface, opts ...grpc.DialOption) (Resource, error) {
+// WithDialOptions sets the grpc dial options of seed peer client.
+func WithDialOptions(opts ...grpc.DialOption) ResourceOption {
+	return func(r *resource) {
+		r.dialOptions = opts
+	}
+}
+
+func New(cfg *config.Config, gc gc.GC, dynconfig config.DynconfigInterface, options ...ResourceOption) (Resource, error) {
 	resource := &resource{}
+	for _, opt := range options {
+		opt(resource)
+	}
+
+	if resource.eventHub == nil {
+		resource.eventHub = event.New()
+	}
 
 	// Initialize host manager interface.
 	hostManager, err := newHostManager(cfg.Scheduler.GC, gc)
@@ -63,23 +97,26 @@ func New(cfg *config.Config, gc gc.GC, dynconfig config.DynconfigInterface, opts
 	}
 	resource.hostManager = hostManager
 
-	// Initialize task manager interface.
-	taskManager, err := newTaskManager(cfg.Scheduler.GC, gc)
+	// Initialize peer manager interface.
+	peerManager, err := newPeerManager(cfg.Scheduler.GC, gc)
 	if err != nil {
 		return nil, err
 	}
-	resource.taskManager = taskManager
+	resource.peerManager = peerManager
 
-	// Initialize peer manager interface.
-	peerManager, err := newPeerManager(cfg.Scheduler.GC, gc)
+	// Initialize task manager interface.
+	taskManager, err := newTaskManager(cfg.Scheduler.GC, gc, peerManager)
 	if err != nil {
 		return nil, err
 	}
-	resource.peerManager = peerManager
+	resource.taskManager = taskManager
+
+	// Synchronize cordoned hosts from dynconfig.
+	dynconfig.Register(hostManager)
 
 	// Initialize seed peer interface.
 	if cfg.SeedPeer.Enable {
-		client, err := newSeedPeerClient(dynconfig, hostManager, opts...)
+		client, err := newSeedPeerClient(dynconfig, hostManager, resource.dialOptions...)
 		if err != nil {
 			return nil, err
 		}
@@ -105,3 +142,7 @@ func (r *resource) TaskManager() TaskManager {
 func (r *resource) PeerManager() PeerManager {
 	return r.peerManager
 }
+
+func (r *resource) EventHub() event.Hub {
+	return r.eventHub
+}
@@ -7,6 +7,7 @@ package resource
 import (
 	reflect "reflect"
 
+	event "d7y.io/dragonfly/v2/scheduler/event"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -33,6 +34,20 @@ func (m *MockResource) EXPECT() *MockResourceMockRecorder {
 	return m.recorder
 }
 
+// EventHub mocks base method.
+func (m *MockResource) EventHub() event.Hub {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventHub")
+	ret0, _ := ret[0].(event.Hub)
+	return ret0
+}
+
+// EventHub indicates an expected call of EventHub.
+func (mr *MockResourceMockRecorder) EventHub() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventHub", reflect.TypeOf((*MockResource)(nil).EventHub))
+}
+
 // HostManager mocks base method.
 func (m *MockResource) HostManager() HostManager {
 	m.ctrl.T.Helper()
@@ -42,6 +42,7 @@ func TestResource_New(t *testing.T) {
 			mock: func(gc *gc.MockGCMockRecorder, dynconfig *configmocks.MockDynconfigInterfaceMockRecorder) {
 				gomock.InOrder(
 					gc.Add(gomock.Any()).Return(nil).Times(3),
+					dynconfig.Register(gomock.Any()).Return().Times(1),
 					dynconfig.Get().Return(&config.DynconfigData{
 						SeedPeers: []*config.SeedPeer{{ID: 1}},
 					}, nil).Times(1),
@@ -51,6 +52,7 @@ func TestResource_New(t *testing.T) {
 			expect: func(t *testing.T, resource Resource, err error) {
 				assert := assert.New(t)
 				assert.Equal(reflect.TypeOf(resource).Elem().Name(), "resource")
+				assert.NotNil(resource.EventHub())
 				assert.NoError(err)
 			},
 		},
@@ -101,6 +103,7 @@ func TestResource_New(t *testing.T) {
 			mock: func(gc *gc.MockGCMockRecorder, dynconfig *configmocks.MockDynconfigInterfaceMockRecorder) {
 				gomock.InOrder(
 					gc.Add(gomock.Any()).Return(nil).Times(3),
+					dynconfig.Register(gomock.Any()).Return().Times(1),
 					dynconfig.Get().Return(nil, errors.New("foo")).Times(1),
 				)
 			},
@@ -115,6 +118,7 @@ func TestResource_New(t *testing.T) {
 			mock: func(gc *gc.MockGCMockRecorder, dynconfig *configmocks.MockDynconfigInterfaceMockRecorder) {
 				gomock.InOrder(
 					gc.Add(gomock.Any()).Return(nil).Times(3),
+					dynconfig.Register(gomock.Any()).Return().Times(1),
 					dynconfig.Get().Return(&config.DynconfigData{
 						SeedPeers: []*config.SeedPeer{},
 					}, nil).Times(1),
@@ -146,6 +150,7 @@ func TestResource_New(t *testing.T) {
 			mock: func(gc *gc.MockGCMockRecorder, dynconfig *configmocks.MockDynconfigInterfaceMockRecorder) {
 				gomock.InOrder(
 					gc.Add(gomock.Any()).Return(nil).Times(3),
+					dynconfig.Register(gomock.Any()).Return().Times(1),
 				)
 			},
 			expect: func(t *testing.T, resource Resource, err error) {
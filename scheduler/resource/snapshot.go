@@ -0,0 +1,358 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+//go:generate mockgen -destination snapshot_mock.go -source snapshot.go -package resource
+
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/bits-and-blooms/bitset"
+
+	logger "d7y.io/dragonfly/v2/internal/dflog"
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	"d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+)
+
+const (
+	// DefaultSnapshotFilename is the default file name of resource snapshot.
+	DefaultSnapshotFilename = "snapshot.json"
+
+	// snapshotVersion is the version of snapshot file format.
+	snapshotVersion = 1
+)
+
+// Snapshot is the interface used for saving and restoring resource.
+type Snapshot interface {
+	// Save writes the succeeded tasks, peers and hosts to the snapshot file.
+	Save() error
+
+	// Restore loads tasks, peers and hosts from the snapshot file.
+	Restore() error
+
+	// Serve saves snapshot periodically.
+	Serve()
+
+	// Stop saves snapshot and stops saving periodically.
+	Stop()
+}
+
+type snapshot struct {
+	// Resource interface.
+	resource Resource
+
+	// Snapshot file name.
+	filename string
+
+	// Interval of saving snapshot.
+	interval time.Duration
+
+	// Done channel.
+	done chan struct{}
+}
+
+// snapshotHost is the host of snapshot.
+type snapshotHost struct {
+	ID              string    `json:"id"`
+	Type            HostType  `json:"type"`
+	IP              string    `json:"ip"`
+	Hostname        string    `json:"hostname"`
+	Port            int32     `json:"port"`
+	DownloadPort    int32     `json:"downloadPort"`
+	SecurityDomain  string    `json:"securityDomain"`
+	IDC             string    `json:"idc"`
+	NetTopology     string    `json:"netTopology"`
+	Location        string    `json:"location"`
+	UploadLoadLimit int32     `json:"uploadLoadLimit"`
+	CreateAt        time.Time `json:"createAt"`
+	UpdateAt        time.Time `json:"updateAt"`
+}
+
+// snapshotTask is the task of snapshot.
+type snapshotTask struct {
+	ID                string            `json:"id"`
+	URL               string            `json:"url"`
+	Type              base.TaskType     `json:"type"`
+	URLMeta           *base.UrlMeta     `json:"urlMeta"`
+	DirectPiece       []byte            `json:"directPiece"`
+	ContentLength     int64             `json:"contentLength"`
+	TotalPieceCount   int32             `json:"totalPieceCount"`
+	BackToSourceLimit int32             `json:"backToSourceLimit"`
+	Pieces            []*base.PieceInfo `json:"pieces"`
+	CreateAt          time.Time         `json:"createAt"`
+	UpdateAt          time.Time         `json:"updateAt"`
+}
+
+// snapshotPeer is the peer of snapshot.
+type snapshotPeer struct {
+	ID       string         `json:"id"`
+	Tag      string         `json:"tag"`
+	TaskID   string         `json:"taskID"`
+	HostID   string         `json:"hostID"`
+	Pieces   *bitset.BitSet `json:"pieces"`
+	CreateAt time.Time      `json:"createAt"`
+	UpdateAt time.Time      `json:"updateAt"`
+}
+
+// snapshotData is the content of snapshot file.
+type snapshotData struct {
+	Version  int             `json:"version"`
+	CreateAt time.Time       `json:"createAt"`
+	Hosts    []*snapshotHost `json:"hosts"`
+	Tasks    []*snapshotTask `json:"tasks"`
+	Peers    []*snapshotPeer `json:"peers"`
+}
+
+// NewSnapshot returns a new Snapshot instence.
+func NewSnapshot(resource Resource, filename string, interval time.Duration) Snapshot {
+	return &snapshot{
+		resource: resource,
+		filename: filename,
+		interval: interval,
+		done:     make(chan struct{}),
+	}
+}
+
+// Save writes the succeeded tasks, peers and hosts to the snapshot file.
+// Only peers that have downloaded the task successfully are saved,
+// running peers will register again after scheduler restarts.
+func (s *snapshot) Save() error {
+	data := &snapshotData{
+		Version:  snapshotVersion,
+		CreateAt: time.Now(),
+	}
+
+	s.resource.HostManager().Range(func(_, value any) bool {
+		host, ok := value.(*Host)
+		if !ok {
+			return true
+		}
+
+		data.Hosts = append(data.Hosts, &snapshotHost{
+			ID:              host.ID,
+			Type:            host.Type,
+			IP:              host.IP,
+			Hostname:        host.Hostname,
+			Port:            host.Port,
+			DownloadPort:    host.DownloadPort,
+			SecurityDomain:  host.SecurityDomain,
+			IDC:             host.IDC,
+			NetTopology:     host.NetTopology,
+			Location:        host.Location,
+			UploadLoadLimit: host.UploadLoadLimit.Load(),
+			CreateAt:        host.CreateAt.Load(),
+			UpdateAt:        host.UpdateAt.Load(),
+		})
+		return true
+	})
+
+	s.resource.TaskManager().Range(func(_, value any) bool {
+		task, ok := value.(*Task)
+		if !ok || !task.FSM.Is(TaskStateSucceeded) {
+			return true
+		}
+
+		var pieces []*base.PieceInfo
+		task.Pieces.Range(func(_, value any) bool {
+			if piece, ok := value.(*base.PieceInfo); ok {
+				pieces = append(pieces, piece)
+			}
+
+			return true
+		})
+
+		data.Tasks = append(data.Tasks, &snapshotTask{
+			ID:                task.ID,
+			URL:               task.URL,
+			Type:              task.Type,
+			URLMeta:           task.URLMeta,
+			DirectPiece:       task.DirectPiece,
+			ContentLength:     task.ContentLength.Load(),
+			TotalPieceCount:   task.TotalPieceCount.Load(),
+			BackToSourceLimit: task.BackToSourceLimit.Load(),
+			Pieces:            pieces,
+			CreateAt:          task.CreateAt.Load(),
+			UpdateAt:          task.UpdateAt.Load(),
+		})
+		return true
+	})
+
+	s.resource.PeerManager().Range(func(_, value any) bool {
+		peer, ok := value.(*Peer)
+		if !ok || !peer.FSM.Is(PeerStateSucceeded) || !peer.Task.FSM.Is(TaskStateSucceeded) {
+			return true
+		}
+
+		data.Peers = append(data.Peers, &snapshotPeer{
+			ID:       peer.ID,
+			Tag:      peer.Tag,
+			TaskID:   peer.Task.ID,
+			HostID:   peer.Host.ID,
+			Pieces:   peer.Pieces.Clone(),
+			CreateAt: peer.CreateAt.Load(),
+			UpdateAt: peer.UpdateAt.Load(),
+		})
+		return true
+	})
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.filename), 0700); err != nil {
+		return err
+	}
+
+	// Write temporary file first to prevent snapshot file from being corrupted.
+	tmp, err := ioutil.TempFile(filepath.Dir(s.filename), filepath.Base(s.filename))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), s.filename); err != nil {
+		return err
+	}
+
+	logger.Infof("save snapshot with %d hosts, %d tasks and %d peers", len(data.Hosts), len(data.Tasks), len(data.Peers))
+	return nil
+}
+
+// Restore loads tasks, peers and hosts from the snapshot file.
+// Restored peers are marked as NeedValidation, they are validated lazily
+// when children download pieces from them.
+func (s *snapshot) Restore() error {
+	b, err := ioutil.ReadFile(s.filename)
+	if err != nil {
+		return err
+	}
+
+	data := &snapshotData{}
+	if err := json.Unmarshal(b, data); err != nil {
+		return err
+	}
+
+	if data.Version != snapshotVersion {
+		return fmt.Errorf("invalid snapshot version %d", data.Version)
+	}
+
+	for _, h := range data.Hosts {
+		host := NewHost(&scheduler.PeerHost{
+			Id:             h.ID,
+			Ip:             h.IP,
+			HostName:       h.Hostname,
+			RpcPort:        h.Port,
+			DownPort:       h.DownloadPort,
+			SecurityDomain: h.SecurityDomain,
+			Idc:            h.IDC,
+			NetTopology:    h.NetTopology,
+			Location:       h.Location,
+		}, WithHostType(h.Type), WithUploadLoadLimit(h.UploadLoadLimit))
+		host.CreateAt.Store(h.CreateAt)
+		host.UpdateAt.Store(h.UpdateAt)
+
+		s.resource.HostManager().LoadOrStore(host)
+	}
+
+	for _, t := range data.Tasks {
+		task := NewTask(t.ID, t.URL, t.Type, t.URLMeta, WithBackToSourceLimit(t.BackToSourceLimit), WithEventHub(s.resource.EventHub()))
+		task.DirectPiece = t.DirectPiece
+		task.ContentLength.Store(t.ContentLength)
+		task.TotalPieceCount.Store(t.TotalPieceCount)
+		for _, piece := range t.Pieces {
+			task.StorePiece(piece)
+		}
+		task.FSM.SetState(TaskStateSucceeded)
+		task.CreateAt.Store(t.CreateAt)
+		task.UpdateAt.Store(t.UpdateAt)
+
+		s.resource.TaskManager().LoadOrStore(task)
+	}
+
+	var count int
+	for _, p := range data.Peers {
+		task, ok := s.resource.TaskManager().Load(p.TaskID)
+		if !ok {
+			continue
+		}
+
+		host, ok := s.resource.HostManager().Load(p.HostID)
+		if !ok {
+			continue
+		}
+
+		peer := NewPeer(p.ID, task, host, WithTag(p.Tag))
+		if p.Pieces != nil {
+			peer.Pieces = p.Pieces
+		}
+		peer.FSM.SetState(PeerStateSucceeded)
+		peer.NeedValidation.Store(true)
+		peer.CreateAt.Store(p.CreateAt)
+		peer.UpdateAt.Store(p.UpdateAt)
+
+		if _, loaded := s.resource.PeerManager().LoadOrStore(peer); loaded {
+			continue
+		}
+
+		// Succeeded peer has been removed from host.
+		host.DeletePeer(peer.ID)
+		count++
+	}
+
+	logger.Infof("restore snapshot created at %s with %d hosts, %d tasks and %d peers",
+		data.CreateAt, len(data.Hosts), len(data.Tasks), count)
+	return nil
+}
+
+// Serve saves snapshot periodically.
+func (s *snapshot) Serve() {
+	tick := time.NewTicker(s.interval)
+	for {
+		select {
+		case <-tick.C:
+			if err := s.Save(); err != nil {
+				logger.Errorf("save snapshot failed: %s", err.Error())
+			}
+		case <-s.done:
+			tick.Stop()
+			return
+		}
+	}
+}
+
+// Stop saves snapshot and stops saving periodically.
+func (s *snapshot) Stop() {
+	close(s.done)
+	if err := s.Save(); err != nil {
+		logger.Errorf("save snapshot failed: %s", err.Error())
+	}
+}
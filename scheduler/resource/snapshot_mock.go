@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: snapshot.go
+
+// Package resource is a generated GoMock package.
+package resource
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSnapshot is a mock of Snapshot interface.
+type MockSnapshot struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotMockRecorder
+}
+
+// MockSnapshotMockRecorder is the mock recorder for MockSnapshot.
+type MockSnapshotMockRecorder struct {
+	mock *MockSnapshot
+}
+
+// NewMockSnapshot creates a new mock instance.
+func NewMockSnapshot(ctrl *gomock.Controller) *MockSnapshot {
+	mock := &MockSnapshot{ctrl: ctrl}
+	mock.recorder = &MockSnapshotMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshot) EXPECT() *MockSnapshotMockRecorder {
+	return m.recorder
+}
+
+// Restore mocks base method.
+func (m *MockSnapshot) Restore() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockSnapshotMockRecorder) Restore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockSnapshot)(nil).Restore))
+}
+
+// Save mocks base method.
+func (m *MockSnapshot) Save() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockSnapshotMockRecorder) Save() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSnapshot)(nil).Save))
+}
+
+// Serve mocks base method.
+func (m *MockSnapshot) Serve() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Serve")
+}
+
+// Serve indicates an expected call of Serve.
+func (mr *MockSnapshotMockRecorder) Serve() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Serve", reflect.TypeOf((*MockSnapshot)(nil).Serve))
+}
+
+// Stop mocks base method.
+func (m *MockSnapshot) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockSnapshotMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockSnapshot)(nil).Stop))
+}
@@ -0,0 +1,236 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resource
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"d7y.io/dragonfly/v2/pkg/gc"
+	"d7y.io/dragonfly/v2/pkg/idgen"
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	"d7y.io/dragonfly/v2/scheduler/config"
+	"d7y.io/dragonfly/v2/scheduler/event"
+)
+
+func newMockSnapshotResource(t *testing.T, ctl *gomock.Controller) (*MockResource, HostManager, TaskManager, PeerManager) {
+	mockGC := gc.NewMockGC(ctl)
+	mockGC.EXPECT().Add(gomock.Any()).Return(nil).Times(3)
+
+	cfg := config.New()
+	hostManager, err := newHostManager(cfg.Scheduler.GC, mockGC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	peerManager, err := newPeerManager(cfg.Scheduler.GC, mockGC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	taskManager, err := newTaskManager(cfg.Scheduler.GC, mockGC, peerManager)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := NewMockResource(ctl)
+	res.EXPECT().HostManager().Return(hostManager).AnyTimes()
+	res.EXPECT().TaskManager().Return(taskManager).AnyTimes()
+	res.EXPECT().PeerManager().Return(peerManager).AnyTimes()
+	res.EXPECT().EventHub().Return(event.New()).AnyTimes()
+	return res, hostManager, taskManager, peerManager
+}
+
+func TestSnapshot_SaveAndRestore(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(hostManager HostManager, taskManager TaskManager, peerManager PeerManager)
+		expect func(t *testing.T, hostManager HostManager, taskManager TaskManager, peerManager PeerManager)
+	}{
+		{
+			name: "restore succeeded task and peer",
+			mock: func(hostManager HostManager, taskManager TaskManager, peerManager PeerManager) {
+				host := NewHost(mockRawHost, WithUploadLoadLimit(10))
+				hostManager.Store(host)
+
+				task := NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, WithBackToSourceLimit(mockTaskBackToSourceLimit))
+				task.ContentLength.Store(1024)
+				task.TotalPieceCount.Store(2)
+				task.StorePiece(mockPieceInfo)
+				task.FSM.SetState(TaskStateSucceeded)
+				taskManager.Store(task)
+
+				peer := NewPeer(mockPeerID, task, host, WithTag("foo"))
+				peer.Pieces.Set(0)
+				peer.Pieces.Set(1)
+				peer.FSM.SetState(PeerStateSucceeded)
+				peerManager.Store(peer)
+
+				runningPeer := NewPeer(idgen.PeerID("127.0.0.2"), task, host)
+				runningPeer.FSM.SetState(PeerStateRunning)
+				peerManager.Store(runningPeer)
+			},
+			expect: func(t *testing.T, hostManager HostManager, taskManager TaskManager, peerManager PeerManager) {
+				assert := assert.New(t)
+				host, ok := hostManager.Load(mockRawHost.Id)
+				assert.True(ok)
+				assert.Equal(host.IP, mockRawHost.Ip)
+				assert.Equal(host.UploadLoadLimit.Load(), int32(10))
+				assert.Equal(host.PeerCount.Load(), int32(0))
+
+				task, ok := taskManager.Load(mockTaskID)
+				assert.True(ok)
+				assert.True(task.FSM.Is(TaskStateSucceeded))
+				assert.Equal(task.ContentLength.Load(), int64(1024))
+				assert.Equal(task.TotalPieceCount.Load(), int32(2))
+				assert.Equal(task.URLMeta.Digest, mockTaskURLMeta.Digest)
+				assert.NotNil(task.EventHub)
+				piece, ok := task.LoadPiece(mockPieceInfo.PieceNum)
+				assert.True(ok)
+				assert.Equal(piece.PieceMd5, mockPieceInfo.PieceMd5)
+				assert.Equal(task.PeerCount.Load(), int32(1))
+
+				peer, ok := peerManager.Load(mockPeerID)
+				assert.True(ok)
+				assert.True(peer.FSM.Is(PeerStateSucceeded))
+				assert.True(peer.NeedValidation.Load())
+				assert.Equal(peer.Tag, "foo")
+				assert.Equal(peer.Pieces.Count(), uint(2))
+
+				_, ok = peerManager.Load(idgen.PeerID("127.0.0.2"))
+				assert.False(ok)
+			},
+		},
+		{
+			name: "task is not succeeded",
+			mock: func(hostManager HostManager, taskManager TaskManager, peerManager PeerManager) {
+				host := NewHost(mockRawHost)
+				hostManager.Store(host)
+
+				task := NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+				task.FSM.SetState(TaskStateRunning)
+				taskManager.Store(task)
+
+				peer := NewPeer(mockPeerID, task, host)
+				peer.FSM.SetState(PeerStateSucceeded)
+				peerManager.Store(peer)
+			},
+			expect: func(t *testing.T, hostManager HostManager, taskManager TaskManager, peerManager PeerManager) {
+				assert := assert.New(t)
+				_, ok := hostManager.Load(mockRawHost.Id)
+				assert.True(ok)
+
+				_, ok = taskManager.Load(mockTaskID)
+				assert.False(ok)
+
+				_, ok = peerManager.Load(mockPeerID)
+				assert.False(ok)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+
+			dir, err := ioutil.TempDir("", "snapshot")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			filename := filepath.Join(dir, DefaultSnapshotFilename)
+
+			res, hostManager, taskManager, peerManager := newMockSnapshotResource(t, ctl)
+			tc.mock(hostManager, taskManager, peerManager)
+			if err := NewSnapshot(res, filename, time.Minute).Save(); err != nil {
+				t.Fatal(err)
+			}
+
+			res, hostManager, taskManager, peerManager = newMockSnapshotResource(t, ctl)
+			if err := NewSnapshot(res, filename, time.Minute).Restore(); err != nil {
+				t.Fatal(err)
+			}
+			tc.expect(t, hostManager, taskManager, peerManager)
+		})
+	}
+}
+
+func TestSnapshot_Restore(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(t *testing.T, filename string)
+		expect func(t *testing.T, err error)
+	}{
+		{
+			name: "snapshot file does not exist",
+			mock: func(t *testing.T, filename string) {},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.Error(err)
+			},
+		},
+		{
+			name: "snapshot file is corrupt",
+			mock: func(t *testing.T, filename string) {
+				if err := ioutil.WriteFile(filename, []byte("{"), 0600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.Error(err)
+			},
+		},
+		{
+			name: "snapshot version is invalid",
+			mock: func(t *testing.T, filename string) {
+				if err := ioutil.WriteFile(filename, []byte(`{"version": 100}`), 0600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "invalid snapshot version 100")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+
+			dir, err := ioutil.TempDir("", "snapshot")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			filename := filepath.Join(dir, DefaultSnapshotFilename)
+
+			res := NewMockResource(ctl)
+			tc.mock(t, filename)
+			tc.expect(t, NewSnapshot(res, filename, time.Minute).Restore())
+		})
+	}
+}
@@ -27,8 +27,11 @@ import (
 
 	logger "d7y.io/dragonfly/v2/internal/dflog"
 	"d7y.io/dragonfly/v2/pkg/container/set"
+	"d7y.io/dragonfly/v2/pkg/dag"
 	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	"d7y.io/dragonfly/v2/pkg/rpc/errordetails"
 	rpcscheduler "d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+	"d7y.io/dragonfly/v2/scheduler/event"
 )
 
 const (
@@ -64,6 +67,17 @@ const (
 	TaskEventDownloadFailed = "DownloadFailed"
 )
 
+const (
+	// taskBaseSize is the approximate memory size of task without pieces and peers.
+	taskBaseSize = 2 * 1024
+
+	// pieceInfoSize is the approximate memory size of piece info.
+	pieceInfoSize = 128
+
+	// peerSize is the approximate memory size of peer.
+	peerSize = 1024
+)
+
 // Option is a functional option for task.
 type Option func(task *Task)
 
@@ -74,6 +88,13 @@ func WithBackToSourceLimit(limit int32) Option {
 	}
 }
 
+// WithEventHub sets the hub which task and its peers publish lifecycle events to.
+func WithEventHub(hub event.Hub) Option {
+	return func(task *Task) {
+		task.EventHub = hub
+	}
+}
+
 type Task struct {
 	// ID is task id.
 	ID string
@@ -108,9 +129,17 @@ type Task struct {
 	// Piece sync map.
 	Pieces *sync.Map
 
+	// PieceCount is the count of pieces in piece sync map,
+	// it is cached to estimate the size of task.
+	PieceCount *atomic.Int32
+
 	// Peer sync map.
 	Peers *sync.Map
 
+	// DAG is directed acyclic graph of peers, the edge
+	// represents child downloads pieces from parent.
+	DAG dag.DAG
+
 	// PeerCount is peer count.
 	PeerCount *atomic.Int32
 
@@ -124,6 +153,18 @@ type Task struct {
 	// UpdateAt is task update time.
 	UpdateAt *atomic.Time
 
+	// AccessCount is the count of task accessed, it is used by lfu eviction.
+	AccessCount *atomic.Int64
+
+	// AccessAt is task access time, it is used by lru eviction.
+	AccessAt *atomic.Time
+
+	// EventHub is the hub of lifecycle events, events are not published if it is nil.
+	EventHub event.Hub
+
+	// Task mutex, it guards edges of DAG.
+	mu *sync.RWMutex
+
 	// Task log.
 	Log *logger.SugaredLoggerOnWith
 }
@@ -140,11 +181,16 @@ func NewTask(id, url string, taskType base.TaskType, meta *base.UrlMeta, options
 		BackToSourceLimit: atomic.NewInt32(0),
 		BackToSourcePeers: set.NewSafeSet(),
 		Pieces:            &sync.Map{},
+		PieceCount:        atomic.NewInt32(0),
 		Peers:             &sync.Map{},
+		DAG:               dag.NewDAG(),
 		PeerCount:         atomic.NewInt32(0),
 		PeerFailedCount:   atomic.NewInt32(0),
 		CreateAt:          atomic.NewTime(time.Now()),
 		UpdateAt:          atomic.NewTime(time.Now()),
+		AccessCount:       atomic.NewInt64(0),
+		AccessAt:          atomic.NewTime(time.Now()),
+		mu:                &sync.RWMutex{},
 		Log:               logger.WithTaskIDAndURL(id, url),
 	}
 
@@ -160,14 +206,17 @@ func NewTask(id, url string, taskType base.TaskType, meta *base.UrlMeta, options
 			TaskEventDownload: func(e *fsm.Event) {
 				t.UpdateAt.Store(time.Now())
 				t.Log.Infof("task state is %s", e.FSM.Current())
+				t.PublishEvent(rpcscheduler.EventType_TASK_STARTED, nil)
 			},
 			TaskEventDownloadSucceeded: func(e *fsm.Event) {
 				t.UpdateAt.Store(time.Now())
 				t.Log.Infof("task state is %s", e.FSM.Current())
+				t.PublishEvent(rpcscheduler.EventType_TASK_SUCCEEDED, nil)
 			},
 			TaskEventDownloadFailed: func(e *fsm.Event) {
 				t.UpdateAt.Store(time.Now())
 				t.Log.Infof("task state is %s", e.FSM.Current())
+				t.PublishEvent(rpcscheduler.EventType_TASK_FAILED, nil)
 			},
 		},
 	)
@@ -179,6 +228,61 @@ func NewTask(id, url string, taskType base.TaskType, meta *base.UrlMeta, options
 	return t
 }
 
+// PublishEvent publishes the lifecycle event of task.
+func (t *Task) PublishEvent(eventType rpcscheduler.EventType, sourceError *errordetails.SourceError) {
+	if t.EventHub == nil {
+		return
+	}
+
+	t.EventHub.Publish(&rpcscheduler.Event{
+		Type:        eventType,
+		TaskId:      t.ID,
+		Url:         t.URL,
+		Tag:         t.URLMeta.GetTag(),
+		Application: t.URLMeta.GetApplication(),
+		State:       t.FSM.Current(),
+		SourceError: sourceError,
+		CreatedAt:   time.Now().UnixNano(),
+	})
+}
+
+// Access records an access of task for eviction.
+func (t *Task) Access() {
+	t.AccessCount.Inc()
+	t.AccessAt.Store(time.Now())
+}
+
+// Size returns the approximate memory size of task,
+// including its pieces, peers and direct piece.
+func (t *Task) Size() int64 {
+	size := int64(taskBaseSize + len(t.ID) + len(t.URL) + len(t.DirectPiece))
+	return size + int64(t.PieceCount.Load())*pieceInfoSize + int64(t.PeerCount.Load())*peerSize
+}
+
+// IsIdle returns whether the task and its peers are not downloading.
+func (t *Task) IsIdle() bool {
+	if t.FSM.Is(TaskStateRunning) {
+		return false
+	}
+
+	idle := true
+	t.Peers.Range(func(_, value any) bool {
+		peer, ok := value.(*Peer)
+		if !ok {
+			return true
+		}
+
+		if !peer.FSM.Is(PeerStateSucceeded) && !peer.FSM.Is(PeerStateFailed) && !peer.FSM.Is(PeerStateLeave) {
+			idle = false
+			return false
+		}
+
+		return true
+	})
+
+	return idle
+}
+
 // LoadPeer return peer for a key.
 func (t *Task) LoadPeer(key string) (*Peer, bool) {
 	rawPeer, ok := t.Peers.Load(key)
@@ -193,6 +297,10 @@ func (t *Task) LoadPeer(key string) (*Peer, bool) {
 func (t *Task) StorePeer(peer *Peer) {
 	t.Peers.Store(peer.ID, peer)
 	t.PeerCount.Inc()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.storePeerVertex(peer)
 }
 
 // LoadOrStorePeer returns peer the key if present.
@@ -202,16 +310,218 @@ func (t *Task) LoadOrStorePeer(peer *Peer) (*Peer, bool) {
 	rawPeer, loaded := t.Peers.LoadOrStore(peer.ID, peer)
 	if !loaded {
 		t.PeerCount.Inc()
+
+		t.mu.Lock()
+		t.storePeerVertex(peer)
+		t.mu.Unlock()
 	}
 
 	return rawPeer.(*Peer), loaded
 }
 
-// DeletePeer deletes peer for a key.
+// DeletePeer deletes peer and its edges for a key.
 func (t *Task) DeletePeer(key string) {
 	if _, loaded := t.Peers.LoadAndDelete(key); loaded {
 		t.PeerCount.Dec()
 	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	vertex, err := t.DAG.GetVertex(key)
+	if err != nil {
+		return
+	}
+
+	t.deletePeerInEdges(vertex)
+	t.deletePeerOutEdges(vertex)
+	t.DAG.DeleteVertex(key)
+}
+
+// AddPeerEdge adds edge between parent and child, the upload load
+// of parent's host is accounted per edge.
+func (t *Task) AddPeerEdge(from *Peer, to *Peer) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.storePeerVertex(from)
+	t.storePeerVertex(to)
+	if err := t.DAG.AddEdge(from.ID, to.ID); err != nil {
+		return err
+	}
+
+	from.ChildCount.Inc()
+	from.Host.UploadPeerCount.Inc()
+	from.Host.UploadPeers.Store(from.ID, from)
+	return nil
+}
+
+// DeletePeerEdge deletes edge between parent and child.
+func (t *Task) DeletePeerEdge(from *Peer, to *Peer) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	fromVertex, err := t.DAG.GetVertex(from.ID)
+	if err != nil {
+		return
+	}
+
+	toVertex, err := t.DAG.GetVertex(to.ID)
+	if err != nil {
+		return
+	}
+
+	t.deletePeerEdge(fromVertex, toVertex)
+}
+
+// DeletePeerInEdges deletes edges between peer and its parents.
+func (t *Task) DeletePeerInEdges(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	vertex, err := t.DAG.GetVertex(key)
+	if err != nil {
+		return
+	}
+
+	t.deletePeerInEdges(vertex)
+}
+
+// DeletePeerOutEdges deletes edges between peer and its children.
+func (t *Task) DeletePeerOutEdges(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	vertex, err := t.DAG.GetVertex(key)
+	if err != nil {
+		return
+	}
+
+	t.deletePeerOutEdges(vertex)
+}
+
+// PeerParents returns parents of peer for a key.
+func (t *Task) PeerParents(key string) []*Peer {
+	vertex, err := t.DAG.GetVertex(key)
+	if err != nil {
+		return []*Peer{}
+	}
+
+	return vertexPeers(vertex.Parents)
+}
+
+// PeerChildren returns children of peer for a key.
+func (t *Task) PeerChildren(key string) []*Peer {
+	vertex, err := t.DAG.GetVertex(key)
+	if err != nil {
+		return []*Peer{}
+	}
+
+	return vertexPeers(vertex.Children)
+}
+
+// storePeerVertex adds vertex of peer to DAG if it does not exist,
+// the stale vertex of the peer with the same id is replaced.
+func (t *Task) storePeerVertex(peer *Peer) {
+	if vertex, err := t.DAG.GetVertex(peer.ID); err == nil {
+		if vertex.Value == peer {
+			return
+		}
+
+		t.deletePeerInEdges(vertex)
+		t.deletePeerOutEdges(vertex)
+		t.DAG.DeleteVertex(peer.ID)
+	}
+
+	if err := t.DAG.AddVertex(peer.ID, peer); err != nil {
+		t.Log.Errorf("add vertex %s failed: %s", peer.ID, err.Error())
+	}
+}
+
+// deletePeerInEdges deletes edges between vertex and its parents.
+func (t *Task) deletePeerInEdges(vertex *dag.Vertex) {
+	for _, value := range vertex.Parents.Values() {
+		if parent, ok := value.(*dag.Vertex); ok {
+			t.deletePeerEdge(parent, vertex)
+		}
+	}
+}
+
+// deletePeerOutEdges deletes edges between vertex and its children.
+func (t *Task) deletePeerOutEdges(vertex *dag.Vertex) {
+	for _, value := range vertex.Children.Values() {
+		if child, ok := value.(*dag.Vertex); ok {
+			t.deletePeerEdge(vertex, child)
+		}
+	}
+}
+
+// deletePeerEdge deletes edge between vertices and releases the upload load
+// of parent's host. If parent is the main parent of child, another parent
+// is promoted to the main parent.
+func (t *Task) deletePeerEdge(fromVertex *dag.Vertex, toVertex *dag.Vertex) {
+	if !toVertex.Parents.Contains(fromVertex) {
+		return
+	}
+
+	if err := t.DAG.DeleteEdge(fromVertex.ID, toVertex.ID); err != nil {
+		t.Log.Errorf("delete edge %s -> %s failed: %s", fromVertex.ID, toVertex.ID, err.Error())
+		return
+	}
+
+	from, ok := fromVertex.Value.(*Peer)
+	if !ok {
+		return
+	}
+	if from.ChildCount.Dec() == 0 {
+		from.Host.UploadPeers.Delete(from.ID)
+	}
+	from.Host.UploadPeerCount.Dec()
+
+	to, ok := toVertex.Value.(*Peer)
+	if !ok {
+		return
+	}
+
+	if mainParent, ok := to.mainParent.Load().(*Peer); !ok || mainParent != from {
+		return
+	}
+
+	// Promote the parent with the most finished pieces.
+	var mainParent *Peer
+	for _, parent := range vertexPeers(toVertex.Parents) {
+		if mainParent == nil || parent.Pieces.Count() > mainParent.Pieces.Count() {
+			mainParent = parent
+		}
+	}
+
+	to.mainParent.Store(mainParent)
+	if mainParent == nil {
+		return
+	}
+
+	to.Log.Infof("main parent %s is deleted, promote parent %s to main parent", from.ID, mainParent.ID)
+}
+
+// vertexPeers returns peers of vertices sorted by id.
+func vertexPeers(vertices set.SafeSet) []*Peer {
+	var peers []*Peer
+	for _, value := range vertices.Values() {
+		vertex, ok := value.(*dag.Vertex)
+		if !ok {
+			continue
+		}
+
+		if peer, ok := vertex.Value.(*Peer); ok {
+			peers = append(peers, peer)
+		}
+	}
+
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].ID < peers[j].ID
+	})
+
+	return peers
 }
 
 // HasAvailablePeer returns whether there is an available peer.
@@ -282,7 +592,12 @@ func (t *Task) LoadPiece(key int32) (*base.PieceInfo, bool) {
 
 // StorePiece set piece.
 func (t *Task) StorePiece(piece *base.PieceInfo) {
-	t.Pieces.Store(piece.PieceNum, piece)
+	if _, loaded := t.Pieces.LoadOrStore(piece.PieceNum, piece); loaded {
+		t.Pieces.Store(piece.PieceNum, piece)
+		return
+	}
+
+	t.PieceCount.Inc()
 }
 
 // LoadOrStorePiece returns piece the key if present.
@@ -290,12 +605,18 @@ func (t *Task) StorePiece(piece *base.PieceInfo) {
 // The loaded result is true if the piece was loaded, false if stored.
 func (t *Task) LoadOrStorePiece(piece *base.PieceInfo) (*base.PieceInfo, bool) {
 	rawPiece, loaded := t.Pieces.LoadOrStore(piece.PieceNum, piece)
+	if !loaded {
+		t.PieceCount.Inc()
+	}
+
 	return rawPiece.(*base.PieceInfo), loaded
 }
 
 // DeletePiece deletes piece for a key.
 func (t *Task) DeletePiece(key int32) {
-	t.Pieces.Delete(key)
+	if _, loaded := t.Pieces.LoadAndDelete(key); loaded {
+		t.PieceCount.Dec()
+	}
 }
 
 // SizeScope return task size scope type.
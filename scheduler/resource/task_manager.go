@@ -19,11 +19,14 @@
 package resource
 
 import (
+	"sort"
 	"sync"
 	"time"
 
+	logger "d7y.io/dragonfly/v2/internal/dflog"
 	pkggc "d7y.io/dragonfly/v2/pkg/gc"
 	"d7y.io/dragonfly/v2/scheduler/config"
+	"d7y.io/dragonfly/v2/scheduler/metrics"
 )
 
 const (
@@ -41,11 +44,16 @@ type TaskManager interface {
 	// LoadOrStore returns task the key if present.
 	// Otherwise, it stores and returns the given task.
 	// The loaded result is true if the task was loaded, false if stored.
+	// The access of task is recorded for eviction.
 	LoadOrStore(*Task) (*Task, bool)
 
 	// Delete deletes task for a key.
 	Delete(string)
 
+	// Range calls f sequentially for each key and task present in the map.
+	// If f returns false, range stops the iteration.
+	Range(f func(any, any) bool)
+
 	// Try to reclaim task.
 	RunGC() error
 }
@@ -56,13 +64,29 @@ type taskManager struct {
 
 	// Task time to live.
 	ttl time.Duration
+
+	// limit is the maximum number of tasks, 0 means no limit.
+	limit int
+
+	// memoryLimit is the maximum approximate memory in bytes of tasks, 0 means no limit.
+	memoryLimit int64
+
+	// evictionPolicy is the policy of picking idle tasks to evict.
+	evictionPolicy string
+
+	// peerManager is used to delete peers of the evicted tasks.
+	peerManager PeerManager
 }
 
 // New task manager interface.
-func newTaskManager(cfg *config.GCConfig, gc pkggc.GC) (TaskManager, error) {
+func newTaskManager(cfg *config.GCConfig, gc pkggc.GC, peerManager PeerManager) (TaskManager, error) {
 	t := &taskManager{
-		Map: &sync.Map{},
-		ttl: cfg.TaskTTL,
+		Map:            &sync.Map{},
+		ttl:            cfg.TaskTTL,
+		limit:          cfg.TaskLimit,
+		memoryLimit:    int64(cfg.TaskMemoryLimit) * 1024 * 1024,
+		evictionPolicy: cfg.TaskEvictionPolicy,
+		peerManager:    peerManager,
 	}
 
 	if err := gc.Add(pkggc.Task{
@@ -92,6 +116,7 @@ func (t *taskManager) Store(task *Task) {
 
 func (t *taskManager) LoadOrStore(task *Task) (*Task, bool) {
 	rawTask, loaded := t.Map.LoadOrStore(task.ID, task)
+	rawTask.(*Task).Access()
 	return rawTask.(*Task), loaded
 }
 
@@ -100,6 +125,11 @@ func (t *taskManager) Delete(key string) {
 }
 
 func (t *taskManager) RunGC() error {
+	var (
+		tasks []*Task
+		count int
+		size  int64
+	)
 	t.Map.Range(func(_, value any) bool {
 		task := value.(*Task)
 		elapsed := time.Since(task.UpdateAt.Load())
@@ -107,10 +137,73 @@ func (t *taskManager) RunGC() error {
 		if elapsed > t.ttl && task.PeerCount.Load() == 0 && !task.FSM.Is(TaskStateRunning) {
 			task.Log.Info("task has been reclaimed")
 			t.Delete(task.ID)
+			return true
+		}
+
+		count++
+		size += task.Size()
+		if task.IsIdle() {
+			tasks = append(tasks, task)
 		}
 
 		return true
 	})
+	metrics.TaskMemoryUsage.Set(float64(size))
+
+	if !t.exceeded(count, size) {
+		return nil
+	}
+
+	// Evict idle tasks by eviction policy until the memory budget is satisfied.
+	sort.Slice(tasks, func(i, j int) bool {
+		if t.evictionPolicy == config.TaskEvictionPolicyLFU {
+			if tasks[i].AccessCount.Load() != tasks[j].AccessCount.Load() {
+				return tasks[i].AccessCount.Load() < tasks[j].AccessCount.Load()
+			}
+		}
+
+		return tasks[i].AccessAt.Load().Before(tasks[j].AccessAt.Load())
+	})
+
+	for _, task := range tasks {
+		if !t.exceeded(count, size) {
+			break
+		}
+
+		taskSize := task.Size()
+		t.evict(task)
+		count--
+		size -= taskSize
+	}
+	metrics.TaskMemoryUsage.Set(float64(size))
+
+	if t.exceeded(count, size) {
+		logger.Warnf("tasks exceed memory budget after eviction, count: %d, size: %d", count, size)
+	}
 
 	return nil
 }
+
+// exceeded returns whether the tasks exceed the memory budget.
+func (t *taskManager) exceeded(count int, size int64) bool {
+	return (t.limit > 0 && count > t.limit) || (t.memoryLimit > 0 && size > t.memoryLimit)
+}
+
+// evict deletes the task and its peers, the task is reconstructed
+// when peers register or announce it again.
+func (t *taskManager) evict(task *Task) {
+	task.Peers.Range(func(_, value any) bool {
+		peer, ok := value.(*Peer)
+		if !ok {
+			return true
+		}
+
+		peer.DeleteParents()
+		t.peerManager.Delete(peer.ID)
+		return true
+	})
+
+	t.Delete(task.ID)
+	metrics.EvictTaskCount.WithLabelValues(t.evictionPolicy).Inc()
+	task.Log.Infof("task has been evicted by %s", t.evictionPolicy)
+}
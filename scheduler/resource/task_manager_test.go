@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"d7y.io/dragonfly/v2/pkg/gc"
+	"d7y.io/dragonfly/v2/pkg/idgen"
 	"d7y.io/dragonfly/v2/pkg/rpc/base"
 	"d7y.io/dragonfly/v2/scheduler/config"
 )
@@ -72,7 +73,7 @@ func TestTaskManager_newTaskManager(t *testing.T) {
 			gc := gc.NewMockGC(ctl)
 			tc.mock(gc.EXPECT())
 
-			taskManager, err := newTaskManager(mockTaskGCConfig, gc)
+			taskManager, err := newTaskManager(mockTaskGCConfig, gc, NewMockPeerManager(ctl))
 			tc.expect(t, taskManager, err)
 		})
 	}
@@ -95,6 +96,7 @@ func TestTaskManager_Load(t *testing.T) {
 				task, ok := taskManager.Load(mockTask.ID)
 				assert.Equal(ok, true)
 				assert.Equal(task.ID, mockTask.ID)
+				assert.Equal(task.AccessCount.Load(), int64(0))
 			},
 		},
 		{
@@ -132,7 +134,7 @@ func TestTaskManager_Load(t *testing.T) {
 			tc.mock(gc.EXPECT())
 
 			mockTask := NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, WithBackToSourceLimit(mockTaskBackToSourceLimit))
-			taskManager, err := newTaskManager(mockTaskGCConfig, gc)
+			taskManager, err := newTaskManager(mockTaskGCConfig, gc, NewMockPeerManager(ctl))
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -185,7 +187,7 @@ func TestTaskManager_Store(t *testing.T) {
 			tc.mock(gc.EXPECT())
 
 			mockTask := NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, WithBackToSourceLimit(mockTaskBackToSourceLimit))
-			taskManager, err := newTaskManager(mockTaskGCConfig, gc)
+			taskManager, err := newTaskManager(mockTaskGCConfig, gc, NewMockPeerManager(ctl))
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -212,6 +214,7 @@ func TestTaskManager_LoadOrStore(t *testing.T) {
 				task, ok := taskManager.LoadOrStore(mockTask)
 				assert.Equal(ok, true)
 				assert.Equal(task.ID, mockTask.ID)
+				assert.Equal(task.AccessCount.Load(), int64(1))
 			},
 		},
 		{
@@ -236,7 +239,7 @@ func TestTaskManager_LoadOrStore(t *testing.T) {
 			tc.mock(gc.EXPECT())
 
 			mockTask := NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, WithBackToSourceLimit(mockTaskBackToSourceLimit))
-			taskManager, err := newTaskManager(mockTaskGCConfig, gc)
+			taskManager, err := newTaskManager(mockTaskGCConfig, gc, NewMockPeerManager(ctl))
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -289,7 +292,7 @@ func TestTaskManager_Delete(t *testing.T) {
 			tc.mock(gc.EXPECT())
 
 			mockTask := NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, WithBackToSourceLimit(mockTaskBackToSourceLimit))
-			taskManager, err := newTaskManager(mockTaskGCConfig, gc)
+			taskManager, err := newTaskManager(mockTaskGCConfig, gc, NewMockPeerManager(ctl))
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -366,7 +369,7 @@ func TestTaskManager_RunGC(t *testing.T) {
 			mockHost := NewHost(mockRawHost)
 			mockTask := NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, WithBackToSourceLimit(mockTaskBackToSourceLimit))
 			mockPeer := NewPeer(mockPeerID, mockTask, mockHost)
-			taskManager, err := newTaskManager(mockTaskGCConfig, gc)
+			taskManager, err := newTaskManager(mockTaskGCConfig, gc, NewMockPeerManager(ctl))
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -375,3 +378,145 @@ func TestTaskManager_RunGC(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskManager_RunGC_Eviction(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *config.GCConfig
+		mock   func(tasks []*Task, peers []*Peer, m *MockPeerManagerMockRecorder)
+		expect func(t *testing.T, taskManager TaskManager, tasks []*Task)
+	}{
+		{
+			name: "evict the least recently used idle task",
+			config: &config.GCConfig{
+				TaskTTL:            time.Hour,
+				TaskLimit:          1,
+				TaskEvictionPolicy: config.TaskEvictionPolicyLRU,
+			},
+			mock: func(tasks []*Task, peers []*Peer, m *MockPeerManagerMockRecorder) {
+				tasks[1].AccessAt.Store(time.Now().Add(time.Minute))
+				m.Delete(gomock.Eq(peers[0].ID)).Return().Times(1)
+			},
+			expect: func(t *testing.T, taskManager TaskManager, tasks []*Task) {
+				assert := assert.New(t)
+				_, ok := taskManager.Load(tasks[0].ID)
+				assert.False(ok)
+				_, ok = taskManager.Load(tasks[1].ID)
+				assert.True(ok)
+			},
+		},
+		{
+			name: "evict the least frequently used idle task",
+			config: &config.GCConfig{
+				TaskTTL:            time.Hour,
+				TaskLimit:          1,
+				TaskEvictionPolicy: config.TaskEvictionPolicyLFU,
+			},
+			mock: func(tasks []*Task, peers []*Peer, m *MockPeerManagerMockRecorder) {
+				tasks[1].AccessAt.Store(time.Now().Add(time.Minute))
+				tasks[0].Access()
+				tasks[0].Access()
+				m.Delete(gomock.Eq(peers[1].ID)).Return().Times(1)
+			},
+			expect: func(t *testing.T, taskManager TaskManager, tasks []*Task) {
+				assert := assert.New(t)
+				_, ok := taskManager.Load(tasks[0].ID)
+				assert.True(ok)
+				_, ok = taskManager.Load(tasks[1].ID)
+				assert.False(ok)
+			},
+		},
+		{
+			name: "evict idle task exceeding memory limit",
+			config: &config.GCConfig{
+				TaskTTL:            time.Hour,
+				TaskMemoryLimit:    1,
+				TaskEvictionPolicy: config.TaskEvictionPolicyLRU,
+			},
+			mock: func(tasks []*Task, peers []*Peer, m *MockPeerManagerMockRecorder) {
+				tasks[0].AccessAt.Store(time.Now().Add(time.Minute))
+				tasks[1].DirectPiece = make([]byte, 1024*1024)
+				m.Delete(gomock.Eq(peers[1].ID)).Return().Times(1)
+			},
+			expect: func(t *testing.T, taskManager TaskManager, tasks []*Task) {
+				assert := assert.New(t)
+				_, ok := taskManager.Load(tasks[0].ID)
+				assert.True(ok)
+				_, ok = taskManager.Load(tasks[1].ID)
+				assert.False(ok)
+			},
+		},
+		{
+			name: "running tasks are not evicted",
+			config: &config.GCConfig{
+				TaskTTL:            time.Hour,
+				TaskLimit:          1,
+				TaskEvictionPolicy: config.TaskEvictionPolicyLRU,
+			},
+			mock: func(tasks []*Task, peers []*Peer, m *MockPeerManagerMockRecorder) {
+				tasks[0].FSM.SetState(TaskStateRunning)
+				peers[1].FSM.SetState(PeerStateRunning)
+			},
+			expect: func(t *testing.T, taskManager TaskManager, tasks []*Task) {
+				assert := assert.New(t)
+				_, ok := taskManager.Load(tasks[0].ID)
+				assert.True(ok)
+				_, ok = taskManager.Load(tasks[1].ID)
+				assert.True(ok)
+			},
+		},
+		{
+			name: "tasks do not exceed memory budget",
+			config: &config.GCConfig{
+				TaskTTL:            time.Hour,
+				TaskLimit:          2,
+				TaskEvictionPolicy: config.TaskEvictionPolicyLRU,
+			},
+			mock: func(tasks []*Task, peers []*Peer, m *MockPeerManagerMockRecorder) {},
+			expect: func(t *testing.T, taskManager TaskManager, tasks []*Task) {
+				assert := assert.New(t)
+				_, ok := taskManager.Load(tasks[0].ID)
+				assert.True(ok)
+				_, ok = taskManager.Load(tasks[1].ID)
+				assert.True(ok)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			gc := gc.NewMockGC(ctl)
+			gc.EXPECT().Add(gomock.Any()).Return(nil).Times(1)
+			peerManager := NewMockPeerManager(ctl)
+
+			mockHost := NewHost(mockRawHost)
+			var (
+				tasks []*Task
+				peers []*Peer
+			)
+			for _, url := range []string{"http://example.com/foo", "http://example.com/bar"} {
+				task := NewTask(idgen.TaskID(url, mockTaskURLMeta), url, base.TaskType_Normal, mockTaskURLMeta)
+				peer := NewPeer(idgen.PeerID("127.0.0.1"), task, mockHost)
+				peer.FSM.SetState(PeerStateSucceeded)
+				task.StorePeer(peer)
+				tasks = append(tasks, task)
+				peers = append(peers, peer)
+			}
+
+			taskManager, err := newTaskManager(tc.config, gc, peerManager)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, task := range tasks {
+				taskManager.Store(task)
+			}
+
+			tc.mock(tasks, peers, peerManager.EXPECT())
+			assert.NoError(t, taskManager.RunGC())
+			tc.expect(t, taskManager, tasks)
+		})
+	}
+}
@@ -876,6 +876,114 @@ func TestTask_CanBackToSource(t *testing.T) {
 	}
 }
 
+func TestTask_Access(t *testing.T) {
+	task := NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+	accessAt := task.AccessAt.Load()
+	task.Access()
+	task.Access()
+
+	assert := assert.New(t)
+	assert.Equal(task.AccessCount.Load(), int64(2))
+	assert.False(task.AccessAt.Load().Before(accessAt))
+}
+
+func TestTask_Size(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect func(t *testing.T, task *Task)
+	}{
+		{
+			name: "size of empty task",
+			expect: func(t *testing.T, task *Task) {
+				assert := assert.New(t)
+				assert.Equal(task.Size(), int64(taskBaseSize+len(task.ID)+len(task.URL)))
+			},
+		},
+		{
+			name: "size of task with pieces, peers and direct piece",
+			expect: func(t *testing.T, task *Task) {
+				assert := assert.New(t)
+				mockHost := NewHost(mockRawHost)
+				task.StorePeer(NewPeer(mockPeerID, task, mockHost))
+				task.StorePiece(&base.PieceInfo{PieceNum: 0})
+				task.StorePiece(&base.PieceInfo{PieceNum: 1})
+				task.DirectPiece = []byte("foo")
+				assert.Equal(task.Size(), int64(taskBaseSize+len(task.ID)+len(task.URL)+3+2*pieceInfoSize+peerSize))
+			},
+		},
+		{
+			name: "size of task with stored and deleted pieces",
+			expect: func(t *testing.T, task *Task) {
+				assert := assert.New(t)
+				task.StorePiece(&base.PieceInfo{PieceNum: 0})
+				task.StorePiece(&base.PieceInfo{PieceNum: 0})
+				task.LoadOrStorePiece(&base.PieceInfo{PieceNum: 0})
+				task.LoadOrStorePiece(&base.PieceInfo{PieceNum: 1})
+				task.StorePiece(&base.PieceInfo{PieceNum: 2})
+				task.DeletePiece(2)
+				task.DeletePiece(3)
+				assert.Equal(task.PieceCount.Load(), int32(2))
+				assert.Equal(task.Size(), int64(taskBaseSize+len(task.ID)+len(task.URL)+2*pieceInfoSize))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expect(t, NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta))
+		})
+	}
+}
+
+func TestTask_IsIdle(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect func(t *testing.T, task *Task, peer *Peer)
+	}{
+		{
+			name: "task without peers is idle",
+			expect: func(t *testing.T, task *Task, peer *Peer) {
+				assert := assert.New(t)
+				assert.True(task.IsIdle())
+			},
+		},
+		{
+			name: "task with succeeded peer is idle",
+			expect: func(t *testing.T, task *Task, peer *Peer) {
+				assert := assert.New(t)
+				peer.FSM.SetState(PeerStateSucceeded)
+				task.StorePeer(peer)
+				assert.True(task.IsIdle())
+			},
+		},
+		{
+			name: "task with running peer is not idle",
+			expect: func(t *testing.T, task *Task, peer *Peer) {
+				assert := assert.New(t)
+				peer.FSM.SetState(PeerStateRunning)
+				task.StorePeer(peer)
+				assert.False(task.IsIdle())
+			},
+		},
+		{
+			name: "task state is TaskStateRunning",
+			expect: func(t *testing.T, task *Task, peer *Peer) {
+				assert := assert.New(t)
+				task.FSM.SetState(TaskStateRunning)
+				assert.False(task.IsIdle())
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockHost := NewHost(mockRawHost)
+			task := NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+			tc.expect(t, task, NewPeer(mockPeerID, task, mockHost))
+		})
+	}
+}
+
 func TestTask_NotifyPeers(t *testing.T) {
 	tests := []struct {
 		name string
@@ -115,3 +115,46 @@ func (s *Server) AnnounceTask(ctx context.Context, req *scheduler.AnnounceTaskRe
 func (s *Server) LeaveTask(ctx context.Context, req *scheduler.PeerTarget) (*empty.Empty, error) {
 	return new(empty.Empty), s.service.LeaveTask(ctx, req)
 }
+
+// SyncProbes reports probe results of the host and receives the hosts to be probed.
+func (s *Server) SyncProbes(ctx context.Context, req *scheduler.SyncProbesRequest) (*scheduler.SyncProbesResponse, error) {
+	metrics.SyncProbesCount.Inc()
+	resp, err := s.service.SyncProbes(ctx, req)
+	if err != nil {
+		metrics.SyncProbesFailureCount.Inc()
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// FindTaskPeers finds the succeeded peers of task for the scheduler of federated cluster.
+func (s *Server) FindTaskPeers(ctx context.Context, req *scheduler.FindTaskPeersRequest) (*scheduler.FindTaskPeersResponse, error) {
+	metrics.FindTaskPeersCount.Inc()
+	resp, err := s.service.FindTaskPeers(ctx, req)
+	if err != nil {
+		metrics.FindTaskPeersFailureCount.Inc()
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// AnnounceHost reports the load of the host.
+func (s *Server) AnnounceHost(ctx context.Context, req *scheduler.AnnounceHostRequest) (*empty.Empty, error) {
+	metrics.AnnounceHostCount.Inc()
+	if err := s.service.AnnounceHost(ctx, req); err != nil {
+		metrics.AnnounceHostFailureCount.Inc()
+		return new(empty.Empty), err
+	}
+
+	return new(empty.Empty), nil
+}
+
+// Watch receives lifecycle events of tasks and peers.
+func (s *Server) Watch(req *scheduler.WatchRequest, stream scheduler.Scheduler_WatchServer) error {
+	metrics.ConcurrentWatchGauge.Inc()
+	defer metrics.ConcurrentWatchGauge.Dec()
+
+	return s.service.Watch(req, stream)
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"path/filepath"
 	"time"
 
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -31,12 +32,17 @@ import (
 	"d7y.io/dragonfly/v2/pkg/gc"
 	rpcmanager "d7y.io/dragonfly/v2/pkg/rpc/manager"
 	managerclient "d7y.io/dragonfly/v2/pkg/rpc/manager/client"
+	"d7y.io/dragonfly/v2/scheduler/admin"
+	"d7y.io/dragonfly/v2/scheduler/cluster"
 	"d7y.io/dragonfly/v2/scheduler/config"
+	"d7y.io/dragonfly/v2/scheduler/event"
+	"d7y.io/dragonfly/v2/scheduler/federation"
 	"d7y.io/dragonfly/v2/scheduler/job"
 	"d7y.io/dragonfly/v2/scheduler/metrics"
 	"d7y.io/dragonfly/v2/scheduler/resource"
 	"d7y.io/dragonfly/v2/scheduler/rpcserver"
 	"d7y.io/dragonfly/v2/scheduler/scheduler"
+	"d7y.io/dragonfly/v2/scheduler/scheduler/evaluator"
 	"d7y.io/dragonfly/v2/scheduler/service"
 	"d7y.io/dragonfly/v2/scheduler/storage"
 )
@@ -57,6 +63,9 @@ type Server struct {
 	// Metrics server.
 	metricsServer *http.Server
 
+	// Admin server.
+	adminServer *http.Server
+
 	// Manager client.
 	managerClient managerclient.Client
 
@@ -68,6 +77,15 @@ type Server struct {
 
 	// GC server.
 	gc gc.GC
+
+	// Resource snapshot.
+	snapshot resource.Snapshot
+
+	// Scheduler cluster.
+	cluster cluster.Cluster
+
+	// Federation of scheduler clusters.
+	federation federation.Federation
 }
 
 func New(ctx context.Context, cfg *config.Config, d dfpath.Dfpath) (*Server, error) {
@@ -124,13 +142,50 @@ func New(ctx context.Context, cfg *config.Config, d dfpath.Dfpath) (*Server, err
 	}
 
 	// Initialize resource.
-	resource, err := resource.New(cfg, s.gc, dynconfig, dialOptions...)
+	res, err := resource.New(cfg, s.gc, dynconfig, resource.WithResourceEventHub(event.New()), resource.WithDialOptions(dialOptions...))
 	if err != nil {
 		return nil, err
 	}
 
+	// Initialize resource snapshot and restore resource from snapshot.
+	if cfg.Snapshot != nil && cfg.Snapshot.Enable {
+		s.snapshot = resource.NewSnapshot(res, filepath.Join(d.DataDir(), resource.DefaultSnapshotFilename), cfg.Snapshot.Interval)
+		if err := s.snapshot.Restore(); err != nil {
+			logger.Warnf("restore snapshot failed: %s", err.Error())
+		}
+	}
+
+	// Initialize scheduler cluster for replicating and handing off tasks.
+	if cfg.Replication != nil && cfg.Replication.Enable {
+		s.cluster, err = cluster.New(cfg, res, dynconfig, dialOptions...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Initialize federation for finding peers in federated scheduler clusters.
+	var serviceOptions []service.Option
+	if cfg.Federation != nil && cfg.Federation.Enable {
+		s.federation, err = federation.New(cfg, dynconfig, dialOptions...)
+		if err != nil {
+			return nil, err
+		}
+		serviceOptions = append(serviceOptions, service.WithFederation(s.federation))
+	}
+
 	// Initialize scheduler.
-	scheduler := scheduler.New(cfg.Scheduler, dynconfig, d.PluginDir())
+	if cfg.Scheduler.ModelFile == "" {
+		cfg.Scheduler.ModelFile = filepath.Join(d.DataDir(), evaluator.DefaultModelFilename)
+	}
+	sched := scheduler.New(cfg.Scheduler, dynconfig, d.PluginDir())
+	if err := s.gc.Add(gc.Task{
+		ID:       scheduler.GCAdmissionID,
+		Interval: scheduler.AdmissionGCInterval,
+		Timeout:  scheduler.AdmissionGCInterval,
+		Runner:   sched,
+	}); err != nil {
+		return nil, err
+	}
 
 	// Initialize Storage.
 	storage, err := storage.New(d.DataDir())
@@ -139,7 +194,7 @@ func New(ctx context.Context, cfg *config.Config, d dfpath.Dfpath) (*Server, err
 	}
 
 	// Initialize scheduler service.
-	service := service.New(cfg, resource, scheduler, dynconfig, storage)
+	service := service.New(cfg, res, sched, dynconfig, storage, serviceOptions...)
 
 	// Initialize grpc service.
 	svr := rpcserver.New(service, serverOptions...)
@@ -147,7 +202,7 @@ func New(ctx context.Context, cfg *config.Config, d dfpath.Dfpath) (*Server, err
 
 	// Initialize job service.
 	if cfg.Job.Enable {
-		s.job, err = job.New(cfg, resource)
+		s.job, err = job.New(cfg, res, sched, job.WithObjectCache(service.ObjectCache()))
 		if err != nil {
 			return nil, err
 		}
@@ -158,6 +213,11 @@ func New(ctx context.Context, cfg *config.Config, d dfpath.Dfpath) (*Server, err
 		s.metricsServer = metrics.New(cfg.Metrics, s.grpcServer)
 	}
 
+	// Initialize admin server.
+	if cfg.Admin != nil && cfg.Admin.Enable {
+		s.adminServer = admin.New(cfg.Admin, res)
+	}
+
 	return s, nil
 }
 
@@ -174,6 +234,18 @@ func (s *Server) Serve() error {
 	s.gc.Serve()
 	logger.Info("gc start successfully")
 
+	// Serve snapshot.
+	if s.snapshot != nil {
+		go s.snapshot.Serve()
+		logger.Info("snapshot start successfully")
+	}
+
+	// Serve scheduler cluster.
+	if s.cluster != nil {
+		go s.cluster.Serve()
+		logger.Info("cluster start successfully")
+	}
+
 	// Serve Job.
 	if s.job != nil {
 		s.job.Serve()
@@ -193,6 +265,19 @@ func (s *Server) Serve() error {
 		}()
 	}
 
+	// Started admin server.
+	if s.adminServer != nil {
+		go func() {
+			logger.Infof("started admin server at %s", s.adminServer.Addr)
+			if err := s.adminServer.ListenAndServe(); err != nil {
+				if err == http.ErrServerClosed {
+					return
+				}
+				logger.Fatalf("admin server closed unexpect: %s", err.Error())
+			}
+		}()
+	}
+
 	if s.managerClient != nil {
 		// scheduler keepalive with manager.
 		go func() {
@@ -241,6 +326,30 @@ func (s *Server) Stop() {
 	s.gc.Stop()
 	logger.Info("gc closed")
 
+	// Stop snapshot.
+	if s.snapshot != nil {
+		s.snapshot.Stop()
+		logger.Info("snapshot closed")
+	}
+
+	// Stop scheduler cluster.
+	if s.cluster != nil {
+		s.cluster.Stop()
+		logger.Info("cluster closed")
+	}
+
+	// Stop job.
+	if s.job != nil {
+		s.job.Stop()
+		logger.Info("job closed")
+	}
+
+	// Stop federation.
+	if s.federation != nil {
+		s.federation.Stop()
+		logger.Info("federation closed")
+	}
+
 	// Stop metrics server.
 	if s.metricsServer != nil {
 		if err := s.metricsServer.Shutdown(context.Background()); err != nil {
@@ -249,6 +358,14 @@ func (s *Server) Stop() {
 		logger.Info("metrics server closed under request")
 	}
 
+	// Stop admin server.
+	if s.adminServer != nil {
+		if err := s.adminServer.Shutdown(context.Background()); err != nil {
+			logger.Errorf("admin server failed to stop: %s", err.Error())
+		}
+		logger.Info("admin server closed under request")
+	}
+
 	// Stop GRPC server.
 	stopped := make(chan struct{})
 	go func() {
@@ -0,0 +1,226 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scheduler
+
+import (
+	"net/url"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	"d7y.io/dragonfly/v2/scheduler/resource"
+)
+
+const (
+	// originTTL is the time to live of idle origin host, the limiter of idle
+	// origin host is full after a second, so it is reclaimed without effect.
+	originTTL = 1 * time.Minute
+)
+
+// admission controls the back-to-source downloads of origin hosts
+// in the scheduler, it prevents peers of many tasks from hammering
+// the same origin at the same time. High priority peers go back-to-source
+// first, other peers are not admitted while high priority peers are waiting.
+type admission struct {
+	// origins is the map of origin host and its back-to-source state.
+	origins map[string]*origin
+
+	// mu guards origins.
+	mu sync.Mutex
+}
+
+// origin is the back-to-source state of origin host.
+type origin struct {
+	// peers is the admitted back-to-source peers of origin host.
+	peers map[string]*resource.Peer
+
+	// waiting is the high priority peers waiting for admission of origin host.
+	waiting map[string]*resource.Peer
+
+	// limiter limits the content length of tasks
+	// admitted to back-to-source per second.
+	limiter *rate.Limiter
+
+	// updatedAt is the time of the latest admitting of origin host.
+	updatedAt time.Time
+}
+
+// newAdmission returns a new admission.
+func newAdmission() *admission {
+	return &admission{
+		origins: map[string]*origin{},
+	}
+}
+
+// Admit determines whether peer can download back-to-source from the origin host of task.
+// concurrentLimit limits the number of concurrent back-to-source peers of origin host,
+// and rateLimit limits the bytes per second admitted to back-to-source of origin host,
+// zero value means no limit.
+func (a *admission) Admit(peer *resource.Peer, concurrentLimit uint32, rateLimit uint64) bool {
+	host, ok := originHost(peer.Task.URL)
+	if !ok {
+		return true
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	o, ok := a.origins[host]
+	if !ok {
+		o = &origin{peers: map[string]*resource.Peer{}, waiting: map[string]*resource.Peer{}}
+		a.origins[host] = o
+	}
+	o.prune()
+	o.updatedAt = time.Now()
+
+	if _, ok := o.peers[peer.ID]; ok {
+		return true
+	}
+
+	// High priority peer waits in the queue when it is not admitted.
+	highPriority := peer.Priority == base.Priority_HIGH_PRIORITY
+	reject := func() bool {
+		if highPriority {
+			o.waiting[peer.ID] = peer
+		}
+
+		return false
+	}
+
+	if !highPriority && len(o.waiting) > 0 {
+		peer.Log.Infof("origin %s has %d high priority peers waiting for back-to-source", host, len(o.waiting))
+		return false
+	}
+
+	if concurrentLimit > 0 && len(o.peers) >= int(concurrentLimit) {
+		peer.Log.Infof("origin %s has %d back-to-source peers, exceeds the limit %d", host, len(o.peers), concurrentLimit)
+		return reject()
+	}
+
+	if rateLimit == 0 {
+		o.limiter = nil
+	} else {
+		burst := int(rateLimit)
+		if o.limiter == nil {
+			o.limiter = rate.NewLimiter(rate.Limit(rateLimit), burst)
+		} else if o.limiter.Burst() != burst {
+			now := time.Now()
+			o.limiter.SetLimitAt(now, rate.Limit(rateLimit))
+			o.limiter.SetBurstAt(now, burst)
+		}
+
+		// Content length of task may be unknown before the first peer downloads back-to-source,
+		// and the task larger than burst consumes all tokens.
+		if contentLength := peer.Task.ContentLength.Load(); contentLength > 0 {
+			n := burst
+			if contentLength < int64(burst) {
+				n = int(contentLength)
+			}
+
+			if !o.limiter.AllowN(time.Now(), n) {
+				peer.Log.Infof("origin %s exceeds the back-to-source rate limit %d bytes/s", host, rateLimit)
+				return reject()
+			}
+		}
+	}
+
+	delete(o.waiting, peer.ID)
+	o.peers[peer.ID] = peer
+	return true
+}
+
+// Release releases the admission of peer, it is used when peer fails to download back-to-source
+// before its state becomes PeerStateBackToSource.
+func (a *admission) Release(peer *resource.Peer) {
+	host, ok := originHost(peer.Task.URL)
+	if !ok {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	o, ok := a.origins[host]
+	if !ok {
+		return
+	}
+
+	delete(o.peers, peer.ID)
+	delete(o.waiting, peer.ID)
+	if len(o.peers) == 0 && len(o.waiting) == 0 && o.limiter == nil {
+		delete(a.origins, host)
+	}
+}
+
+// RunGC reclaims the origin hosts which have no back-to-source
+// and waiting peers, and have not admitted peers for originTTL.
+func (a *admission) RunGC() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for host, o := range a.origins {
+		o.prune()
+		if len(o.peers) == 0 && len(o.waiting) == 0 && time.Since(o.updatedAt) > originTTL {
+			delete(a.origins, host)
+		}
+	}
+
+	return nil
+}
+
+// Len returns the number of admitted back-to-source peers of origin host.
+func (a *admission) Len(host string) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	o, ok := a.origins[host]
+	if !ok {
+		return 0
+	}
+
+	o.prune()
+	return len(o.peers)
+}
+
+// prune deletes peers that have finished downloading back-to-source,
+// admitted peers are in PeerStateRunning until they are notified to back-to-source,
+// and deletes waiting peers that are no longer in PeerStateRunning.
+func (o *origin) prune() {
+	for id, peer := range o.peers {
+		if !peer.FSM.Is(resource.PeerStateRunning) && !peer.FSM.Is(resource.PeerStateBackToSource) {
+			delete(o.peers, id)
+		}
+	}
+
+	for id, peer := range o.waiting {
+		if !peer.FSM.Is(resource.PeerStateRunning) {
+			delete(o.waiting, id)
+		}
+	}
+}
+
+// originHost returns the origin host of url.
+func originHost(rawURL string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+
+	return u.Host, true
+}
@@ -0,0 +1,277 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"d7y.io/dragonfly/v2/pkg/idgen"
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	"d7y.io/dragonfly/v2/scheduler/resource"
+)
+
+func TestAdmission_Admit(t *testing.T) {
+	tests := []struct {
+		name   string
+		url    string
+		expect func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer)
+	}{
+		{
+			name: "origin has no limit",
+			url:  mockTaskURL,
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(a.Admit(peer, 0, 0))
+				assert.True(a.Admit(mockPeer, 0, 0))
+				assert.Equal(a.Len("example.com"), 2)
+			},
+		},
+		{
+			name: "url has no host",
+			url:  "foo",
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(a.Admit(peer, 1, 0))
+				assert.True(a.Admit(mockPeer, 1, 0))
+				assert.Equal(a.Len(""), 0)
+			},
+		},
+		{
+			name: "origin exceeds concurrent limit",
+			url:  mockTaskURL,
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(a.Admit(peer, 1, 0))
+				assert.True(a.Admit(peer, 1, 0))
+				assert.False(a.Admit(mockPeer, 1, 0))
+				assert.Equal(a.Len("example.com"), 1)
+			},
+		},
+		{
+			name: "admitted peer finishes downloading back-to-source",
+			url:  mockTaskURL,
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(a.Admit(peer, 1, 0))
+				peer.FSM.SetState(resource.PeerStateBackToSource)
+				assert.False(a.Admit(mockPeer, 1, 0))
+
+				peer.FSM.SetState(resource.PeerStateSucceeded)
+				assert.True(a.Admit(mockPeer, 1, 0))
+				assert.Equal(a.Len("example.com"), 1)
+			},
+		},
+		{
+			name: "origin exceeds rate limit",
+			url:  mockTaskURL,
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				peer.Task.ContentLength.Store(1024 * 1024)
+				assert.True(a.Admit(peer, 0, 1024))
+				assert.False(a.Admit(mockPeer, 0, 1024))
+				assert.Equal(a.Len("example.com"), 1)
+			},
+		},
+		{
+			name: "content length of task is unknown",
+			url:  mockTaskURL,
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(a.Admit(peer, 0, 1024))
+				assert.True(a.Admit(mockPeer, 0, 1024))
+				assert.Equal(a.Len("example.com"), 2)
+			},
+		},
+		{
+			name: "high priority peer goes back-to-source first",
+			url:  mockTaskURL,
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(a.Admit(peer, 1, 0))
+
+				mockPeer.Priority = base.Priority_HIGH_PRIORITY
+				assert.False(a.Admit(mockPeer, 1, 0))
+
+				peer.FSM.SetState(resource.PeerStateSucceeded)
+				lowPeer := resource.NewPeer(idgen.PeerID("127.0.0.3"), peer.Task, peer.Host)
+				lowPeer.FSM.SetState(resource.PeerStateRunning)
+				assert.False(a.Admit(lowPeer, 1, 0))
+				assert.True(a.Admit(mockPeer, 1, 0))
+
+				mockPeer.FSM.SetState(resource.PeerStateSucceeded)
+				assert.True(a.Admit(lowPeer, 1, 0))
+				assert.Equal(a.Len("example.com"), 1)
+			},
+		},
+		{
+			name: "waiting high priority peer leaves",
+			url:  mockTaskURL,
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				peer.Priority = base.Priority_HIGH_PRIORITY
+				mockPeer.Priority = base.Priority_HIGH_PRIORITY
+				assert.True(a.Admit(peer, 1, 0))
+				assert.False(a.Admit(mockPeer, 1, 0))
+
+				mockPeer.FSM.SetState(resource.PeerStateFailed)
+				peer.FSM.SetState(resource.PeerStateSucceeded)
+				lowPeer := resource.NewPeer(idgen.PeerID("127.0.0.3"), peer.Task, peer.Host)
+				lowPeer.FSM.SetState(resource.PeerStateRunning)
+				assert.True(a.Admit(lowPeer, 1, 0))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockHost := resource.NewHost(mockRawHost)
+			mockTask := resource.NewTask(mockTaskID, tc.url, base.TaskType_Normal, mockTaskURLMeta)
+			peer := resource.NewPeer(mockPeerID, mockTask, mockHost)
+			peer.FSM.SetState(resource.PeerStateRunning)
+			mockPeer := resource.NewPeer(idgen.PeerID("127.0.0.2"), mockTask, mockHost)
+			mockPeer.FSM.SetState(resource.PeerStateRunning)
+			tc.expect(t, newAdmission(), peer, mockPeer)
+		})
+	}
+}
+
+func TestAdmission_Release(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer)
+	}{
+		{
+			name: "release admitted peer",
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(a.Admit(peer, 1, 0))
+				assert.False(a.Admit(mockPeer, 1, 0))
+
+				a.Release(peer)
+				assert.Equal(a.Len("example.com"), 0)
+				assert.True(a.Admit(mockPeer, 1, 0))
+			},
+		},
+		{
+			name: "release peer that is not admitted",
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				a.Release(peer)
+				assert.Equal(a.Len("example.com"), 0)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockHost := resource.NewHost(mockRawHost)
+			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+			peer := resource.NewPeer(mockPeerID, mockTask, mockHost)
+			peer.FSM.SetState(resource.PeerStateRunning)
+			mockPeer := resource.NewPeer(idgen.PeerID("127.0.0.2"), mockTask, mockHost)
+			mockPeer.FSM.SetState(resource.PeerStateRunning)
+			tc.expect(t, newAdmission(), peer, mockPeer)
+		})
+	}
+}
+
+func TestAdmission_RunGC(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer)
+	}{
+		{
+			name: "idle origin is reclaimed",
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(a.Admit(peer, 1, 1024))
+				peer.FSM.SetState(resource.PeerStateSucceeded)
+				a.origins["example.com"].updatedAt = time.Now().Add(-originTTL - time.Second)
+
+				assert.NoError(a.RunGC())
+				assert.Empty(a.origins)
+			},
+		},
+		{
+			name: "origin admitted recently is kept",
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(a.Admit(peer, 1, 1024))
+				peer.FSM.SetState(resource.PeerStateSucceeded)
+
+				assert.NoError(a.RunGC())
+				assert.Len(a.origins, 1)
+				assert.NotNil(a.origins["example.com"].limiter)
+			},
+		},
+		{
+			name: "origin with back-to-source peer is kept",
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(a.Admit(peer, 1, 0))
+				peer.FSM.SetState(resource.PeerStateBackToSource)
+				a.origins["example.com"].updatedAt = time.Now().Add(-originTTL - time.Second)
+
+				assert.NoError(a.RunGC())
+				assert.Equal(a.Len("example.com"), 1)
+			},
+		},
+		{
+			name: "origin with waiting peer is kept",
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				mockPeer.Priority = base.Priority_HIGH_PRIORITY
+				assert.True(a.Admit(peer, 1, 0))
+				assert.False(a.Admit(mockPeer, 1, 0))
+				a.origins["example.com"].updatedAt = time.Now().Add(-originTTL - time.Second)
+
+				assert.NoError(a.RunGC())
+				assert.Len(a.origins["example.com"].waiting, 1)
+			},
+		},
+		{
+			name: "origin with left waiting peer is reclaimed",
+			expect: func(t *testing.T, a *admission, peer *resource.Peer, mockPeer *resource.Peer) {
+				assert := assert.New(t)
+				mockPeer.Priority = base.Priority_HIGH_PRIORITY
+				assert.True(a.Admit(peer, 1, 0))
+				assert.False(a.Admit(mockPeer, 1, 0))
+				peer.FSM.SetState(resource.PeerStateFailed)
+				mockPeer.FSM.SetState(resource.PeerStateLeave)
+				a.origins["example.com"].updatedAt = time.Now().Add(-originTTL - time.Second)
+
+				assert.NoError(a.RunGC())
+				assert.Empty(a.origins)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockHost := resource.NewHost(mockRawHost)
+			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+			peer := resource.NewPeer(mockPeerID, mockTask, mockHost)
+			peer.FSM.SetState(resource.PeerStateRunning)
+			mockPeer := resource.NewPeer(idgen.PeerID("127.0.0.2"), mockTask, mockHost)
+			mockPeer.FSM.SetState(resource.PeerStateRunning)
+			tc.expect(t, newAdmission(), peer, mockPeer)
+		})
+	}
+}
@@ -17,6 +17,10 @@
 package evaluator
 
 import (
+	"sort"
+	"time"
+
+	logger "d7y.io/dragonfly/v2/internal/dflog"
 	"d7y.io/dragonfly/v2/scheduler/resource"
 )
 
@@ -29,6 +33,9 @@ const (
 
 	// PluginAlgorithm is a scheduling algorithm based on plugin extension.
 	PluginAlgorithm = "plugin"
+
+	// RemoteAlgorithm is a scheduling algorithm served by external grpc service.
+	RemoteAlgorithm = "remote"
 )
 
 type Evaluator interface {
@@ -39,14 +46,92 @@ type Evaluator interface {
 	IsBadNode(peer *resource.Peer) bool
 }
 
-func New(algorithm string, pluginDir string) Evaluator {
+// BatchEvaluator is implemented by evaluators which score
+// all candidate parents of child in one call.
+type BatchEvaluator interface {
+	// EvaluateParents sorts parents by evaluation score in descending order,
+	// and removes the parents which are bad nodes.
+	EvaluateParents(parents []*resource.Peer, child *resource.Peer, taskPieceCount int32) []*resource.Peer
+}
+
+// EvaluateParents sorts parents by evaluation score in descending order,
+// the parents are scored in one call if evaluator is a BatchEvaluator.
+func EvaluateParents(e Evaluator, parents []*resource.Peer, child *resource.Peer, taskPieceCount int32) []*resource.Peer {
+	if be, ok := e.(BatchEvaluator); ok {
+		return be.EvaluateParents(parents, child, taskPieceCount)
+	}
+
+	sort.Slice(
+		parents,
+		func(i, j int) bool {
+			return e.Evaluate(parents[i], child, taskPieceCount) > e.Evaluate(parents[j], child, taskPieceCount)
+		},
+	)
+
+	return parents
+}
+
+// options is the options of evaluator.
+type options struct {
+	modelFile     string
+	remoteAddr    string
+	remoteTimeout time.Duration
+}
+
+// Option is a functional option for configuring the evaluator.
+type Option func(o *options)
+
+// WithModelFile sets the model file of machine learning algorithm.
+func WithModelFile(modelFile string) Option {
+	return func(o *options) {
+		o.modelFile = modelFile
+	}
+}
+
+// WithRemoteAddr sets the address of evaluator grpc service used by remote algorithm.
+func WithRemoteAddr(addr string) Option {
+	return func(o *options) {
+		o.remoteAddr = addr
+	}
+}
+
+// WithRemoteTimeout sets the timeout of evaluating by remote algorithm.
+func WithRemoteTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.remoteTimeout = timeout
+	}
+}
+
+func New(algorithm string, pluginDir string, opts ...Option) Evaluator {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	switch algorithm {
 	case PluginAlgorithm:
 		if plugin, err := LoadPlugin(pluginDir); err == nil {
 			return plugin
 		}
-	// TODO Implement MLAlgorithm.
-	case MLAlgorithm, DefaultAlgorithm:
+	case MLAlgorithm:
+		// If the model is missing or corrupt, fall back to the rule-based algorithm.
+		ml, err := NewEvaluatorML(o.modelFile)
+		if err != nil {
+			logger.Warnf("load machine learning model %s failed, fall back to default algorithm: %s", o.modelFile, err.Error())
+			return NewEvaluatorBase()
+		}
+
+		return ml
+	case RemoteAlgorithm:
+		// If the evaluator service can not be dialed, fall back to the rule-based algorithm.
+		remote, err := NewEvaluatorRemote(o.remoteAddr, o.remoteTimeout)
+		if err != nil {
+			logger.Warnf("dial evaluator service %s failed, fall back to default algorithm: %s", o.remoteAddr, err.Error())
+			return NewEvaluatorBase()
+		}
+
+		return remote
+	case DefaultAlgorithm:
 		return NewEvaluatorBase()
 	}
 
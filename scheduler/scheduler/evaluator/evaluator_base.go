@@ -19,8 +19,10 @@ package evaluator
 import (
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/montanaflynn/stats"
+	"go.uber.org/atomic"
 
 	logger "d7y.io/dragonfly/v2/internal/dflog"
 	"d7y.io/dragonfly/v2/pkg/math"
@@ -29,22 +31,33 @@ import (
 
 const (
 	// Finished piece weight.
-	finishedPieceWeight float64 = 0.3
+	finishedPieceWeight float64 = 0.2
 
 	// Free load weight.
-	freeLoadWeight = 0.2
+	freeLoadWeight = 0.15
 
 	// Host type affinity weight.
-	hostTypeAffinityWeight = 0.2
+	hostTypeAffinityWeight = 0.15
 
 	// IDC affinity weight.
-	idcAffinityWeight = 0.15
+	idcAffinityWeight = 0.1
 
 	// NetTopology affinity weight.
 	netTopologyAffinityWeight = 0.1
 
 	// Location affinity weight.
 	locationAffinityWeight = 0.05
+
+	// Network distance weight.
+	networkDistanceWeight = 0.15
+
+	// Bandwidth weight.
+	bandwidthWeight = 0.1
+)
+
+const (
+	// Round-trip time that halves the network distance score.
+	networkDistanceRTT = 10 * time.Millisecond
 )
 
 const (
@@ -68,10 +81,25 @@ const (
 	maxElementLen = 5
 )
 
-type evaluatorBase struct{}
+type evaluatorBase struct {
+	// profile is the weight profile in use.
+	profile *atomic.Value
+}
 
 func NewEvaluatorBase() Evaluator {
-	return &evaluatorBase{}
+	profile := &atomic.Value{}
+	profile.Store(DefaultProfile)
+	return &evaluatorBase{profile: profile}
+}
+
+// Profile returns the weight profile in use.
+func (eb *evaluatorBase) Profile() Profile {
+	return eb.profile.Load().(Profile)
+}
+
+// SetProfile swaps the weight profile.
+func (eb *evaluatorBase) SetProfile(profile Profile) {
+	eb.profile.Store(profile)
 }
 
 // The larger the value after evaluation, the higher the priority.
@@ -84,12 +112,24 @@ func (eb *evaluatorBase) Evaluate(parent *resource.Peer, child *resource.Peer, t
 		return minScore
 	}
 
-	return finishedPieceWeight*calculatePieceScore(parent, child, totalPieceCount) +
-		freeLoadWeight*calculateFreeLoadScore(parent.Host) +
-		hostTypeAffinityWeight*calculateHostTypeAffinityScore(parent) +
-		idcAffinityWeight*calculateIDCAffinityScore(parent.Host, child.Host) +
-		netTopologyAffinityWeight*calculateMultiElementAffinityScore(parent.Host.NetTopology, child.Host.NetTopology) +
-		locationAffinityWeight*calculateMultiElementAffinityScore(parent.Host.Location, child.Host.Location)
+	profile := eb.Profile()
+	score := profile.FinishedPieceWeight*calculatePieceScore(parent, child, totalPieceCount) +
+		profile.FreeLoadWeight*calculateFreeLoadScore(parent.Host) +
+		profile.HostTypeAffinityWeight*calculateHostTypeAffinityScore(parent) +
+		profile.IDCAffinityWeight*calculateIDCAffinityScore(parent.Host, child.Host) +
+		profile.NetTopologyAffinityWeight*calculateMultiElementAffinityScore(parent.Host.NetTopology, child.Host.NetTopology) +
+		profile.LocationAffinityWeight*calculateMultiElementAffinityScore(parent.Host.Location, child.Host.Location) +
+		profile.NetworkDistanceWeight*calculateNetworkDistanceScore(parent.Host, child.Host) +
+		profile.BandwidthWeight*calculateBandwidthScore(parent.Host)
+
+	// Add the scores of custom rules matched by parent.
+	for _, rule := range profile.Rules {
+		if rule.Match(parent) {
+			score += rule.Score
+		}
+	}
+
+	return score
 }
 
 // calculatePieceScore 0.0~unlimited larger and better.
@@ -176,7 +216,44 @@ func calculateMultiElementAffinityScore(dst, src string) float64 {
 	return float64(score) / float64(maxElementLen)
 }
 
+// calculateNetworkDistanceScore 0.0~1.0 larger and better.
+func calculateNetworkDistanceScore(dst, src *resource.Host) float64 {
+	if dst.ID == src.ID {
+		return maxScore
+	}
+
+	// Probe results are stored by both hosts, but one of them may be evicted.
+	probe, ok := src.Probes.Load(dst.ID)
+	if !ok {
+		if probe, ok = dst.Probes.Load(src.ID); !ok {
+			return minScore
+		}
+	}
+
+	return (maxScore - probe.Loss) * float64(networkDistanceRTT) / float64(networkDistanceRTT+probe.RTT)
+}
+
+// calculateBandwidthScore 0.0~1.0 larger and better.
+func calculateBandwidthScore(host *resource.Host) float64 {
+	stat, ok := host.Stats.Average()
+	if !ok || stat.UploadBandwidthLimit == 0 {
+		// The upload bandwidth of host is unknown.
+		return maxScore * 0.5
+	}
+
+	if stat.TxBandwidth >= stat.UploadBandwidthLimit {
+		return minScore
+	}
+
+	return float64(stat.UploadBandwidthLimit-stat.TxBandwidth) / float64(stat.UploadBandwidthLimit)
+}
+
 func (eb *evaluatorBase) IsBadNode(peer *resource.Peer) bool {
+	if peer.Quarantined.Load() {
+		peer.Log.Debug("peer is bad node because peer is quarantined")
+		return true
+	}
+
 	if peer.FSM.Is(resource.PeerStateFailed) || peer.FSM.Is(resource.PeerStateLeave) || peer.FSM.Is(resource.PeerStatePending) ||
 		peer.FSM.Is(resource.PeerStateReceivedTiny) || peer.FSM.Is(resource.PeerStateReceivedSmall) || peer.FSM.Is(resource.PeerStateReceivedNormal) {
 		peer.Log.Debugf("peer is bad node because peer status is %s", peer.FSM.Current())
@@ -19,6 +19,7 @@ package evaluator
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -116,7 +117,7 @@ func TestEvaluatorBase_Evaluate(t *testing.T) {
 			},
 			expect: func(t *testing.T, score float64) {
 				assert := assert.New(t)
-				assert.Equal(score, float64(0.9))
+				assert.InDelta(score, 0.875, 0.0001)
 			},
 		},
 		{
@@ -131,7 +132,7 @@ func TestEvaluatorBase_Evaluate(t *testing.T) {
 			},
 			expect: func(t *testing.T, score float64) {
 				assert := assert.New(t)
-				assert.Equal(score, float64(0.9))
+				assert.InDelta(score, 0.875, 0.0001)
 			},
 		},
 		{
@@ -146,7 +147,7 @@ func TestEvaluatorBase_Evaluate(t *testing.T) {
 			},
 			expect: func(t *testing.T, score float64) {
 				assert := assert.New(t)
-				assert.Equal(score, float64(0.9))
+				assert.InDelta(score, 0.875, 0.0001)
 			},
 		},
 	}
@@ -160,6 +161,194 @@ func TestEvaluatorBase_Evaluate(t *testing.T) {
 	}
 }
 
+func TestEvaluatorBase_EvaluateNetworkDistance(t *testing.T) {
+	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+
+	tests := []struct {
+		name   string
+		mock   func(child *resource.Host, nearParent *resource.Host, farParent *resource.Host)
+		expect func(t *testing.T, nearScore float64, farScore float64)
+	}{
+		{
+			name: "parents have not been probed",
+			mock: func(child *resource.Host, nearParent *resource.Host, farParent *resource.Host) {},
+			expect: func(t *testing.T, nearScore float64, farScore float64) {
+				assert := assert.New(t)
+				assert.Equal(nearScore, farScore)
+			},
+		},
+		{
+			name: "parent with lower rtt ranks higher",
+			mock: func(child *resource.Host, nearParent *resource.Host, farParent *resource.Host) {
+				child.Probes.Store(nearParent.ID, time.Millisecond, 0)
+				child.Probes.Store(farParent.ID, 100*time.Millisecond, 0)
+			},
+			expect: func(t *testing.T, nearScore float64, farScore float64) {
+				assert := assert.New(t)
+				assert.Greater(nearScore, farScore)
+			},
+		},
+		{
+			name: "parent with lower loss ranks higher",
+			mock: func(child *resource.Host, nearParent *resource.Host, farParent *resource.Host) {
+				child.Probes.Store(nearParent.ID, 10*time.Millisecond, 0)
+				farParent.Probes.Store(child.ID, 10*time.Millisecond, 0.5)
+			},
+			expect: func(t *testing.T, nearScore float64, farScore float64) {
+				assert := assert.New(t)
+				assert.Greater(nearScore, farScore)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			childHost := resource.NewHost(mockRawHost)
+			nearParentHost := resource.NewHost(mockRawHost)
+			nearParentHost.ID = idgen.HostID("foo", 8003)
+			farParentHost := resource.NewHost(mockRawHost)
+			farParentHost.ID = idgen.HostID("bar", 8003)
+			tc.mock(childHost, nearParentHost, farParentHost)
+
+			child := resource.NewPeer(idgen.PeerID("127.0.0.1"), mockTask, childHost)
+			nearParent := resource.NewPeer(idgen.PeerID("127.0.0.2"), mockTask, nearParentHost)
+			farParent := resource.NewPeer(idgen.PeerID("127.0.0.3"), mockTask, farParentHost)
+			nearParent.Pieces.Set(0)
+			farParent.Pieces.Set(0)
+
+			eb := NewEvaluatorBase()
+			tc.expect(t, eb.Evaluate(nearParent, child, 1), eb.Evaluate(farParent, child, 1))
+		})
+	}
+}
+
+func TestEvaluatorBase_EvaluateBandwidth(t *testing.T) {
+	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+
+	tests := []struct {
+		name   string
+		mock   func(idleParent *resource.Host, busyParent *resource.Host)
+		expect func(t *testing.T, idleScore float64, busyScore float64)
+	}{
+		{
+			name: "parents have not announced stats",
+			mock: func(idleParent *resource.Host, busyParent *resource.Host) {},
+			expect: func(t *testing.T, idleScore float64, busyScore float64) {
+				assert := assert.New(t)
+				assert.Equal(idleScore, busyScore)
+			},
+		},
+		{
+			name: "parent with more free upload bandwidth ranks higher",
+			mock: func(idleParent *resource.Host, busyParent *resource.Host) {
+				idleParent.Stats.Store(resource.HostStat{TxBandwidth: 10, UploadBandwidthLimit: 100})
+				busyParent.Stats.Store(resource.HostStat{TxBandwidth: 90, UploadBandwidthLimit: 100})
+			},
+			expect: func(t *testing.T, idleScore float64, busyScore float64) {
+				assert := assert.New(t)
+				assert.Greater(idleScore, busyScore)
+			},
+		},
+		{
+			name: "parent with exhausted upload bandwidth ranks lower than unknown",
+			mock: func(idleParent *resource.Host, busyParent *resource.Host) {
+				busyParent.Stats.Store(resource.HostStat{TxBandwidth: 100, UploadBandwidthLimit: 100})
+			},
+			expect: func(t *testing.T, idleScore float64, busyScore float64) {
+				assert := assert.New(t)
+				assert.Greater(idleScore, busyScore)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			childHost := resource.NewHost(mockRawHost)
+			idleParentHost := resource.NewHost(mockRawHost)
+			idleParentHost.ID = idgen.HostID("foo", 8003)
+			busyParentHost := resource.NewHost(mockRawHost)
+			busyParentHost.ID = idgen.HostID("bar", 8003)
+			tc.mock(idleParentHost, busyParentHost)
+
+			child := resource.NewPeer(idgen.PeerID("127.0.0.1"), mockTask, childHost)
+			idleParent := resource.NewPeer(idgen.PeerID("127.0.0.2"), mockTask, idleParentHost)
+			busyParent := resource.NewPeer(idgen.PeerID("127.0.0.3"), mockTask, busyParentHost)
+			idleParent.Pieces.Set(0)
+			busyParent.Pieces.Set(0)
+
+			eb := NewEvaluatorBase()
+			tc.expect(t, eb.Evaluate(idleParent, child, 1), eb.Evaluate(busyParent, child, 1))
+		})
+	}
+}
+
+func TestEvaluatorBase_SetProfile(t *testing.T) {
+	mockHost := resource.NewHost(mockRawHost)
+	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+
+	tests := []struct {
+		name    string
+		profile Profile
+		expect  func(t *testing.T, profile Profile, score float64)
+	}{
+		{
+			name:    "evaluate with default profile",
+			profile: DefaultProfile,
+			expect: func(t *testing.T, profile Profile, score float64) {
+				assert := assert.New(t)
+				assert.Equal(profile.Name, DefaultProfileName)
+				assert.InDelta(score, 0.875, 0.0001)
+			},
+		},
+		{
+			name: "evaluate with custom profile",
+			profile: Profile{
+				Name:                "foo",
+				FinishedPieceWeight: 1,
+			},
+			expect: func(t *testing.T, profile Profile, score float64) {
+				assert := assert.New(t)
+				assert.Equal(profile.Name, "foo")
+				assert.InDelta(score, 1, 0.0001)
+			},
+		},
+		{
+			name: "evaluate with custom rules",
+			profile: Profile{
+				Name:                "foo",
+				FinishedPieceWeight: 1,
+				Rules: []Rule{
+					{
+						HostType: "normal",
+						Score:    0.5,
+					},
+					{
+						IDC:   "bar",
+						Score: -0.5,
+					},
+				},
+			},
+			expect: func(t *testing.T, profile Profile, score float64) {
+				assert := assert.New(t)
+				assert.Equal(len(profile.Rules), 2)
+				assert.InDelta(score, 1.5, 0.0001)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			parent := resource.NewPeer(idgen.PeerID("127.0.0.1"), mockTask, mockHost)
+			child := resource.NewPeer(idgen.PeerID("127.0.0.2"), mockTask, mockHost)
+			parent.Pieces.Set(0)
+
+			eb := NewEvaluatorBase().(Profiler)
+			eb.SetProfile(tc.profile)
+			tc.expect(t, eb.Profile(), eb.(Evaluator).Evaluate(parent, child, 1))
+		})
+	}
+}
+
 func TestEvaluatorBase_calculatePieceScore(t *testing.T) {
 	mockHost := resource.NewHost(mockRawHost)
 	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
@@ -286,7 +475,7 @@ func TestEvaluatorBase_calculateFreeLoadScore(t *testing.T) {
 		{
 			name: "host peers is not empty",
 			mock: func(host *resource.Host, mockPeer *resource.Peer) {
-				mockPeer.StoreParent(mockPeer)
+				mockPeer.StoreParent(resource.NewPeer(idgen.PeerID("127.0.0.2"), mockPeer.Task, mockPeer.Host))
 			},
 			expect: func(t *testing.T, score float64) {
 				assert := assert.New(t)
@@ -558,6 +747,129 @@ func TestEvaluatorBase_calculateMultiElementAffinityScore(t *testing.T) {
 	}
 }
 
+func TestEvaluatorBase_calculateNetworkDistanceScore(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(dstHost *resource.Host, srcHost *resource.Host)
+		expect func(t *testing.T, score float64)
+	}{
+		{
+			name: "hosts are the same",
+			mock: func(dstHost *resource.Host, srcHost *resource.Host) {
+				srcHost.ID = dstHost.ID
+			},
+			expect: func(t *testing.T, score float64) {
+				assert := assert.New(t)
+				assert.Equal(score, float64(1))
+			},
+		},
+		{
+			name: "hosts have not been probed",
+			mock: func(dstHost *resource.Host, srcHost *resource.Host) {},
+			expect: func(t *testing.T, score float64) {
+				assert := assert.New(t)
+				assert.Equal(score, float64(0))
+			},
+		},
+		{
+			name: "source host has probed destination host",
+			mock: func(dstHost *resource.Host, srcHost *resource.Host) {
+				srcHost.Probes.Store(dstHost.ID, 10*time.Millisecond, 0)
+			},
+			expect: func(t *testing.T, score float64) {
+				assert := assert.New(t)
+				assert.Equal(score, float64(0.5))
+			},
+		},
+		{
+			name: "destination host has probed source host",
+			mock: func(dstHost *resource.Host, srcHost *resource.Host) {
+				dstHost.Probes.Store(srcHost.ID, 0, 0.5)
+			},
+			expect: func(t *testing.T, score float64) {
+				assert := assert.New(t)
+				assert.Equal(score, float64(0.5))
+			},
+		},
+		{
+			name: "probe loses all packets",
+			mock: func(dstHost *resource.Host, srcHost *resource.Host) {
+				srcHost.Probes.Store(dstHost.ID, 0, 1)
+			},
+			expect: func(t *testing.T, score float64) {
+				assert := assert.New(t)
+				assert.Equal(score, float64(0))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dstHost := resource.NewHost(mockRawHost)
+			srcHost := resource.NewHost(mockRawHost)
+			srcHost.ID = idgen.HostID("foo", 8003)
+			tc.mock(dstHost, srcHost)
+			tc.expect(t, calculateNetworkDistanceScore(dstHost, srcHost))
+		})
+	}
+}
+
+func TestEvaluatorBase_calculateBandwidthScore(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(host *resource.Host)
+		expect func(t *testing.T, score float64)
+	}{
+		{
+			name: "host has not announced stats",
+			mock: func(host *resource.Host) {},
+			expect: func(t *testing.T, score float64) {
+				assert := assert.New(t)
+				assert.Equal(score, float64(0.5))
+			},
+		},
+		{
+			name: "upload bandwidth of host is unlimited",
+			mock: func(host *resource.Host) {
+				host.Stats.Store(resource.HostStat{TxBandwidth: 100})
+			},
+			expect: func(t *testing.T, score float64) {
+				assert := assert.New(t)
+				assert.Equal(score, float64(0.5))
+			},
+		},
+		{
+			name: "host has free upload bandwidth",
+			mock: func(host *resource.Host) {
+				host.Stats.Store(resource.HostStat{TxBandwidth: 20, UploadBandwidthLimit: 100})
+				host.Stats.Store(resource.HostStat{TxBandwidth: 40, UploadBandwidthLimit: 100})
+			},
+			expect: func(t *testing.T, score float64) {
+				assert := assert.New(t)
+				assert.Equal(score, float64(0.7))
+			},
+		},
+		{
+			name: "upload bandwidth of host is exhausted",
+			mock: func(host *resource.Host) {
+				host.Stats.Store(resource.HostStat{TxBandwidth: 200, UploadBandwidthLimit: 100})
+			},
+			expect: func(t *testing.T, score float64) {
+				assert := assert.New(t)
+				assert.Equal(score, float64(0))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			host := resource.NewHost(mockRawHost)
+			tc.mock(host)
+			tc.expect(t, calculateBandwidthScore(host))
+		})
+	}
+}
+
 func TestEvaluatorBase_IsBadNode(t *testing.T) {
 	mockHost := resource.NewHost(mockRawHost)
 	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
@@ -569,6 +881,19 @@ func TestEvaluatorBase_IsBadNode(t *testing.T) {
 		mock            func(peer *resource.Peer)
 		expect          func(t *testing.T, isBadNode bool)
 	}{
+		{
+			name:            "peer is quarantined",
+			peer:            resource.NewPeer(mockPeerID, mockTask, mockHost),
+			totalPieceCount: 1,
+			mock: func(peer *resource.Peer) {
+				peer.FSM.SetState(resource.PeerStateSucceeded)
+				peer.Quarantined.Store(true)
+			},
+			expect: func(t *testing.T, isBadNode bool) {
+				assert := assert.New(t)
+				assert.True(isBadNode)
+			},
+		},
 		{
 			name:            "peer state is PeerStateFailed",
 			peer:            resource.NewPeer(mockPeerID, mockTask, mockHost),
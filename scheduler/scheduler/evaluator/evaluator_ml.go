@@ -0,0 +1,73 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluator
+
+import (
+	"d7y.io/dragonfly/v2/scheduler/resource"
+)
+
+type evaluatorML struct {
+	model    *Model
+	badHosts map[string]struct{}
+	base     Evaluator
+}
+
+// NewEvaluatorML returns a new Evaluator based on the machine learning model file.
+func NewEvaluatorML(modelFile string) (Evaluator, error) {
+	model, err := LoadModel(modelFile)
+	if err != nil {
+		return nil, err
+	}
+
+	badHosts := make(map[string]struct{}, len(model.BadHosts))
+	for _, host := range model.BadHosts {
+		badHosts[host.IP+"|"+host.Hostname] = struct{}{}
+	}
+
+	return &evaluatorML{
+		model:    model,
+		badHosts: badHosts,
+		base:     NewEvaluatorBase(),
+	}, nil
+}
+
+// The larger the value after evaluation, the higher the priority.
+func (em *evaluatorML) Evaluate(parent *resource.Peer, child *resource.Peer, totalPieceCount int32) float64 {
+	// If the SecurityDomain of hosts exists but is not equal,
+	// it cannot be scheduled as a parent.
+	if parent.Host.SecurityDomain != "" &&
+		child.Host.SecurityDomain != "" &&
+		parent.Host.SecurityDomain != child.Host.SecurityDomain {
+		return minScore
+	}
+
+	return em.model.Score(parent, child, totalPieceCount)
+}
+
+func (em *evaluatorML) IsBadNode(peer *resource.Peer) bool {
+	if em.base.IsBadNode(peer) {
+		return true
+	}
+
+	// Hosts which failed frequently in the training records are bad nodes.
+	if _, ok := em.badHosts[peer.Host.IP+"|"+peer.Host.Hostname]; ok {
+		peer.Log.Debugf("peer is bad node because host is learned as bad host by model %s", em.model.Version)
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,196 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluator
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"d7y.io/dragonfly/v2/pkg/idgen"
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	"d7y.io/dragonfly/v2/scheduler/resource"
+)
+
+func mockModelFile(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "model")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	model, err := Train(mockTrainRecords())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filename := filepath.Join(dir, DefaultModelFilename)
+	if err := model.Save(filename); err != nil {
+		t.Fatal(err)
+	}
+
+	return filename, func() { os.RemoveAll(dir) }
+}
+
+func TestEvaluatorML_New(t *testing.T) {
+	modelFile, cleanup := mockModelFile(t)
+	defer cleanup()
+
+	tests := []struct {
+		name      string
+		modelFile string
+		expect    func(t *testing.T, e any)
+	}{
+		{
+			name:      "new evaluator with model file",
+			modelFile: modelFile,
+			expect: func(t *testing.T, e any) {
+				assert := assert.New(t)
+				assert.Equal(reflect.TypeOf(e).Elem().Name(), "evaluatorML")
+			},
+		},
+		{
+			name:      "model file does not exist",
+			modelFile: "foo",
+			expect: func(t *testing.T, e any) {
+				assert := assert.New(t)
+				assert.Equal(reflect.TypeOf(e).Elem().Name(), "evaluatorBase")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expect(t, New(MLAlgorithm, "", WithModelFile(tc.modelFile)))
+		})
+	}
+}
+
+func TestEvaluatorML_Evaluate(t *testing.T) {
+	modelFile, cleanup := mockModelFile(t)
+	defer cleanup()
+
+	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+	tests := []struct {
+		name   string
+		mock   func(parent *resource.Peer, other *resource.Peer, child *resource.Peer)
+		expect func(t *testing.T, score float64, otherScore float64)
+	}{
+		{
+			name: "security domain is not the same",
+			mock: func(parent *resource.Peer, other *resource.Peer, child *resource.Peer) {
+				parent.Host.SecurityDomain = "foo"
+				child.Host.SecurityDomain = "bar"
+			},
+			expect: func(t *testing.T, score float64, otherScore float64) {
+				assert := assert.New(t)
+				assert.Equal(score, float64(0))
+			},
+		},
+		{
+			name: "parent in the same idc has higher score",
+			mock: func(parent *resource.Peer, other *resource.Peer, child *resource.Peer) {
+				parent.Host.IDC = "idc"
+				other.Host.IDC = "foo"
+				child.Host.IDC = "idc"
+			},
+			expect: func(t *testing.T, score float64, otherScore float64) {
+				assert := assert.New(t)
+				assert.Greater(score, otherScore)
+				assert.LessOrEqual(score, maxScore)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			em, err := NewEvaluatorML(modelFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			parent := resource.NewPeer(idgen.PeerID("127.0.0.1"), mockTask, resource.NewHost(mockRawHost))
+			other := resource.NewPeer(idgen.PeerID("127.0.0.1"), mockTask, resource.NewHost(mockRawHost))
+			child := resource.NewPeer(idgen.PeerID("127.0.0.1"), mockTask, resource.NewHost(mockRawHost))
+			tc.mock(parent, other, child)
+			tc.expect(t, em.Evaluate(parent, child, 1), em.Evaluate(other, child, 1))
+		})
+	}
+}
+
+func TestEvaluatorML_IsBadNode(t *testing.T) {
+	modelFile, cleanup := mockModelFile(t)
+	defer cleanup()
+
+	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+	tests := []struct {
+		name   string
+		mock   func(peer *resource.Peer)
+		expect func(t *testing.T, isBadNode bool)
+	}{
+		{
+			name: "peer state is PeerStateFailed",
+			mock: func(peer *resource.Peer) {
+				peer.FSM.SetState(resource.PeerStateFailed)
+			},
+			expect: func(t *testing.T, isBadNode bool) {
+				assert := assert.New(t)
+				assert.True(isBadNode)
+			},
+		},
+		{
+			name: "host is learned as bad host",
+			mock: func(peer *resource.Peer) {
+				peer.FSM.SetState(resource.PeerStateRunning)
+				peer.Host.IP = "127.0.0.3"
+				peer.Host.Hostname = "bad"
+			},
+			expect: func(t *testing.T, isBadNode bool) {
+				assert := assert.New(t)
+				assert.True(isBadNode)
+			},
+		},
+		{
+			name: "host is not learned as bad host",
+			mock: func(peer *resource.Peer) {
+				peer.FSM.SetState(resource.PeerStateRunning)
+				peer.Host.IP = "127.0.0.1"
+				peer.Host.Hostname = "good"
+			},
+			expect: func(t *testing.T, isBadNode bool) {
+				assert := assert.New(t)
+				assert.False(isBadNode)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			em, err := NewEvaluatorML(modelFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			peer := resource.NewPeer(mockPeerID, mockTask, resource.NewHost(mockRawHost))
+			tc.mock(peer)
+			tc.expect(t, em.IsBadNode(peer))
+		})
+	}
+}
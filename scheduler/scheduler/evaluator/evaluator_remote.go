@@ -0,0 +1,209 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	rpcevaluator "d7y.io/dragonfly/v2/pkg/rpc/evaluator"
+	evaluatorclient "d7y.io/dragonfly/v2/pkg/rpc/evaluator/client"
+	"d7y.io/dragonfly/v2/scheduler/metrics"
+	"d7y.io/dragonfly/v2/scheduler/resource"
+)
+
+const (
+	// defaultRemoteTimeout is the default timeout of evaluating by evaluator service.
+	defaultRemoteTimeout = 200 * time.Millisecond
+)
+
+type evaluatorRemote struct {
+	client  evaluatorclient.Client
+	timeout time.Duration
+	base    Evaluator
+}
+
+// NewEvaluatorRemote returns a new Evaluator which scores candidate parents
+// by the external evaluator grpc service.
+func NewEvaluatorRemote(addr string, timeout time.Duration) (Evaluator, error) {
+	if addr == "" {
+		return nil, errors.New("evaluator service address is empty")
+	}
+
+	client, err := evaluatorclient.New(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return newEvaluatorRemote(client, timeout), nil
+}
+
+func newEvaluatorRemote(client evaluatorclient.Client, timeout time.Duration) *evaluatorRemote {
+	if timeout <= 0 {
+		timeout = defaultRemoteTimeout
+	}
+
+	return &evaluatorRemote{
+		client:  client,
+		timeout: timeout,
+		base:    NewEvaluatorBase(),
+	}
+}
+
+// Profile returns the weight profile of the fallback algorithm.
+func (er *evaluatorRemote) Profile() Profile {
+	return er.base.(Profiler).Profile()
+}
+
+// SetProfile swaps the weight profile of the fallback algorithm.
+func (er *evaluatorRemote) SetProfile(profile Profile) {
+	er.base.(Profiler).SetProfile(profile)
+}
+
+// The larger the value after evaluation, the higher the priority.
+func (er *evaluatorRemote) Evaluate(parent *resource.Peer, child *resource.Peer, totalPieceCount int32) float64 {
+	scores, err := er.evaluate([]*resource.Peer{parent}, child, totalPieceCount)
+	if err != nil {
+		child.Log.Warnf("evaluate by evaluator service failed, fall back to default algorithm: %s", err.Error())
+		return er.base.Evaluate(parent, child, totalPieceCount)
+	}
+
+	if score := scores[parent.ID]; !score.BadNode {
+		return score.Score
+	}
+
+	return minScore
+}
+
+// EvaluateParents scores parents by evaluator service in one call, and removes
+// the parents which are bad nodes in the verdicts of evaluator service.
+func (er *evaluatorRemote) EvaluateParents(parents []*resource.Peer, child *resource.Peer, totalPieceCount int32) []*resource.Peer {
+	scores, err := er.evaluate(parents, child, totalPieceCount)
+	if err != nil {
+		child.Log.Warnf("evaluate by evaluator service failed, fall back to default algorithm: %s", err.Error())
+		return EvaluateParents(er.base, parents, child, totalPieceCount)
+	}
+
+	var availableParents []*resource.Peer
+	for _, parent := range parents {
+		if scores[parent.ID].BadNode {
+			child.Log.Debugf("candidate parent %s is not selected because it is bad node by evaluator service", parent.ID)
+			continue
+		}
+
+		availableParents = append(availableParents, parent)
+	}
+
+	sort.SliceStable(
+		availableParents,
+		func(i, j int) bool {
+			return scores[availableParents[i].ID].Score > scores[availableParents[j].ID].Score
+		},
+	)
+
+	return availableParents
+}
+
+// IsBadNode determines if peer is a failed node by the rules of default algorithm,
+// it is called for every candidate parent, so the verdicts of evaluator service
+// are only applied by EvaluateParents.
+func (er *evaluatorRemote) IsBadNode(peer *resource.Peer) bool {
+	return er.base.IsBadNode(peer)
+}
+
+// evaluate calls evaluator service and returns the scores of parents by peer id.
+func (er *evaluatorRemote) evaluate(parents []*resource.Peer, child *resource.Peer, totalPieceCount int32) (map[string]*rpcevaluator.Score, error) {
+	metrics.RemoteEvaluateCount.Inc()
+
+	candidates := make([]*rpcevaluator.Candidate, 0, len(parents))
+	for _, parent := range parents {
+		candidate := &rpcevaluator.Candidate{Peer: newPeer(parent)}
+		if probe, ok := child.Host.Probes.Load(parent.Host.ID); ok {
+			candidate.Rtt = int64(probe.RTT)
+			candidate.Loss = probe.Loss
+		} else if probe, ok := parent.Host.Probes.Load(child.Host.ID); ok {
+			candidate.Rtt = int64(probe.RTT)
+			candidate.Loss = probe.Loss
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), er.timeout)
+	defer cancel()
+
+	resp, err := er.client.Evaluate(ctx, &rpcevaluator.EvaluateRequest{
+		TaskId:          child.Task.ID,
+		TotalPieceCount: totalPieceCount,
+		Child:           newPeer(child),
+		Candidates:      candidates,
+	})
+	if err != nil {
+		metrics.RemoteEvaluateFailureCount.Inc()
+		return nil, err
+	}
+
+	scores := make(map[string]*rpcevaluator.Score, len(resp.Scores))
+	for _, score := range resp.Scores {
+		scores[score.PeerId] = score
+	}
+
+	for _, parent := range parents {
+		if _, ok := scores[parent.ID]; !ok {
+			metrics.RemoteEvaluateFailureCount.Inc()
+			return nil, fmt.Errorf("score of candidate parent %s is missing", parent.ID)
+		}
+	}
+
+	return scores, nil
+}
+
+// newPeer converts peer to the peer message of evaluator service.
+func newPeer(peer *resource.Peer) *rpcevaluator.Peer {
+	host := &rpcevaluator.Host{
+		Id:              peer.Host.ID,
+		Type:            peer.Host.Type.Name(),
+		Ip:              peer.Host.IP,
+		Hostname:        peer.Host.Hostname,
+		SecurityDomain:  peer.Host.SecurityDomain,
+		Location:        peer.Host.Location,
+		Idc:             peer.Host.IDC,
+		NetTopology:     peer.Host.NetTopology,
+		UploadLoadLimit: peer.Host.UploadLoadLimit.Load(),
+		UploadPeerCount: peer.Host.UploadPeerCount.Load(),
+	}
+
+	if stat, ok := peer.Host.Stats.Average(); ok {
+		host.TxBandwidth = stat.TxBandwidth
+		host.UploadBandwidthLimit = stat.UploadBandwidthLimit
+		host.CpuRatio = stat.CPURatio
+		host.MemoryRatio = stat.MemoryRatio
+	}
+
+	return &rpcevaluator.Peer{
+		Id:                 peer.ID,
+		Host:               host,
+		State:              peer.FSM.Current(),
+		FinishedPieceCount: int32(peer.Pieces.Count()),
+		IsBackToSource:     peer.IsBackToSource.Load(),
+		Depth:              int32(peer.Depth()),
+		PieceCosts:         peer.PieceCosts(),
+	}
+}
@@ -0,0 +1,220 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	rpcevaluator "d7y.io/dragonfly/v2/pkg/rpc/evaluator"
+	"d7y.io/dragonfly/v2/pkg/rpc/evaluator/client/mocks"
+	"d7y.io/dragonfly/v2/scheduler/resource"
+)
+
+func TestEvaluatorRemote_NewEvaluatorRemote(t *testing.T) {
+	tests := []struct {
+		name   string
+		addr   string
+		expect func(t *testing.T, e any, err error)
+	}{
+		{
+			name: "new evaluator remote",
+			addr: "127.0.0.1:65002",
+			expect: func(t *testing.T, e any, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(reflect.TypeOf(e).Elem().Name(), "evaluatorRemote")
+			},
+		},
+		{
+			name: "address is empty",
+			addr: "",
+			expect: func(t *testing.T, e any, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "evaluator service address is empty")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := NewEvaluatorRemote(tc.addr, time.Second)
+			tc.expect(t, e, err)
+		})
+	}
+}
+
+func TestEvaluatorRemote_EvaluateParents(t *testing.T) {
+	mockHost := resource.NewHost(mockRawHost)
+	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+
+	tests := []struct {
+		name   string
+		mock   func(m *mocks.MockClientMockRecorder)
+		expect func(t *testing.T, parents []*resource.Peer)
+	}{
+		{
+			name: "sort parents by scores of evaluator service",
+			mock: func(m *mocks.MockClientMockRecorder) {
+				m.Evaluate(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, req *rpcevaluator.EvaluateRequest, _ ...any) (*rpcevaluator.EvaluateResponse, error) {
+					assert := assert.New(t)
+					assert.Equal(req.TaskId, mockTaskID)
+					assert.Equal(req.Child.Id, "child")
+					assert.Len(req.Candidates, 3)
+					return &rpcevaluator.EvaluateResponse{
+						Scores: []*rpcevaluator.Score{
+							{PeerId: "foo", Score: 0.1},
+							{PeerId: "bar", Score: 0.9},
+							{PeerId: "baz", Score: 0.5},
+						},
+					}, nil
+				}).Times(1)
+			},
+			expect: func(t *testing.T, parents []*resource.Peer) {
+				assert := assert.New(t)
+				assert.Len(parents, 3)
+				assert.Equal(parents[0].ID, "bar")
+				assert.Equal(parents[1].ID, "baz")
+				assert.Equal(parents[2].ID, "foo")
+			},
+		},
+		{
+			name: "remove bad nodes by verdicts of evaluator service",
+			mock: func(m *mocks.MockClientMockRecorder) {
+				m.Evaluate(gomock.Any(), gomock.Any()).Return(&rpcevaluator.EvaluateResponse{
+					Scores: []*rpcevaluator.Score{
+						{PeerId: "foo", Score: 0.1},
+						{PeerId: "bar", Score: 0.9, BadNode: true},
+						{PeerId: "baz", Score: 0.5},
+					},
+				}, nil).Times(1)
+			},
+			expect: func(t *testing.T, parents []*resource.Peer) {
+				assert := assert.New(t)
+				assert.Len(parents, 2)
+				assert.Equal(parents[0].ID, "baz")
+				assert.Equal(parents[1].ID, "foo")
+			},
+		},
+		{
+			name: "evaluator service failed",
+			mock: func(m *mocks.MockClientMockRecorder) {
+				m.Evaluate(gomock.Any(), gomock.Any()).Return(nil, errors.New("foo")).Times(1)
+			},
+			expect: func(t *testing.T, parents []*resource.Peer) {
+				assert := assert.New(t)
+				assert.Len(parents, 3)
+			},
+		},
+		{
+			name: "score of parent is missing",
+			mock: func(m *mocks.MockClientMockRecorder) {
+				m.Evaluate(gomock.Any(), gomock.Any()).Return(&rpcevaluator.EvaluateResponse{
+					Scores: []*rpcevaluator.Score{
+						{PeerId: "foo", Score: 0.1, BadNode: true},
+					},
+				}, nil).Times(1)
+			},
+			expect: func(t *testing.T, parents []*resource.Peer) {
+				assert := assert.New(t)
+				assert.Len(parents, 3)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			client := mocks.NewMockClient(ctl)
+			tc.mock(client.EXPECT())
+
+			e := newEvaluatorRemote(client, time.Second)
+			child := resource.NewPeer("child", mockTask, mockHost)
+			parents := []*resource.Peer{
+				resource.NewPeer("foo", mockTask, mockHost),
+				resource.NewPeer("bar", mockTask, mockHost),
+				resource.NewPeer("baz", mockTask, mockHost),
+			}
+			tc.expect(t, e.EvaluateParents(parents, child, 1))
+		})
+	}
+}
+
+func TestEvaluatorRemote_Evaluate(t *testing.T) {
+	mockHost := resource.NewHost(mockRawHost)
+	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+
+	tests := []struct {
+		name   string
+		mock   func(m *mocks.MockClientMockRecorder)
+		expect func(t *testing.T, score float64)
+	}{
+		{
+			name: "evaluate parent",
+			mock: func(m *mocks.MockClientMockRecorder) {
+				m.Evaluate(gomock.Any(), gomock.Any()).Return(&rpcevaluator.EvaluateResponse{
+					Scores: []*rpcevaluator.Score{{PeerId: "foo", Score: 0.8}},
+				}, nil).Times(1)
+			},
+			expect: func(t *testing.T, score float64) {
+				assert := assert.New(t)
+				assert.Equal(score, 0.8)
+			},
+		},
+		{
+			name: "parent is bad node",
+			mock: func(m *mocks.MockClientMockRecorder) {
+				m.Evaluate(gomock.Any(), gomock.Any()).Return(&rpcevaluator.EvaluateResponse{
+					Scores: []*rpcevaluator.Score{{PeerId: "foo", Score: 0.8, BadNode: true}},
+				}, nil).Times(1)
+			},
+			expect: func(t *testing.T, score float64) {
+				assert := assert.New(t)
+				assert.Equal(score, float64(minScore))
+			},
+		},
+		{
+			name: "evaluator service failed",
+			mock: func(m *mocks.MockClientMockRecorder) {
+				m.Evaluate(gomock.Any(), gomock.Any()).Return(nil, errors.New("foo")).Times(1)
+			},
+			expect: func(t *testing.T, score float64) {
+				assert := assert.New(t)
+				assert.Greater(score, float64(minScore))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			client := mocks.NewMockClient(ctl)
+			tc.mock(client.EXPECT())
+
+			e := newEvaluatorRemote(client, time.Second)
+			tc.expect(t, e.Evaluate(resource.NewPeer("foo", mockTask, mockHost), resource.NewPeer("bar", mockTask, mockHost), 1))
+		})
+	}
+}
@@ -54,6 +54,14 @@ func TestEvaluator_New(t *testing.T) {
 				assert.Equal(reflect.TypeOf(e).Elem().Name(), "evaluatorBase")
 			},
 		},
+		{
+			name:      "new evaluator with remote algorithm",
+			algorithm: "remote",
+			expect: func(t *testing.T, e any) {
+				assert := assert.New(t)
+				assert.Equal(reflect.TypeOf(e).Elem().Name(), "evaluatorBase")
+			},
+		},
 		{
 			name:      "new evaluator with empty string",
 			algorithm: "",
@@ -0,0 +1,538 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"d7y.io/dragonfly/v2/scheduler/resource"
+	"d7y.io/dragonfly/v2/scheduler/storage"
+)
+
+const (
+	// DefaultModelFilename is the default file name of machine learning model.
+	DefaultModelFilename = "model.json"
+
+	// ModelSchemaVersion is the schema version of model file,
+	// model files with other schema versions can not be loaded.
+	ModelSchemaVersion = 1
+)
+
+const (
+	// DefaultTrainIterations is the default number of gradient descent iterations.
+	DefaultTrainIterations = 1000
+
+	// DefaultTrainLearningRate is the default learning rate of gradient descent.
+	DefaultTrainLearningRate = 0.1
+
+	// DefaultBadHostFailureRate is the default failure rate of parent host
+	// which is regarded as bad node.
+	DefaultBadHostFailureRate = 0.5
+
+	// DefaultBadHostMinSamples is the default minimum number of samples of parent host
+	// which can be regarded as bad node.
+	DefaultBadHostMinSamples = 10
+
+	// minTrainSamples is the minimum number of samples to train model.
+	minTrainSamples = 2
+
+	// minStdDev is the minimum standard deviation of non-constant feature.
+	minStdDev = 1e-9
+)
+
+// Feature names of model, the order is the same as the feature vector.
+const (
+	featurePieceRatio          = "pieceRatio"
+	featureFreeUploadLoad      = "freeUploadLoad"
+	featureSeedHost            = "seedHost"
+	featureIDCAffinity         = "idcAffinity"
+	featureNetTopologyAffinity = "netTopologyAffinity"
+	featureLocationAffinity    = "locationAffinity"
+)
+
+// featureNames is the feature vector layout of model.
+var featureNames = []string{
+	featurePieceRatio,
+	featureFreeUploadLoad,
+	featureSeedHost,
+	featureIDCAffinity,
+	featureNetTopologyAffinity,
+	featureLocationAffinity,
+}
+
+// BadHost is the parent host which is learned as bad node.
+type BadHost struct {
+	// IP is host ip.
+	IP string `json:"ip"`
+
+	// Hostname is host name.
+	Hostname string `json:"hostname"`
+
+	// FailureRate is failure rate of downloads from the host.
+	FailureRate float64 `json:"failureRate"`
+
+	// Samples is number of downloads from the host.
+	Samples int `json:"samples"`
+}
+
+// Model is the logistic regression model of parent scoring.
+type Model struct {
+	// SchemaVersion is the schema version of model file.
+	SchemaVersion int `json:"schemaVersion"`
+
+	// Version is the version of the trained model.
+	Version string `json:"version"`
+
+	// CreatedAt is the time when the model is trained.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Features is the feature names of model.
+	Features []string `json:"features"`
+
+	// Weights is the feature weights of model.
+	Weights []float64 `json:"weights"`
+
+	// Bias is the bias of model.
+	Bias float64 `json:"bias"`
+
+	// Means is the feature means used to standardize features.
+	Means []float64 `json:"means"`
+
+	// StdDevs is the feature standard deviations used to standardize features.
+	StdDevs []float64 `json:"stdDevs"`
+
+	// SampleCount is number of samples used to train model.
+	SampleCount int `json:"sampleCount"`
+
+	// Accuracy is the accuracy of model on training samples.
+	Accuracy float64 `json:"accuracy"`
+
+	// BadHosts is the parent hosts which are learned as bad node.
+	BadHosts []BadHost `json:"badHosts"`
+}
+
+// trainOptions is the options of training.
+type trainOptions struct {
+	iterations         int
+	learningRate       float64
+	badHostFailureRate float64
+	badHostMinSamples  int
+}
+
+// TrainOption is a functional option for configuring the training.
+type TrainOption func(o *trainOptions)
+
+// WithTrainIterations sets the number of gradient descent iterations.
+func WithTrainIterations(iterations int) TrainOption {
+	return func(o *trainOptions) {
+		o.iterations = iterations
+	}
+}
+
+// WithTrainLearningRate sets the learning rate of gradient descent.
+func WithTrainLearningRate(learningRate float64) TrainOption {
+	return func(o *trainOptions) {
+		o.learningRate = learningRate
+	}
+}
+
+// WithBadHostFailureRate sets the failure rate of parent host which is regarded as bad node.
+func WithBadHostFailureRate(failureRate float64) TrainOption {
+	return func(o *trainOptions) {
+		o.badHostFailureRate = failureRate
+	}
+}
+
+// WithBadHostMinSamples sets the minimum number of samples of parent host
+// which can be regarded as bad node.
+func WithBadHostMinSamples(minSamples int) TrainOption {
+	return func(o *trainOptions) {
+		o.badHostMinSamples = minSamples
+	}
+}
+
+// Train trains the model with download records. Only records downloaded
+// from a parent are used, record is labeled as positive when it is downloaded
+// successfully and its piece cost is not greater than the median.
+func Train(records []storage.Record, options ...TrainOption) (*Model, error) {
+	o := &trainOptions{
+		iterations:         DefaultTrainIterations,
+		learningRate:       DefaultTrainLearningRate,
+		badHostFailureRate: DefaultBadHostFailureRate,
+		badHostMinSamples:  DefaultBadHostMinSamples,
+	}
+
+	for _, opt := range options {
+		opt(o)
+	}
+
+	if o.iterations <= 0 {
+		return nil, errors.New("iterations must be greater than 0")
+	}
+
+	if o.learningRate <= 0 {
+		return nil, errors.New("learning rate must be greater than 0")
+	}
+
+	var samples []storage.Record
+	var costs []float64
+	for _, record := range records {
+		if record.ParentID == "" {
+			continue
+		}
+
+		samples = append(samples, record)
+		if record.State == storage.PeerStateSucceeded {
+			costs = append(costs, pieceCost(record))
+		}
+	}
+
+	if len(samples) < minTrainSamples {
+		return nil, fmt.Errorf("not enough samples to train, got %d", len(samples))
+	}
+
+	// Label samples by the median of piece costs.
+	var medianCost float64
+	if len(costs) > 0 {
+		sort.Float64s(costs)
+		medianCost = costs[(len(costs)-1)/2]
+	}
+
+	xs := make([][]float64, len(samples))
+	ys := make([]float64, len(samples))
+	for i, sample := range samples {
+		xs[i] = recordFeatures(sample)
+		if sample.State == storage.PeerStateSucceeded && pieceCost(sample) <= medianCost {
+			ys[i] = 1
+		}
+	}
+
+	means, stdDevs := standardize(xs)
+	weights := make([]float64, len(featureNames))
+	var bias float64
+	n := float64(len(xs))
+	for i := 0; i < o.iterations; i++ {
+		gradients := make([]float64, len(weights))
+		var biasGradient float64
+		for j, x := range xs {
+			diff := sigmoid(dot(weights, x)+bias) - ys[j]
+			for k := range gradients {
+				gradients[k] += diff * x[k]
+			}
+			biasGradient += diff
+		}
+
+		for k := range weights {
+			weights[k] -= o.learningRate * gradients[k] / n
+		}
+		bias -= o.learningRate * biasGradient / n
+	}
+
+	var correct int
+	for i, x := range xs {
+		if (sigmoid(dot(weights, x)+bias) >= 0.5) == (ys[i] == 1) {
+			correct++
+		}
+	}
+
+	createdAt := time.Now()
+	return &Model{
+		SchemaVersion: ModelSchemaVersion,
+		Version:       createdAt.UTC().Format("20060102150405"),
+		CreatedAt:     createdAt,
+		Features:      append([]string(nil), featureNames...),
+		Weights:       weights,
+		Bias:          bias,
+		Means:         means,
+		StdDevs:       stdDevs,
+		SampleCount:   len(samples),
+		Accuracy:      float64(correct) / n,
+		BadHosts:      badHosts(samples, o.badHostFailureRate, o.badHostMinSamples),
+	}, nil
+}
+
+// LoadModel loads the model from file and validates it.
+func LoadModel(filename string) (*Model, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &Model{}
+	if err := json.Unmarshal(b, model); err != nil {
+		return nil, err
+	}
+
+	if err := model.Validate(); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+// Save writes the model to file atomically.
+func (m *Model) Save(filename string) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(filename), filepath.Base(filename))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filename)
+}
+
+// Validate checks the model is usable.
+func (m *Model) Validate() error {
+	if m.SchemaVersion != ModelSchemaVersion {
+		return fmt.Errorf("invalid model schema version %d, expected %d", m.SchemaVersion, ModelSchemaVersion)
+	}
+
+	if len(m.Features) != len(featureNames) {
+		return fmt.Errorf("invalid model features %v", m.Features)
+	}
+
+	for i, feature := range m.Features {
+		if feature != featureNames[i] {
+			return fmt.Errorf("invalid model feature %s", feature)
+		}
+	}
+
+	if len(m.Weights) != len(featureNames) || len(m.Means) != len(featureNames) || len(m.StdDevs) != len(featureNames) {
+		return errors.New("invalid model parameters length")
+	}
+
+	for i := range featureNames {
+		if isInvalidFloat(m.Weights[i]) || isInvalidFloat(m.Means[i]) || isInvalidFloat(m.StdDevs[i]) {
+			return errors.New("invalid model parameters")
+		}
+	}
+
+	if isInvalidFloat(m.Bias) {
+		return errors.New("invalid model bias")
+	}
+
+	return nil
+}
+
+// Score returns the probability that parent is a good parent of child.
+func (m *Model) Score(parent *resource.Peer, child *resource.Peer, totalPieceCount int32) float64 {
+	x := peerFeatures(parent, child, totalPieceCount)
+	for i := range x {
+		x[i] = standardizeValue(x[i], m.Means[i], m.StdDevs[i])
+	}
+
+	return sigmoid(dot(m.Weights, x) + m.Bias)
+}
+
+// recordFeatures returns feature vector of record.
+func recordFeatures(record storage.Record) []float64 {
+	var pieceRatio float64
+	if record.TotalPieceCount > 0 {
+		pieceRatio = math.Min(float64(record.ParentPieceCount)/float64(record.TotalPieceCount), 1)
+	}
+
+	var seedHost float64
+	if resource.HostType(record.ParentHostType) != resource.HostTypeNormal {
+		seedHost = 1
+	}
+
+	var idcAffinity float64
+	if record.IDC != "" && record.IDC == record.ParentIDC {
+		idcAffinity = 1
+	}
+
+	return []float64{
+		pieceRatio,
+		float64(record.ParentFreeUploadLoad),
+		seedHost,
+		idcAffinity,
+		calculateMultiElementAffinityScore(record.ParentNetTopology, record.NetTopology),
+		calculateMultiElementAffinityScore(record.ParentLocation, record.Location),
+	}
+}
+
+// peerFeatures returns feature vector of parent and child.
+func peerFeatures(parent *resource.Peer, child *resource.Peer, totalPieceCount int32) []float64 {
+	var pieceRatio float64
+	if totalPieceCount > 0 {
+		pieceRatio = math.Min(float64(parent.Pieces.Count())/float64(totalPieceCount), 1)
+	}
+
+	var seedHost float64
+	if parent.Host.Type != resource.HostTypeNormal {
+		seedHost = 1
+	}
+
+	return []float64{
+		pieceRatio,
+		float64(parent.Host.FreeUploadLoad()),
+		seedHost,
+		calculateIDCAffinityScore(parent.Host, child.Host),
+		calculateMultiElementAffinityScore(parent.Host.NetTopology, child.Host.NetTopology),
+		calculateMultiElementAffinityScore(parent.Host.Location, child.Host.Location),
+	}
+}
+
+// badHosts returns parent hosts whose failure rate exceeds the limit.
+func badHosts(records []storage.Record, failureRate float64, minSamples int) []BadHost {
+	type stat struct {
+		ip       string
+		hostname string
+		samples  int
+		failures int
+	}
+
+	stats := map[string]*stat{}
+	var keys []string
+	for _, record := range records {
+		key := record.ParentIP + "|" + record.ParentHostname
+		s, ok := stats[key]
+		if !ok {
+			s = &stat{ip: record.ParentIP, hostname: record.ParentHostname}
+			stats[key] = s
+			keys = append(keys, key)
+		}
+
+		s.samples++
+		if record.State != storage.PeerStateSucceeded {
+			s.failures++
+		}
+	}
+
+	var hosts []BadHost
+	for _, key := range keys {
+		s := stats[key]
+		if s.samples < minSamples {
+			continue
+		}
+
+		rate := float64(s.failures) / float64(s.samples)
+		if rate >= failureRate {
+			hosts = append(hosts, BadHost{
+				IP:          s.ip,
+				Hostname:    s.hostname,
+				FailureRate: rate,
+				Samples:     s.samples,
+			})
+		}
+	}
+
+	return hosts
+}
+
+// pieceCost returns average piece cost of record.
+func pieceCost(record storage.Record) float64 {
+	if record.PieceCount <= 0 {
+		return float64(record.Cost)
+	}
+
+	return float64(record.Cost) / float64(record.PieceCount)
+}
+
+// standardize standardizes features in place and returns means and standard deviations.
+func standardize(xs [][]float64) ([]float64, []float64) {
+	means := make([]float64, len(featureNames))
+	stdDevs := make([]float64, len(featureNames))
+	n := float64(len(xs))
+	for _, x := range xs {
+		for i := range x {
+			means[i] += x[i]
+		}
+	}
+
+	for i := range means {
+		means[i] /= n
+	}
+
+	for _, x := range xs {
+		for i := range x {
+			stdDevs[i] += (x[i] - means[i]) * (x[i] - means[i])
+		}
+	}
+
+	for i := range stdDevs {
+		// Feature with rounding error variance is regarded as constant.
+		stdDevs[i] = math.Sqrt(stdDevs[i] / n)
+		if stdDevs[i] < minStdDev {
+			stdDevs[i] = 0
+		}
+	}
+
+	for _, x := range xs {
+		for i := range x {
+			x[i] = standardizeValue(x[i], means[i], stdDevs[i])
+		}
+	}
+
+	return means, stdDevs
+}
+
+// standardizeValue returns the standard score of value.
+func standardizeValue(value, mean, stdDev float64) float64 {
+	if stdDev == 0 {
+		return 0
+	}
+
+	return (value - mean) / stdDev
+}
+
+func sigmoid(z float64) float64 {
+	return 1 / (1 + math.Exp(-z))
+}
+
+func dot(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+
+	return sum
+}
+
+func isInvalidFloat(f float64) bool {
+	return math.IsNaN(f) || math.IsInf(f, 0)
+}
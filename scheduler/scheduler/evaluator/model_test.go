@@ -0,0 +1,304 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluator
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"d7y.io/dragonfly/v2/scheduler/storage"
+)
+
+// mockTrainRecords returns records in which parents in the same idc download faster,
+// and the parent host "bad" fails all the time.
+func mockTrainRecords() []storage.Record {
+	var records []storage.Record
+	for i := 0; i < 20; i++ {
+		records = append(records, storage.Record{
+			ID:               "fast",
+			Cost:             100,
+			PieceCount:       10,
+			TotalPieceCount:  10,
+			IDC:              "idc",
+			State:            storage.PeerStateSucceeded,
+			ParentID:         "parent",
+			ParentIP:         "127.0.0.1",
+			ParentHostname:   "good",
+			ParentPieceCount: 10,
+			ParentIDC:        "idc",
+		})
+
+		records = append(records, storage.Record{
+			ID:               "slow",
+			Cost:             1000,
+			PieceCount:       10,
+			TotalPieceCount:  10,
+			IDC:              "idc",
+			State:            storage.PeerStateSucceeded,
+			ParentID:         "parent",
+			ParentIP:         "127.0.0.2",
+			ParentHostname:   "slow",
+			ParentPieceCount: 10,
+			ParentIDC:        "foo",
+		})
+
+		records = append(records, storage.Record{
+			ID:               "failed",
+			Cost:             1000,
+			PieceCount:       1,
+			TotalPieceCount:  10,
+			IDC:              "idc",
+			State:            storage.PeerStateFailed,
+			ParentID:         "parent",
+			ParentIP:         "127.0.0.3",
+			ParentHostname:   "bad",
+			ParentPieceCount: 10,
+			ParentIDC:        "bar",
+		})
+
+		records = append(records, storage.Record{
+			ID:    "back-to-source",
+			Cost:  1,
+			State: storage.PeerStateBackToSourceSucceeded,
+		})
+	}
+
+	return records
+}
+
+func TestModel_Train(t *testing.T) {
+	tests := []struct {
+		name    string
+		records []storage.Record
+		options []TrainOption
+		expect  func(t *testing.T, model *Model, err error)
+	}{
+		{
+			name:    "train model",
+			records: mockTrainRecords(),
+			options: []TrainOption{},
+			expect: func(t *testing.T, model *Model, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.NoError(model.Validate())
+				assert.Equal(model.SchemaVersion, ModelSchemaVersion)
+				assert.NotEmpty(model.Version)
+				assert.Equal(model.SampleCount, 60)
+				assert.Greater(model.Weights[3], float64(0))
+				assert.Greater(model.Accuracy, 0.9)
+				assert.Equal(len(model.BadHosts), 1)
+				assert.Equal(model.BadHosts[0].Hostname, "bad")
+				assert.Equal(model.BadHosts[0].FailureRate, float64(1))
+			},
+		},
+		{
+			name:    "records without parent",
+			records: []storage.Record{{ID: "foo"}, {ID: "bar"}},
+			options: []TrainOption{},
+			expect: func(t *testing.T, model *Model, err error) {
+				assert := assert.New(t)
+				assert.Error(err)
+			},
+		},
+		{
+			name:    "invalid iterations",
+			records: mockTrainRecords(),
+			options: []TrainOption{WithTrainIterations(0)},
+			expect: func(t *testing.T, model *Model, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "iterations must be greater than 0")
+			},
+		},
+		{
+			name:    "invalid learning rate",
+			records: mockTrainRecords(),
+			options: []TrainOption{WithTrainLearningRate(0)},
+			expect: func(t *testing.T, model *Model, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "learning rate must be greater than 0")
+			},
+		},
+		{
+			name:    "bad host does not have enough samples",
+			records: mockTrainRecords(),
+			options: []TrainOption{WithBadHostMinSamples(100)},
+			expect: func(t *testing.T, model *Model, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Empty(model.BadHosts)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			model, err := Train(tc.records, tc.options...)
+			tc.expect(t, model, err)
+		})
+	}
+}
+
+func TestModel_SaveAndLoad(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(t *testing.T, filename string)
+		expect func(t *testing.T, filename string)
+	}{
+		{
+			name: "save and load model",
+			mock: func(t *testing.T, filename string) {
+				model, err := Train(mockTrainRecords())
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if err := model.Save(filename); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(t *testing.T, filename string) {
+				assert := assert.New(t)
+				model, err := LoadModel(filename)
+				assert.NoError(err)
+				assert.Equal(model.SampleCount, 60)
+			},
+		},
+		{
+			name: "model file does not exist",
+			mock: func(t *testing.T, filename string) {},
+			expect: func(t *testing.T, filename string) {
+				assert := assert.New(t)
+				_, err := LoadModel(filename)
+				assert.Error(err)
+			},
+		},
+		{
+			name: "model file is corrupt",
+			mock: func(t *testing.T, filename string) {
+				if err := ioutil.WriteFile(filename, []byte("{"), 0600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(t *testing.T, filename string) {
+				assert := assert.New(t)
+				_, err := LoadModel(filename)
+				assert.Error(err)
+			},
+		},
+		{
+			name: "model schema version is invalid",
+			mock: func(t *testing.T, filename string) {
+				if err := ioutil.WriteFile(filename, []byte(`{"schemaVersion": 100}`), 0600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(t *testing.T, filename string) {
+				assert := assert.New(t)
+				_, err := LoadModel(filename)
+				assert.EqualError(err, "invalid model schema version 100, expected 1")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "model")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			filename := filepath.Join(dir, DefaultModelFilename)
+			tc.mock(t, filename)
+			tc.expect(t, filename)
+		})
+	}
+}
+
+func TestModel_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(model *Model)
+		expect func(t *testing.T, err error)
+	}{
+		{
+			name: "model is valid",
+			mock: func(model *Model) {},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+			},
+		},
+		{
+			name: "features are invalid",
+			mock: func(model *Model) {
+				model.Features = []string{"foo"}
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.Error(err)
+			},
+		},
+		{
+			name: "weights length is invalid",
+			mock: func(model *Model) {
+				model.Weights = model.Weights[1:]
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "invalid model parameters length")
+			},
+		},
+		{
+			name: "weight is NaN",
+			mock: func(model *Model) {
+				model.Weights[0] = math.NaN()
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "invalid model parameters")
+			},
+		},
+		{
+			name: "bias is infinity",
+			mock: func(model *Model) {
+				model.Bias = math.Inf(1)
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "invalid model bias")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			model, err := Train(mockTrainRecords())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tc.mock(model)
+			tc.expect(t, model.Validate())
+		})
+	}
+}
@@ -0,0 +1,152 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluator
+
+import (
+	"strings"
+
+	"d7y.io/dragonfly/v2/scheduler/resource"
+)
+
+const (
+	// DefaultProfileName is the name of default weight profile.
+	DefaultProfileName = "default"
+
+	// SingleIDCProfileName is the name of weight profile for the cluster in a single IDC,
+	// it ignores IDC affinity and prefers parents with more free load.
+	SingleIDCProfileName = "single-idc"
+)
+
+// Profiler is the evaluator whose weight profile can be hot-swapped.
+type Profiler interface {
+	// Profile returns the weight profile in use.
+	Profile() Profile
+
+	// SetProfile swaps the weight profile.
+	SetProfile(Profile)
+}
+
+// Profile is the weight profile of evaluator.
+type Profile struct {
+	// Name is the name of profile.
+	Name string
+
+	// FinishedPieceWeight is the weight of finished piece score.
+	FinishedPieceWeight float64
+
+	// FreeLoadWeight is the weight of free load score.
+	FreeLoadWeight float64
+
+	// HostTypeAffinityWeight is the weight of host type affinity score.
+	HostTypeAffinityWeight float64
+
+	// IDCAffinityWeight is the weight of IDC affinity score.
+	IDCAffinityWeight float64
+
+	// NetTopologyAffinityWeight is the weight of net topology affinity score.
+	NetTopologyAffinityWeight float64
+
+	// LocationAffinityWeight is the weight of location affinity score.
+	LocationAffinityWeight float64
+
+	// NetworkDistanceWeight is the weight of network distance score.
+	NetworkDistanceWeight float64
+
+	// BandwidthWeight is the weight of free upload bandwidth score.
+	BandwidthWeight float64
+
+	// Rules is the custom scoring rules of profile.
+	Rules []Rule
+}
+
+// Rule is the custom scoring rule, the score of rule is added
+// to the evaluation when the parent matches all conditions of rule.
+type Rule struct {
+	// HostType is the host type name of parent.
+	HostType string
+
+	// IDC is the IDC of parent.
+	IDC string
+
+	// NetTopology is the net topology prefix of parent.
+	NetTopology string
+
+	// Location is the location prefix of parent.
+	Location string
+
+	// Score is added to the evaluation, it can be negative.
+	Score float64
+}
+
+// Match determines whether the parent matches all conditions of rule.
+func (r *Rule) Match(parent *resource.Peer) bool {
+	if r.HostType != "" && parent.Host.Type.Name() != r.HostType {
+		return false
+	}
+
+	if r.IDC != "" && parent.Host.IDC != r.IDC {
+		return false
+	}
+
+	if r.NetTopology != "" && !strings.HasPrefix(parent.Host.NetTopology, r.NetTopology) {
+		return false
+	}
+
+	if r.Location != "" && !strings.HasPrefix(parent.Host.Location, r.Location) {
+		return false
+	}
+
+	return true
+}
+
+// DefaultProfile is the default weight profile of evaluator.
+var DefaultProfile = Profile{
+	Name:                      DefaultProfileName,
+	FinishedPieceWeight:       finishedPieceWeight,
+	FreeLoadWeight:            freeLoadWeight,
+	HostTypeAffinityWeight:    hostTypeAffinityWeight,
+	IDCAffinityWeight:         idcAffinityWeight,
+	NetTopologyAffinityWeight: netTopologyAffinityWeight,
+	LocationAffinityWeight:    locationAffinityWeight,
+	NetworkDistanceWeight:     networkDistanceWeight,
+	BandwidthWeight:           bandwidthWeight,
+}
+
+// SingleIDCProfile is the weight profile for the cluster in a single IDC.
+var SingleIDCProfile = Profile{
+	Name:                      SingleIDCProfileName,
+	FinishedPieceWeight:       finishedPieceWeight,
+	FreeLoadWeight:            freeLoadWeight + idcAffinityWeight,
+	HostTypeAffinityWeight:    hostTypeAffinityWeight,
+	IDCAffinityWeight:         0,
+	NetTopologyAffinityWeight: netTopologyAffinityWeight,
+	LocationAffinityWeight:    locationAffinityWeight,
+	NetworkDistanceWeight:     networkDistanceWeight,
+	BandwidthWeight:           bandwidthWeight,
+}
+
+// BuiltinProfile returns the builtin weight profile by name.
+func BuiltinProfile(name string) (Profile, bool) {
+	switch name {
+	case DefaultProfileName:
+		return DefaultProfile, true
+	case SingleIDCProfileName:
+		return SingleIDCProfile, true
+	}
+
+	return Profile{}, false
+}
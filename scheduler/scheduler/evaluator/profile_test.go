@@ -0,0 +1,146 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"d7y.io/dragonfly/v2/pkg/idgen"
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	"d7y.io/dragonfly/v2/scheduler/resource"
+)
+
+func TestRule_Match(t *testing.T) {
+	mockHost := resource.NewHost(mockRawHost)
+	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+	mockPeer := resource.NewPeer(idgen.PeerID("127.0.0.1"), mockTask, mockHost)
+
+	tests := []struct {
+		name   string
+		rule   Rule
+		expect func(t *testing.T, ok bool)
+	}{
+		{
+			name: "rule has no conditions",
+			rule: Rule{},
+			expect: func(t *testing.T, ok bool) {
+				assert := assert.New(t)
+				assert.True(ok)
+			},
+		},
+		{
+			name: "rule matches all conditions",
+			rule: Rule{
+				HostType:    "normal",
+				IDC:         "idc",
+				NetTopology: "net",
+				Location:    "loc",
+			},
+			expect: func(t *testing.T, ok bool) {
+				assert := assert.New(t)
+				assert.True(ok)
+			},
+		},
+		{
+			name: "host type does not match",
+			rule: Rule{
+				HostType: "super",
+			},
+			expect: func(t *testing.T, ok bool) {
+				assert := assert.New(t)
+				assert.False(ok)
+			},
+		},
+		{
+			name: "idc does not match",
+			rule: Rule{
+				IDC: "foo",
+			},
+			expect: func(t *testing.T, ok bool) {
+				assert := assert.New(t)
+				assert.False(ok)
+			},
+		},
+		{
+			name: "net topology prefix does not match",
+			rule: Rule{
+				NetTopology: "foo",
+			},
+			expect: func(t *testing.T, ok bool) {
+				assert := assert.New(t)
+				assert.False(ok)
+			},
+		},
+		{
+			name: "location prefix does not match",
+			rule: Rule{
+				Location: "foo",
+			},
+			expect: func(t *testing.T, ok bool) {
+				assert := assert.New(t)
+				assert.False(ok)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expect(t, tc.rule.Match(mockPeer))
+		})
+	}
+}
+
+func TestBuiltinProfile(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect func(t *testing.T, profile Profile, ok bool)
+	}{
+		{
+			name: DefaultProfileName,
+			expect: func(t *testing.T, profile Profile, ok bool) {
+				assert := assert.New(t)
+				assert.True(ok)
+				assert.Equal(profile, DefaultProfile)
+			},
+		},
+		{
+			name: SingleIDCProfileName,
+			expect: func(t *testing.T, profile Profile, ok bool) {
+				assert := assert.New(t)
+				assert.True(ok)
+				assert.Equal(profile.IDCAffinityWeight, float64(0))
+				assert.Greater(profile.FreeLoadWeight, DefaultProfile.FreeLoadWeight)
+			},
+		},
+		{
+			name: "foo",
+			expect: func(t *testing.T, profile Profile, ok bool) {
+				assert := assert.New(t)
+				assert.False(ok)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			profile, ok := BuiltinProfile(tc.name)
+			tc.expect(t, profile, ok)
+		})
+	}
+}
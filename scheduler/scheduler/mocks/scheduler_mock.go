@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	set "d7y.io/dragonfly/v2/pkg/container/set"
+	config "d7y.io/dragonfly/v2/scheduler/config"
 	resource "d7y.io/dragonfly/v2/scheduler/resource"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -66,6 +67,32 @@ func (mr *MockSchedulerMockRecorder) NotifyAndFindParent(arg0, arg1, arg2 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyAndFindParent", reflect.TypeOf((*MockScheduler)(nil).NotifyAndFindParent), arg0, arg1, arg2)
 }
 
+// OnNotify mocks base method.
+func (m *MockScheduler) OnNotify(arg0 *config.DynconfigData) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnNotify", arg0)
+}
+
+// OnNotify indicates an expected call of OnNotify.
+func (mr *MockSchedulerMockRecorder) OnNotify(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnNotify", reflect.TypeOf((*MockScheduler)(nil).OnNotify), arg0)
+}
+
+// RunGC mocks base method.
+func (m *MockScheduler) RunGC() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunGC")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunGC indicates an expected call of RunGC.
+func (mr *MockSchedulerMockRecorder) RunGC() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunGC", reflect.TypeOf((*MockScheduler)(nil).RunGC))
+}
+
 // ScheduleParent mocks base method.
 func (m *MockScheduler) ScheduleParent(arg0 context.Context, arg1 *resource.Peer, arg2 set.SafeSet) {
 	m.ctrl.T.Helper()
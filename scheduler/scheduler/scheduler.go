@@ -20,13 +20,17 @@ package scheduler
 
 import (
 	"context"
-	"sort"
+	"encoding/json"
+	"reflect"
 	"time"
 
+	logger "d7y.io/dragonfly/v2/internal/dflog"
+	"d7y.io/dragonfly/v2/manager/types"
 	"d7y.io/dragonfly/v2/pkg/container/set"
 	"d7y.io/dragonfly/v2/pkg/rpc/base"
 	rpcscheduler "d7y.io/dragonfly/v2/pkg/rpc/scheduler"
 	"d7y.io/dragonfly/v2/scheduler/config"
+	"d7y.io/dragonfly/v2/scheduler/metrics"
 	"d7y.io/dragonfly/v2/scheduler/resource"
 	"d7y.io/dragonfly/v2/scheduler/scheduler/evaluator"
 )
@@ -39,6 +43,14 @@ const (
 	defaultDepthLimit = 4
 )
 
+const (
+	// GC admission id.
+	GCAdmissionID = "admission"
+
+	// AdmissionGCInterval is the interval of reclaiming back-to-source admission of idle origin hosts.
+	AdmissionGCInterval = 1 * time.Minute
+)
+
 type Scheduler interface {
 	// ScheduleParent schedule a parent and candidates to a peer.
 	ScheduleParent(context.Context, *resource.Peer, set.SafeSet)
@@ -48,6 +60,12 @@ type Scheduler interface {
 
 	// Find the parent that best matches the evaluation.
 	FindParent(context.Context, *resource.Peer, set.SafeSet) (*resource.Peer, bool)
+
+	// Try to reclaim back-to-source admission of idle origin hosts.
+	RunGC() error
+
+	// OnNotify swaps the weight profile of evaluator when dynconfig is changed.
+	OnNotify(*config.DynconfigData)
 }
 
 type scheduler struct {
@@ -59,19 +77,40 @@ type scheduler struct {
 
 	// Scheduler dynamic configuration.
 	dynconfig config.DynconfigInterface
+
+	// Back-to-source admission of origin hosts.
+	admission *admission
 }
 
 func New(cfg *config.SchedulerConfig, dynconfig config.DynconfigInterface, pluginDir string) Scheduler {
-	return &scheduler{
-		evaluator: evaluator.New(cfg.Algorithm, pluginDir),
+	s := &scheduler{
+		evaluator: evaluator.New(cfg.Algorithm, pluginDir,
+			evaluator.WithModelFile(cfg.ModelFile),
+			evaluator.WithRemoteAddr(cfg.RemoteEvaluator.Addr),
+			evaluator.WithRemoteTimeout(cfg.RemoteEvaluator.Timeout),
+		),
 		config:    cfg,
 		dynconfig: dynconfig,
+		admission: newAdmission(),
 	}
+
+	// Evaluator profile is swapped when dynconfig is changed.
+	dynconfig.Register(s)
+	return s
+}
+
+// RunGC reclaims back-to-source admission of idle origin hosts.
+func (s *scheduler) RunGC() error {
+	return s.admission.RunGC()
 }
 
 // ScheduleParent schedule a parent and candidates to a peer.
 func (s *scheduler) ScheduleParent(ctx context.Context, peer *resource.Peer, blocklist set.SafeSet) {
-	var n int
+	var (
+		n int
+		// waits is the times of peer waiting for back-to-source admission.
+		waits int
+	)
 	for {
 		select {
 		case <-ctx.Done():
@@ -85,8 +124,50 @@ func (s *scheduler) ScheduleParent(ctx context.Context, peer *resource.Peer, blo
 		needBackToSource := peer.NeedBackToSource.Load()
 		if (n >= s.config.RetryBackSourceLimit || needBackToSource) &&
 			peer.Task.CanBackToSource() {
+			// If the origin host does not admit peer to back-to-source,
+			// peer waits in the queue and tries to find parent again.
+			if !s.admitBackToSource(peer) {
+				if !peer.FSM.Is(resource.PeerStateRunning) {
+					peer.Log.Infof("peer state is %s, stop waiting for back-to-source admission", peer.FSM.Current())
+					return
+				}
+
+				// The peer stops waiting when it finds parent or the waiting exceeds the limit,
+				// so it is released to admit other peers to back-to-source.
+				if _, ok := s.NotifyAndFindParent(ctx, peer, blocklist); ok {
+					s.admission.Release(peer)
+					peer.Log.Info("peer waiting for back-to-source admission finds parent")
+					return
+				}
+
+				// Notify peer schedule failed when the waiting exceeds the limit,
+				// so the waiting peer is not blocked forever by the origin host.
+				waits++
+				if waits >= s.config.BackSourceAdmissionWaitLimit {
+					s.admission.Release(peer)
+					stream, ok := peer.LoadStream()
+					if !ok {
+						peer.Log.Error("load stream failed")
+						return
+					}
+
+					if err := stream.Send(&rpcscheduler.PeerPacket{Code: base.Code_SchedTaskStatusError}); err != nil {
+						peer.Log.Errorf("send packet failed: %s", err.Error())
+						return
+					}
+					peer.Log.Errorf("peer waiting for back-to-source admission exceeds the limit %d times and return code %d",
+						s.config.BackSourceAdmissionWaitLimit, base.Code_SchedTaskStatusError)
+					return
+				}
+
+				peer.Log.Infof("peer waits for back-to-source admission %d times", waits)
+				time.Sleep(s.config.RetryInterval)
+				continue
+			}
+
 			stream, ok := peer.LoadStream()
 			if !ok {
+				s.admission.Release(peer)
 				peer.Log.Error("load stream failed")
 				return
 			}
@@ -96,11 +177,13 @@ func (s *scheduler) ScheduleParent(ctx context.Context, peer *resource.Peer, blo
 
 			// Notify peer back-to-source.
 			if err := stream.Send(&rpcscheduler.PeerPacket{Code: base.Code_SchedNeedBackSource}); err != nil {
+				s.admission.Release(peer)
 				peer.Log.Errorf("send packet failed: %s", err.Error())
 				return
 			}
 
 			if err := peer.FSM.Event(resource.PeerEventDownloadFromBackToSource); err != nil {
+				s.admission.Release(peer)
 				peer.Log.Errorf("peer fsm event failed: %s", err.Error())
 				return
 			}
@@ -158,21 +241,35 @@ func (s *scheduler) NotifyAndFindParent(ctx context.Context, peer *resource.Peer
 		return []*resource.Peer{}, false
 	}
 
-	// Find the candidate parent that can be scheduled.
+	// Find the candidate parent that can be scheduled, high priority peer
+	// preempts the upload load of saturated host if there is no candidate parent.
 	candidateParents := s.filterCandidateParents(peer, blocklist)
+	if len(candidateParents) == 0 && s.preempt(ctx, peer, blocklist) {
+		candidateParents = s.filterCandidateParents(peer, blocklist)
+	}
+
 	if len(candidateParents) == 0 {
 		peer.Log.Info("can not find candidate parents")
 		return []*resource.Peer{}, false
 	}
 
 	// Sort candidate parents by evaluation score.
-	taskTotalPieceCount := peer.Task.TotalPieceCount.Load()
-	sort.Slice(
-		candidateParents,
-		func(i, j int) bool {
-			return s.evaluator.Evaluate(candidateParents[i], peer, taskTotalPieceCount) > s.evaluator.Evaluate(candidateParents[j], peer, taskTotalPieceCount)
-		},
-	)
+	candidateParents = evaluator.EvaluateParents(s.evaluator, candidateParents, peer, peer.Task.TotalPieceCount.Load())
+	if len(candidateParents) == 0 {
+		peer.Log.Info("can not find candidate parents after evaluation")
+		return []*resource.Peer{}, false
+	}
+
+	// Limit the number of parents that peer downloads from at the same time.
+	clusterConfig, _ := s.dynconfig.GetSchedulerClusterConfig()
+	candidateParentLimit := config.DefaultSchedulerCandidateParentLimit
+	if clusterConfig.CandidateParentLimit > 0 {
+		candidateParentLimit = int(clusterConfig.CandidateParentLimit)
+	}
+
+	if len(candidateParents) > candidateParentLimit {
+		candidateParents = candidateParents[:candidateParentLimit]
+	}
 
 	// Send scheduling success message.
 	stream, ok := peer.LoadStream()
@@ -181,7 +278,13 @@ func (s *scheduler) NotifyAndFindParent(ctx context.Context, peer *resource.Peer
 		return []*resource.Peer{}, false
 	}
 
-	if err := stream.Send(constructSuccessPeerPacket(s.dynconfig, peer, candidateParents[0], candidateParents[1:])); err != nil {
+	peerPacket := constructSuccessPeerPacket(s.dynconfig, peer, candidateParents[0], candidateParents[1:])
+	if clusterConfig.PieceRangeAssignment {
+		peerPacket.PieceRanges = assignPieceRanges(peer, candidateParents)
+		peer.Log.Infof("assign %d piece ranges to parents", len(peerPacket.PieceRanges))
+	}
+
+	if err := stream.Send(peerPacket); err != nil {
 		peer.Log.Error(err)
 		return []*resource.Peer{}, false
 	}
@@ -192,10 +295,11 @@ func (s *scheduler) NotifyAndFindParent(ctx context.Context, peer *resource.Peer
 		peer.StealPeers.Add(candidateParent.ID)
 	}
 
-	// Replace peer's parent with scheduled parent.
-	peer.ReplaceParent(candidateParents[0])
-	peer.Log.Infof("schedule parent successful, replace parent to %s and steal peers is %v",
-		candidateParents[0].ID, peer.StealPeers.Values())
+	// Replace peer's parents with scheduled parents, the first one is
+	// the main parent and others are steal peers.
+	peer.ReplaceParents(candidateParents)
+	peer.Log.Infof("schedule parent successful with evaluator profile %s, replace main parent to %s and steal peers is %v",
+		s.evaluatorProfileName(), candidateParents[0].ID, peer.StealPeers.Values())
 	peer.Log.Debugf("peer ancestors is %v", peer.Ancestors())
 	return candidateParents, true
 }
@@ -204,28 +308,130 @@ func (s *scheduler) NotifyAndFindParent(ctx context.Context, peer *resource.Peer
 func (s *scheduler) FindParent(ctx context.Context, peer *resource.Peer, blocklist set.SafeSet) (*resource.Peer, bool) {
 	// Filter the candidate parent that can be scheduled.
 	candidateParents := s.filterCandidateParents(peer, blocklist)
+	if len(candidateParents) == 0 && s.preempt(ctx, peer, blocklist) {
+		candidateParents = s.filterCandidateParents(peer, blocklist)
+	}
+
 	if len(candidateParents) == 0 {
 		peer.Log.Info("can not find candidate parents")
 		return nil, false
 	}
 
 	// Sort candidate parents by evaluation score.
-	taskTotalPieceCount := peer.Task.TotalPieceCount.Load()
-	sort.Slice(
-		candidateParents,
-		func(i, j int) bool {
-			return s.evaluator.Evaluate(candidateParents[i], peer, taskTotalPieceCount) > s.evaluator.Evaluate(candidateParents[j], peer, taskTotalPieceCount)
-		},
-	)
+	candidateParents = evaluator.EvaluateParents(s.evaluator, candidateParents, peer, peer.Task.TotalPieceCount.Load())
+	if len(candidateParents) == 0 {
+		peer.Log.Info("can not find candidate parents after evaluation")
+		return nil, false
+	}
 
-	peer.Log.Infof("find parent %s successful", candidateParents[0].ID)
+	peer.Log.Infof("find parent %s successful with evaluator profile %s", candidateParents[0].ID, s.evaluatorProfileName())
 	return candidateParents[0], true
 }
 
+// OnNotify swaps the weight profile of evaluator to the profile in scheduler cluster config.
+func (s *scheduler) OnNotify(data *config.DynconfigData) {
+	profiler, ok := s.evaluator.(evaluator.Profiler)
+	if !ok {
+		return
+	}
+
+	var clusterConfig types.SchedulerClusterConfig
+	if data.SchedulerCluster != nil {
+		if err := json.Unmarshal(data.SchedulerCluster.Config, &clusterConfig); err != nil {
+			logger.Errorf("unmarshal scheduler cluster config failed: %s", err.Error())
+			return
+		}
+	}
+
+	profile := newEvaluatorProfile(clusterConfig)
+	if profile.Name != clusterConfig.EvaluatorProfile && clusterConfig.EvaluatorProfile != "" {
+		logger.Warnf("evaluator profile %s is not found, use profile %s", clusterConfig.EvaluatorProfile, profile.Name)
+	}
+
+	if current := profiler.Profile(); !reflect.DeepEqual(current, profile) {
+		logger.Infof("swap evaluator profile from %s to %s", current.Name, profile.Name)
+		profiler.SetProfile(profile)
+	}
+}
+
+// evaluatorProfileName returns the name of weight profile in use,
+// the evaluator without weight profile returns its algorithm.
+func (s *scheduler) evaluatorProfileName() string {
+	if profiler, ok := s.evaluator.(evaluator.Profiler); ok {
+		return profiler.Profile().Name
+	}
+
+	return s.config.Algorithm
+}
+
+// newEvaluatorProfile returns the weight profile of evaluator by the name in scheduler cluster config,
+// the custom profiles in scheduler cluster config take precedence over the builtin profiles.
+func newEvaluatorProfile(clusterConfig types.SchedulerClusterConfig) evaluator.Profile {
+	name := clusterConfig.EvaluatorProfile
+	if name == "" {
+		name = evaluator.DefaultProfileName
+	}
+
+	for _, p := range clusterConfig.EvaluatorProfiles {
+		if p.Name != name {
+			continue
+		}
+
+		profile := evaluator.Profile{
+			Name:                      p.Name,
+			FinishedPieceWeight:       p.FinishedPieceWeight,
+			FreeLoadWeight:            p.FreeLoadWeight,
+			HostTypeAffinityWeight:    p.HostTypeAffinityWeight,
+			IDCAffinityWeight:         p.IDCAffinityWeight,
+			NetTopologyAffinityWeight: p.NetTopologyAffinityWeight,
+			LocationAffinityWeight:    p.LocationAffinityWeight,
+			NetworkDistanceWeight:     p.NetworkDistanceWeight,
+			BandwidthWeight:           p.BandwidthWeight,
+		}
+
+		for _, r := range p.Rules {
+			profile.Rules = append(profile.Rules, evaluator.Rule{
+				HostType:    r.HostType,
+				IDC:         r.IDC,
+				NetTopology: r.NetTopology,
+				Location:    r.Location,
+				Score:       r.Score,
+			})
+		}
+
+		return profile
+	}
+
+	if profile, ok := evaluator.BuiltinProfile(name); ok {
+		return profile
+	}
+
+	return evaluator.DefaultProfile
+}
+
+// admitBackToSource determines whether the origin host of task admits peer to back-to-source.
+func (s *scheduler) admitBackToSource(peer *resource.Peer) bool {
+	var (
+		concurrentLimit uint32
+		rateLimit       uint64
+	)
+	if config, ok := s.dynconfig.GetSchedulerClusterConfig(); ok {
+		concurrentLimit = config.BackToSourceConcurrentLimit
+		rateLimit = config.BackToSourceRateLimit
+	}
+
+	if !s.admission.Admit(peer, concurrentLimit, rateLimit) {
+		metrics.BackToSourceNotAdmittedCount.Inc()
+		return false
+	}
+
+	return true
+}
+
 // Filter the candidate parent that can be scheduled.
 func (s *scheduler) filterCandidateParents(peer *resource.Peer, blocklist set.SafeSet) []*resource.Peer {
 	filterParentLimit := config.DefaultSchedulerFilterParentLimit
-	if config, ok := s.dynconfig.GetSchedulerClusterConfig(); ok && filterParentLimit > 0 {
+	if config, ok := s.dynconfig.GetSchedulerClusterConfig(); ok && config.FilterParentLimit > 0 {
 		filterParentLimit = int(config.FilterParentLimit)
 	}
 
@@ -255,6 +461,24 @@ func (s *scheduler) filterCandidateParents(peer *resource.Peer, blocklist set.Sa
 			return true
 		}
 
+		// Candidate parent's host is cordoned for maintenance.
+		if candidateParent.Host.Cordoned.Load() {
+			peer.Log.Debugf("candidate parent %s is not selected because its host %s is cordoned", candidateParent.ID, candidateParent.Host.ID)
+			return true
+		}
+
+		// Candidate parent is quarantined because of corrupted data.
+		if candidateParent.Quarantined.Load() {
+			peer.Log.Debugf("candidate parent %s is not selected because it is quarantined", candidateParent.ID)
+			return true
+		}
+
+		// Candidate parent's host is quarantined because of corrupted data.
+		if candidateParent.Host.Quarantined.Load() {
+			peer.Log.Debugf("candidate parent %s is not selected because its host %s is quarantined", candidateParent.ID, candidateParent.Host.ID)
+			return true
+		}
+
 		// Candidate parent is bad node.
 		if s.evaluator.IsBadNode(candidateParent) {
 			peer.Log.Debugf("candidate parent %s is not selected because it is bad node", candidateParent.ID)
@@ -295,14 +519,16 @@ func (s *scheduler) filterCandidateParents(peer *resource.Peer, blocklist set.Sa
 			return true
 		}
 
-		// Candidate parent is an ancestor of peer.
-		if candidateParent.IsAncestor(peer) {
+		// Candidate parent is an ancestor of peer but not the parent of peer,
+		// the parent of peer can be scheduled again.
+		if _, ok := peer.LoadParentByID(candidateParent.ID); !ok && candidateParent.IsAncestor(peer) {
 			peer.Log.Debugf("candidate parent %s is not selected because it is ancestor", candidateParent.ID)
 			return true
 		}
 
-		// Candidate parent's free upload is empty.
-		if candidateParent.Host.FreeUploadLoad() <= 0 {
+		// Candidate parent's free upload is empty, the reserved upload load
+		// of host is only available for high priority peer.
+		if candidateParent.Host.FreeUploadLoad() <= s.reservedUploadLoad(peer, candidateParent.Host) {
 			peer.Log.Debugf("candidate parent %s is not selected because its free upload is empty, upload limit is %d, upload peer count is %d",
 				candidateParent.ID, candidateParent.Host.UploadLoadLimit.Load(), candidateParent.Host.UploadPeerCount.Load())
 			return true
@@ -317,6 +543,68 @@ func (s *scheduler) filterCandidateParents(peer *resource.Peer, blocklist set.Sa
 	return candidateParents
 }
 
+// reservedUploadLoad returns the upload load of host reserved for high priority peers,
+// high priority peer can use all the upload load of host.
+func (s *scheduler) reservedUploadLoad(peer *resource.Peer, host *resource.Host) int32 {
+	if peer.Priority == base.Priority_HIGH_PRIORITY {
+		return 0
+	}
+
+	return int32(float64(host.UploadLoadLimit.Load()) * s.config.ReservedUploadLoadRatio)
+}
+
+// preempt reschedules a low priority child downloading from the saturated host
+// of candidate parent to other parents, then the upload load of host is released
+// for the high priority peer.
+func (s *scheduler) preempt(ctx context.Context, peer *resource.Peer, blocklist set.SafeSet) bool {
+	if !s.config.Preemption || peer.Priority != base.Priority_HIGH_PRIORITY {
+		return false
+	}
+
+	var preempted bool
+	peer.Task.Peers.Range(func(_, value any) bool {
+		candidateParent, ok := value.(*resource.Peer)
+		if !ok || candidateParent.ID == peer.ID || blocklist.Contains(candidateParent.ID) ||
+			candidateParent.Host.Cordoned.Load() || candidateParent.Quarantined.Load() || candidateParent.Host.Quarantined.Load() ||
+			s.evaluator.IsBadNode(candidateParent) || candidateParent.Host.FreeUploadLoad() > 0 {
+			return true
+		}
+
+		// The upload load of host is held by the children of any task, so the parents
+		// on the saturated host are found by the upload peers of host.
+		candidateParent.Host.UploadPeers.Range(func(_, value any) bool {
+			parent, ok := value.(*resource.Peer)
+			if !ok {
+				return true
+			}
+
+			for _, child := range parent.Children() {
+				if child.Priority != base.Priority_LOW_PRIORITY || !child.FSM.Is(resource.PeerStateRunning) {
+					continue
+				}
+
+				// Low priority child is rescheduled to the parents on other hosts.
+				childBlocklist := set.NewSafeSet()
+				childBlocklist.Add(parent.ID)
+				if _, ok := s.NotifyAndFindParent(ctx, child, childBlocklist); !ok {
+					continue
+				}
+
+				metrics.PreemptPeerCount.Inc()
+				peer.Log.Infof("preempt upload load of host %s from low priority peer %s", candidateParent.Host.ID, child.ID)
+				preempted = true
+				return false
+			}
+
+			return true
+		})
+
+		return !preempted
+	})
+
+	return preempted
+}
+
 // Construct peer successful packet.
 func constructSuccessPeerPacket(dynconfig config.DynconfigInterface, peer *resource.Peer, parent *resource.Peer, candidateParents []*resource.Peer) *rpcscheduler.PeerPacket {
 	parallelCount := config.DefaultClientParallelCount
@@ -346,3 +634,65 @@ func constructSuccessPeerPacket(dynconfig config.DynconfigInterface, peer *resou
 		Code:       base.Code_Success,
 	}
 }
+
+// assignPieceRanges assigns the pieces of task to parents by finished pieces of parents,
+// the continuous pieces assigned to the same parent are merged into a range. Pieces finished by
+// peer and pieces not finished by any parent are not assigned, peer selects them freely.
+func assignPieceRanges(peer *resource.Peer, parents []*resource.Peer) []*rpcscheduler.PeerPacket_PieceRange {
+	totalPieceCount := peer.Task.TotalPieceCount.Load()
+	if totalPieceCount <= 0 || len(parents) == 0 {
+		return nil
+	}
+
+	var pieceNums []int32
+	for num := int32(0); num < totalPieceCount; num++ {
+		if !peer.Pieces.Test(uint(num)) {
+			pieceNums = append(pieceNums, num)
+		}
+	}
+
+	// Pieces are evenly assigned to parents as far as possible.
+	limit := (len(pieceNums) + len(parents) - 1) / len(parents)
+	counts := make([]int, len(parents))
+
+	var (
+		pieceRanges []*rpcscheduler.PeerPacket_PieceRange
+		pieceRange  *rpcscheduler.PeerPacket_PieceRange
+		last        = -1
+	)
+	for _, num := range pieceNums {
+		// Keep the last parent to make the range continuous, otherwise
+		// select the parent with the fewest assigned pieces.
+		n := -1
+		if last >= 0 && counts[last] < limit && pieceRange.EndNum == num && parents[last].Pieces.Test(uint(num)) {
+			n = last
+		} else {
+			for i, parent := range parents {
+				if parent.Pieces.Test(uint(num)) && (n < 0 || counts[i] < counts[n]) {
+					n = i
+				}
+			}
+		}
+
+		if n < 0 {
+			last = -1
+			continue
+		}
+		counts[n]++
+
+		if n == last && pieceRange.EndNum == num {
+			pieceRange.EndNum++
+			continue
+		}
+
+		pieceRange = &rpcscheduler.PeerPacket_PieceRange{
+			PeerId:   parents[n].ID,
+			StartNum: num,
+			EndNum:   num + 1,
+		}
+		pieceRanges = append(pieceRanges, pieceRange)
+		last = n
+	}
+
+	return pieceRanges
+}
@@ -42,11 +42,12 @@ import (
 var (
 	mockPluginDir       = "plugin_dir"
 	mockSchedulerConfig = &config.SchedulerConfig{
-		RetryLimit:           2,
-		RetryBackSourceLimit: 1,
-		RetryInterval:        10 * time.Millisecond,
-		BackSourceCount:      int(mockTaskBackToSourceLimit),
-		Algorithm:            evaluator.DefaultAlgorithm,
+		RetryLimit:                   2,
+		RetryBackSourceLimit:         1,
+		RetryInterval:                10 * time.Millisecond,
+		BackSourceCount:              int(mockTaskBackToSourceLimit),
+		Algorithm:                    evaluator.DefaultAlgorithm,
+		BackSourceAdmissionWaitLimit: 2,
 	}
 	mockRawHost = &rpcscheduler.PeerHost{
 		Id:             idgen.HostID("hostname", 8003),
@@ -119,6 +120,7 @@ func TestScheduler_New(t *testing.T) {
 			defer ctl.Finish()
 			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
 
+			dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1)
 			tc.expect(t, New(mockSchedulerConfig, dynconfig, tc.pluginDir))
 		})
 	}
@@ -148,6 +150,7 @@ func TestScheduler_ScheduleParent(t *testing.T) {
 				task.StorePeer(peer)
 				peer.NeedBackToSource.Store(true)
 				peer.FSM.SetState(resource.PeerStateRunning)
+				md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(1)
 			},
 			expect: func(t *testing.T, peer *resource.Peer) {
 				assert := assert.New(t)
@@ -163,7 +166,10 @@ func TestScheduler_ScheduleParent(t *testing.T) {
 				peer.FSM.SetState(resource.PeerStateRunning)
 				peer.StoreStream(stream)
 
-				mr.Send(gomock.Eq(&rpcscheduler.PeerPacket{Code: base.Code_SchedNeedBackSource})).Return(errors.New("foo")).Times(1)
+				gomock.InOrder(
+					md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(1),
+					mr.Send(gomock.Eq(&rpcscheduler.PeerPacket{Code: base.Code_SchedNeedBackSource})).Return(errors.New("foo")).Times(1),
+				)
 			},
 			expect: func(t *testing.T, peer *resource.Peer) {
 				assert := assert.New(t)
@@ -181,7 +187,10 @@ func TestScheduler_ScheduleParent(t *testing.T) {
 				peer.FSM.SetState(resource.PeerStateRunning)
 				peer.StoreStream(stream)
 
-				mr.Send(gomock.Eq(&rpcscheduler.PeerPacket{Code: base.Code_SchedNeedBackSource})).Return(nil).Times(1)
+				gomock.InOrder(
+					md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(1),
+					mr.Send(gomock.Eq(&rpcscheduler.PeerPacket{Code: base.Code_SchedNeedBackSource})).Return(nil).Times(1),
+				)
 			},
 			expect: func(t *testing.T, peer *resource.Peer) {
 				assert := assert.New(t)
@@ -201,7 +210,10 @@ func TestScheduler_ScheduleParent(t *testing.T) {
 				task.FSM.SetState(resource.TaskStateFailed)
 				peer.StoreStream(stream)
 
-				mr.Send(gomock.Eq(&rpcscheduler.PeerPacket{Code: base.Code_SchedNeedBackSource})).Return(nil).Times(1)
+				gomock.InOrder(
+					md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(1),
+					mr.Send(gomock.Eq(&rpcscheduler.PeerPacket{Code: base.Code_SchedNeedBackSource})).Return(nil).Times(1),
+				)
 			},
 			expect: func(t *testing.T, peer *resource.Peer) {
 				assert := assert.New(t)
@@ -217,7 +229,7 @@ func TestScheduler_ScheduleParent(t *testing.T) {
 				task := peer.Task
 				task.StorePeer(peer)
 				peer.FSM.SetState(resource.PeerStateRunning)
-				md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(1)
+				md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(2)
 			},
 			expect: func(t *testing.T, peer *resource.Peer) {
 				assert := assert.New(t)
@@ -292,7 +304,7 @@ func TestScheduler_ScheduleParent(t *testing.T) {
 				seedPeer.FSM.SetState(resource.PeerStateRunning)
 				peer.StoreStream(stream)
 				gomock.InOrder(
-					md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(1),
+					md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(2),
 					md.GetSchedulerClusterClientConfig().Return(types.SchedulerClusterClientConfig{
 						ParallelCount: 2,
 					}, true).Times(1),
@@ -323,6 +335,7 @@ func TestScheduler_ScheduleParent(t *testing.T) {
 			blocklist := set.NewSafeSet()
 
 			tc.mock(cancel, peer, seedPeer, blocklist, stream, stream.EXPECT(), dynconfig.EXPECT())
+			dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1)
 			scheduler := New(mockSchedulerConfig, dynconfig, mockPluginDir)
 			scheduler.ScheduleParent(ctx, peer, blocklist)
 			tc.expect(t, peer)
@@ -330,6 +343,100 @@ func TestScheduler_ScheduleParent(t *testing.T) {
 	}
 }
 
+func TestScheduler_ScheduleParentWithAdmission(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(peer *resource.Peer, admittedPeer *resource.Peer, stream rpcscheduler.Scheduler_ReportPieceResultServer, mr *rpcschedulermocks.MockScheduler_ReportPieceResultServerMockRecorder)
+		expect func(t *testing.T, s *scheduler, peer *resource.Peer, admittedPeer *resource.Peer)
+	}{
+		{
+			name: "peer waits for admission and send Code_SchedTaskStatusError code when exceeds limit",
+			mock: func(peer *resource.Peer, admittedPeer *resource.Peer, stream rpcscheduler.Scheduler_ReportPieceResultServer, mr *rpcschedulermocks.MockScheduler_ReportPieceResultServerMockRecorder) {
+				peer.StoreStream(stream)
+				mr.Send(gomock.Eq(&rpcscheduler.PeerPacket{Code: base.Code_SchedTaskStatusError})).Return(nil).Times(1)
+			},
+			expect: func(t *testing.T, s *scheduler, peer *resource.Peer, admittedPeer *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(peer.FSM.Is(resource.PeerStateRunning))
+			},
+		},
+		{
+			name: "peer waits for admission and peer stream load failed when exceeds limit",
+			mock: func(peer *resource.Peer, admittedPeer *resource.Peer, stream rpcscheduler.Scheduler_ReportPieceResultServer, mr *rpcschedulermocks.MockScheduler_ReportPieceResultServerMockRecorder) {
+				mr.Send(gomock.Any()).Times(0)
+			},
+			expect: func(t *testing.T, s *scheduler, peer *resource.Peer, admittedPeer *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(peer.FSM.Is(resource.PeerStateRunning))
+			},
+		},
+		{
+			name: "peer is admitted after admitted peer finishes",
+			mock: func(peer *resource.Peer, admittedPeer *resource.Peer, stream rpcscheduler.Scheduler_ReportPieceResultServer, mr *rpcschedulermocks.MockScheduler_ReportPieceResultServerMockRecorder) {
+				peer.StoreStream(stream)
+				admittedPeer.FSM.SetState(resource.PeerStateSucceeded)
+				mr.Send(gomock.Eq(&rpcscheduler.PeerPacket{Code: base.Code_SchedNeedBackSource})).Return(nil).Times(1)
+			},
+			expect: func(t *testing.T, s *scheduler, peer *resource.Peer, admittedPeer *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(peer.FSM.Is(resource.PeerStateBackToSource))
+			},
+		},
+		{
+			name: "high priority peer waiting for admission finds parent and normal peer is admitted",
+			mock: func(peer *resource.Peer, admittedPeer *resource.Peer, stream rpcscheduler.Scheduler_ReportPieceResultServer, mr *rpcschedulermocks.MockScheduler_ReportPieceResultServerMockRecorder) {
+				peer.Priority = base.Priority_HIGH_PRIORITY
+				peer.StoreStream(stream)
+				peer.Task.StorePeer(admittedPeer)
+				peer.Task.BackToSourcePeers.Add(admittedPeer)
+				admittedPeer.IsBackToSource.Store(true)
+				admittedPeer.Pieces.Set(0)
+				mr.Send(gomock.Any()).Return(nil).Times(1)
+			},
+			expect: func(t *testing.T, s *scheduler, peer *resource.Peer, admittedPeer *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(peer.FSM.Is(resource.PeerStateRunning))
+				assert.Equal(len(peer.Parents()), 1)
+
+				admittedPeer.FSM.SetState(resource.PeerStateSucceeded)
+				normalPeer := resource.NewPeer(idgen.PeerID("127.0.0.3"), peer.Task, peer.Host)
+				normalPeer.FSM.SetState(resource.PeerStateRunning)
+				assert.True(s.admission.Admit(normalPeer, 1, 0))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			stream := rpcschedulermocks.NewMockScheduler_ReportPieceResultServer(ctl)
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			dynconfig.EXPECT().GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{BackToSourceConcurrentLimit: 1}, true).AnyTimes()
+			dynconfig.EXPECT().GetSchedulerClusterClientConfig().Return(types.SchedulerClusterClientConfig{}, false).AnyTimes()
+
+			mockHost := resource.NewHost(mockRawHost)
+			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+			peer := resource.NewPeer(mockPeerID, mockTask, mockHost)
+			peer.NeedBackToSource.Store(true)
+			peer.FSM.SetState(resource.PeerStateRunning)
+			mockTask.StorePeer(peer)
+			admittedPeer := resource.NewPeer(idgen.PeerID("127.0.0.2"), mockTask, mockHost)
+			admittedPeer.FSM.SetState(resource.PeerStateRunning)
+
+			dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1)
+			s := New(mockSchedulerConfig, dynconfig, mockPluginDir).(*scheduler)
+			if !s.admission.Admit(admittedPeer, 1, 0) {
+				t.Fatal("admit peer failed")
+			}
+
+			tc.mock(peer, admittedPeer, stream, stream.EXPECT())
+			s.ScheduleParent(context.Background(), peer, set.NewSafeSet())
+			tc.expect(t, s, peer, admittedPeer)
+		})
+	}
+}
+
 func TestScheduler_NotifyAndFindParent(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -542,7 +649,7 @@ func TestScheduler_NotifyAndFindParent(t *testing.T) {
 				mockPeer.Pieces.Set(0)
 				peer.StoreStream(stream)
 				gomock.InOrder(
-					md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(1),
+					md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(2),
 					md.GetSchedulerClusterClientConfig().Return(types.SchedulerClusterClientConfig{
 						ParallelCount: 2,
 					}, true).Times(1),
@@ -572,7 +679,7 @@ func TestScheduler_NotifyAndFindParent(t *testing.T) {
 				mockPeer.Pieces.Set(0)
 				peer.StoreStream(stream)
 				gomock.InOrder(
-					md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(1),
+					md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(2),
 					md.GetSchedulerClusterClientConfig().Return(types.SchedulerClusterClientConfig{
 						ParallelCount: 2,
 					}, true).Times(1),
@@ -586,6 +693,41 @@ func TestScheduler_NotifyAndFindParent(t *testing.T) {
 				assert.True(ok)
 			},
 		},
+		{
+			name: "schedule parent with candidate parent limit",
+			mock: func(peer *resource.Peer, mockPeer *resource.Peer, blocklist set.SafeSet, stream rpcscheduler.Scheduler_ReportPieceResultServer, dynconfig config.DynconfigInterface, ms *rpcschedulermocks.MockScheduler_ReportPieceResultServerMockRecorder, md *configmocks.MockDynconfigInterfaceMockRecorder) {
+				peer.FSM.SetState(resource.PeerStateRunning)
+				mockPeer.FSM.SetState(resource.PeerStateRunning)
+				mockHost := resource.NewHost(mockRawHost)
+				stealPeer := resource.NewPeer(idgen.PeerID("127.0.0.2"), peer.Task, mockHost)
+				stealPeer.FSM.SetState(resource.PeerStateRunning)
+				peer.Task.StorePeer(mockPeer)
+				peer.Task.StorePeer(stealPeer)
+				peer.Task.BackToSourcePeers.Add(mockPeer)
+				peer.Task.BackToSourcePeers.Add(stealPeer)
+				mockPeer.IsBackToSource.Store(true)
+				stealPeer.IsBackToSource.Store(true)
+				mockPeer.Pieces.Set(0)
+				peer.StoreStream(stream)
+				gomock.InOrder(
+					md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{
+						FilterParentLimit:    10,
+						CandidateParentLimit: 1,
+					}, true).Times(2),
+					md.GetSchedulerClusterClientConfig().Return(types.SchedulerClusterClientConfig{
+						ParallelCount: 2,
+					}, true).Times(1),
+					ms.Send(gomock.Any()).Return(nil).Times(1),
+				)
+			},
+			expect: func(t *testing.T, peer *resource.Peer, parents []*resource.Peer, ok bool) {
+				assert := assert.New(t)
+				assert.Equal(len(parents), 1)
+				assert.Equal(len(peer.Parents()), 1)
+				assert.Equal(peer.StealPeers.Len(), uint(0))
+				assert.True(ok)
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -601,6 +743,7 @@ func TestScheduler_NotifyAndFindParent(t *testing.T) {
 			blocklist := set.NewSafeSet()
 
 			tc.mock(peer, mockPeer, blocklist, stream, dynconfig, stream.EXPECT(), dynconfig.EXPECT())
+			dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1)
 			scheduler := New(mockSchedulerConfig, dynconfig, mockPluginDir)
 			parents, ok := scheduler.NotifyAndFindParent(context.Background(), peer, blocklist)
 			tc.expect(t, peer, parents, ok)
@@ -697,6 +840,54 @@ func TestScheduler_FindParent(t *testing.T) {
 				assert.False(ok)
 			},
 		},
+		{
+			name: "parent host is cordoned",
+			mock: func(peer *resource.Peer, mockPeers []*resource.Peer, blocklist set.SafeSet, md *configmocks.MockDynconfigInterfaceMockRecorder) {
+				peer.FSM.SetState(resource.PeerStateRunning)
+				mockPeers[0].FSM.SetState(resource.PeerStateRunning)
+				peer.Task.StorePeer(mockPeers[0])
+				mockPeers[0].IsBackToSource.Store(true)
+				mockPeers[0].Host.Cordoned.Store(true)
+
+				md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(1)
+			},
+			expect: func(t *testing.T, mockPeers []*resource.Peer, parent *resource.Peer, ok bool) {
+				assert := assert.New(t)
+				assert.False(ok)
+			},
+		},
+		{
+			name: "parent is quarantined",
+			mock: func(peer *resource.Peer, mockPeers []*resource.Peer, blocklist set.SafeSet, md *configmocks.MockDynconfigInterfaceMockRecorder) {
+				peer.FSM.SetState(resource.PeerStateRunning)
+				mockPeers[0].FSM.SetState(resource.PeerStateRunning)
+				peer.Task.StorePeer(mockPeers[0])
+				mockPeers[0].IsBackToSource.Store(true)
+				mockPeers[0].Quarantined.Store(true)
+
+				md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(1)
+			},
+			expect: func(t *testing.T, mockPeers []*resource.Peer, parent *resource.Peer, ok bool) {
+				assert := assert.New(t)
+				assert.False(ok)
+			},
+		},
+		{
+			name: "parent host is quarantined",
+			mock: func(peer *resource.Peer, mockPeers []*resource.Peer, blocklist set.SafeSet, md *configmocks.MockDynconfigInterfaceMockRecorder) {
+				peer.FSM.SetState(resource.PeerStateRunning)
+				mockPeers[0].FSM.SetState(resource.PeerStateRunning)
+				peer.Task.StorePeer(mockPeers[0])
+				mockPeers[0].IsBackToSource.Store(true)
+				mockPeers[0].Host.Quarantined.Store(true)
+
+				md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(1)
+			},
+			expect: func(t *testing.T, mockPeers []*resource.Peer, parent *resource.Peer, ok bool) {
+				assert := assert.New(t)
+				assert.False(ok)
+			},
+		},
 		{
 			name: "find back-to-source parent",
 			mock: func(peer *resource.Peer, mockPeers []*resource.Peer, blocklist set.SafeSet, md *configmocks.MockDynconfigInterfaceMockRecorder) {
@@ -852,6 +1043,7 @@ func TestScheduler_FindParent(t *testing.T) {
 
 			blocklist := set.NewSafeSet()
 			tc.mock(peer, mockPeers, blocklist, dynconfig.EXPECT())
+			dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1)
 			scheduler := New(mockSchedulerConfig, dynconfig, mockPluginDir)
 			parent, ok := scheduler.FindParent(context.Background(), peer, blocklist)
 			tc.expect(t, mockPeers, parent, ok)
@@ -859,6 +1051,418 @@ func TestScheduler_FindParent(t *testing.T) {
 	}
 }
 
+func TestScheduler_newEvaluatorProfile(t *testing.T) {
+	tests := []struct {
+		name   string
+		config types.SchedulerClusterConfig
+		expect func(t *testing.T, profile evaluator.Profile)
+	}{
+		{
+			name:   "evaluator profile is empty",
+			config: types.SchedulerClusterConfig{},
+			expect: func(t *testing.T, profile evaluator.Profile) {
+				assert := assert.New(t)
+				assert.Equal(profile, evaluator.DefaultProfile)
+			},
+		},
+		{
+			name: "evaluator profile is builtin profile",
+			config: types.SchedulerClusterConfig{
+				EvaluatorProfile: evaluator.SingleIDCProfileName,
+			},
+			expect: func(t *testing.T, profile evaluator.Profile) {
+				assert := assert.New(t)
+				assert.Equal(profile, evaluator.SingleIDCProfile)
+			},
+		},
+		{
+			name: "evaluator profile is custom profile",
+			config: types.SchedulerClusterConfig{
+				EvaluatorProfile: evaluator.SingleIDCProfileName,
+				EvaluatorProfiles: []types.SchedulerClusterEvaluatorProfile{
+					{
+						Name:                "foo",
+						FinishedPieceWeight: 1,
+					},
+					{
+						Name:                evaluator.SingleIDCProfileName,
+						FinishedPieceWeight: 0.5,
+						FreeLoadWeight:      0.5,
+						Rules: []types.SchedulerClusterEvaluatorRule{
+							{
+								HostType: "super",
+								Score:    0.1,
+							},
+						},
+					},
+				},
+			},
+			expect: func(t *testing.T, profile evaluator.Profile) {
+				assert := assert.New(t)
+				assert.Equal(profile, evaluator.Profile{
+					Name:                evaluator.SingleIDCProfileName,
+					FinishedPieceWeight: 0.5,
+					FreeLoadWeight:      0.5,
+					Rules: []evaluator.Rule{
+						{
+							HostType: "super",
+							Score:    0.1,
+						},
+					},
+				})
+			},
+		},
+		{
+			name: "evaluator profile can not be found",
+			config: types.SchedulerClusterConfig{
+				EvaluatorProfile: "foo",
+			},
+			expect: func(t *testing.T, profile evaluator.Profile) {
+				assert := assert.New(t)
+				assert.Equal(profile, evaluator.DefaultProfile)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expect(t, newEvaluatorProfile(tc.config))
+		})
+	}
+}
+
+func TestScheduler_OnNotify(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   *config.DynconfigData
+		expect func(t *testing.T, profile evaluator.Profile)
+	}{
+		{
+			name: "scheduler cluster is empty",
+			data: &config.DynconfigData{},
+			expect: func(t *testing.T, profile evaluator.Profile) {
+				assert := assert.New(t)
+				assert.Equal(profile, evaluator.DefaultProfile)
+			},
+		},
+		{
+			name: "swap to builtin profile",
+			data: &config.DynconfigData{
+				SchedulerCluster: &config.SchedulerCluster{
+					Config: []byte(`{"evaluator_profile":"single-idc"}`),
+				},
+			},
+			expect: func(t *testing.T, profile evaluator.Profile) {
+				assert := assert.New(t)
+				assert.Equal(profile, evaluator.SingleIDCProfile)
+			},
+		},
+		{
+			name: "scheduler cluster config is invalid",
+			data: &config.DynconfigData{
+				SchedulerCluster: &config.SchedulerCluster{
+					Config: []byte("foo"),
+				},
+			},
+			expect: func(t *testing.T, profile evaluator.Profile) {
+				assert := assert.New(t)
+				assert.Equal(profile, evaluator.DefaultProfile)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1)
+			s := New(mockSchedulerConfig, dynconfig, mockPluginDir).(*scheduler)
+			s.OnNotify(tc.data)
+			tc.expect(t, s.evaluator.(evaluator.Profiler).Profile())
+		})
+	}
+}
+
+func TestScheduler_reservedUploadLoad(t *testing.T) {
+	tests := []struct {
+		name     string
+		ratio    float64
+		priority base.Priority
+		expect   int32
+	}{
+		{
+			name:     "upload load is not reserved",
+			ratio:    0,
+			priority: base.Priority_DEFAULT_PRIORITY,
+			expect:   0,
+		},
+		{
+			name:     "upload load is reserved for high priority peer",
+			ratio:    0.1,
+			priority: base.Priority_LOW_PRIORITY,
+			expect:   5,
+		},
+		{
+			name:     "high priority peer uses reserved upload load",
+			ratio:    0.1,
+			priority: base.Priority_HIGH_PRIORITY,
+			expect:   0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			mockHost := resource.NewHost(mockRawHost, resource.WithUploadLoadLimit(50))
+			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta)
+			peer := resource.NewPeer(mockPeerID, mockTask, mockHost, resource.WithPriority(tc.priority))
+
+			dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1)
+			s := New(&config.SchedulerConfig{ReservedUploadLoadRatio: tc.ratio}, dynconfig, mockPluginDir).(*scheduler)
+			assert := assert.New(t)
+			assert.Equal(s.reservedUploadLoad(peer, mockHost), tc.expect)
+		})
+	}
+}
+
+func TestScheduler_preempt(t *testing.T) {
+	tests := []struct {
+		name       string
+		preemption bool
+		priority   base.Priority
+		mock       func(child *resource.Peer, stream rpcscheduler.Scheduler_ReportPieceResultServer, ms *rpcschedulermocks.MockScheduler_ReportPieceResultServerMockRecorder, md *configmocks.MockDynconfigInterfaceMockRecorder)
+		expect     func(t *testing.T, ok bool, child, parent, otherParent *resource.Peer)
+	}{
+		{
+			name:       "preemption is disabled",
+			preemption: false,
+			priority:   base.Priority_HIGH_PRIORITY,
+			mock: func(child *resource.Peer, stream rpcscheduler.Scheduler_ReportPieceResultServer, ms *rpcschedulermocks.MockScheduler_ReportPieceResultServerMockRecorder, md *configmocks.MockDynconfigInterfaceMockRecorder) {
+				child.Priority = base.Priority_LOW_PRIORITY
+			},
+			expect: func(t *testing.T, ok bool, child, parent, otherParent *resource.Peer) {
+				assert := assert.New(t)
+				assert.False(ok)
+				assert.Equal(parent.Host.FreeUploadLoad(), int32(0))
+			},
+		},
+		{
+			name:       "peer is not high priority",
+			preemption: true,
+			priority:   base.Priority_DEFAULT_PRIORITY,
+			mock: func(child *resource.Peer, stream rpcscheduler.Scheduler_ReportPieceResultServer, ms *rpcschedulermocks.MockScheduler_ReportPieceResultServerMockRecorder, md *configmocks.MockDynconfigInterfaceMockRecorder) {
+				child.Priority = base.Priority_LOW_PRIORITY
+			},
+			expect: func(t *testing.T, ok bool, child, parent, otherParent *resource.Peer) {
+				assert := assert.New(t)
+				assert.False(ok)
+				assert.Equal(parent.Host.FreeUploadLoad(), int32(0))
+			},
+		},
+		{
+			name:       "saturated host has no low priority child",
+			preemption: true,
+			priority:   base.Priority_HIGH_PRIORITY,
+			mock: func(child *resource.Peer, stream rpcscheduler.Scheduler_ReportPieceResultServer, ms *rpcschedulermocks.MockScheduler_ReportPieceResultServerMockRecorder, md *configmocks.MockDynconfigInterfaceMockRecorder) {
+			},
+			expect: func(t *testing.T, ok bool, child, parent, otherParent *resource.Peer) {
+				assert := assert.New(t)
+				assert.False(ok)
+				assert.Equal(parent.Host.FreeUploadLoad(), int32(0))
+			},
+		},
+		{
+			name:       "low priority child can not be rescheduled",
+			preemption: true,
+			priority:   base.Priority_HIGH_PRIORITY,
+			mock: func(child *resource.Peer, stream rpcscheduler.Scheduler_ReportPieceResultServer, ms *rpcschedulermocks.MockScheduler_ReportPieceResultServerMockRecorder, md *configmocks.MockDynconfigInterfaceMockRecorder) {
+				child.Priority = base.Priority_LOW_PRIORITY
+				child.StoreStream(stream)
+				gomock.InOrder(
+					md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(2),
+					md.GetSchedulerClusterClientConfig().Return(types.SchedulerClusterClientConfig{}, false).Times(1),
+					ms.Send(gomock.Any()).Return(errors.New("foo")).Times(1),
+				)
+			},
+			expect: func(t *testing.T, ok bool, child, parent, otherParent *resource.Peer) {
+				assert := assert.New(t)
+				assert.False(ok)
+				assert.Equal(parent.Host.FreeUploadLoad(), int32(0))
+			},
+		},
+		{
+			name:       "preempt upload load from low priority child",
+			preemption: true,
+			priority:   base.Priority_HIGH_PRIORITY,
+			mock: func(child *resource.Peer, stream rpcscheduler.Scheduler_ReportPieceResultServer, ms *rpcschedulermocks.MockScheduler_ReportPieceResultServerMockRecorder, md *configmocks.MockDynconfigInterfaceMockRecorder) {
+				child.Priority = base.Priority_LOW_PRIORITY
+				child.StoreStream(stream)
+				gomock.InOrder(
+					md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(2),
+					md.GetSchedulerClusterClientConfig().Return(types.SchedulerClusterClientConfig{}, false).Times(1),
+					ms.Send(gomock.Any()).Return(nil).Times(1),
+				)
+			},
+			expect: func(t *testing.T, ok bool, child, parent, otherParent *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(ok)
+				assert.Equal(parent.Host.FreeUploadLoad(), int32(1))
+				mainParent, ok := child.LoadParent()
+				assert.True(ok)
+				assert.Equal(mainParent.ID, otherParent.ID)
+			},
+		},
+		{
+			name:       "preempt upload load from low priority child of succeeded parent",
+			preemption: true,
+			priority:   base.Priority_HIGH_PRIORITY,
+			mock: func(child *resource.Peer, stream rpcscheduler.Scheduler_ReportPieceResultServer, ms *rpcschedulermocks.MockScheduler_ReportPieceResultServerMockRecorder, md *configmocks.MockDynconfigInterfaceMockRecorder) {
+				child.Priority = base.Priority_LOW_PRIORITY
+				child.StoreStream(stream)
+
+				// Succeeded parent is deleted from the peers of host.
+				parent, _ := child.LoadParent()
+				parent.FSM.SetState(resource.PeerStateSucceeded)
+				parent.Host.DeletePeer(parent.ID)
+				gomock.InOrder(
+					md.GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(2),
+					md.GetSchedulerClusterClientConfig().Return(types.SchedulerClusterClientConfig{}, false).Times(1),
+					ms.Send(gomock.Any()).Return(nil).Times(1),
+				)
+			},
+			expect: func(t *testing.T, ok bool, child, parent, otherParent *resource.Peer) {
+				assert := assert.New(t)
+				assert.True(ok)
+				assert.Equal(parent.Host.FreeUploadLoad(), int32(1))
+				mainParent, ok := child.LoadParent()
+				assert.True(ok)
+				assert.Equal(mainParent.ID, otherParent.ID)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			stream := rpcschedulermocks.NewMockScheduler_ReportPieceResultServer(ctl)
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+
+			newPeer := func(id string, hostname string) *resource.Peer {
+				host := resource.NewHost(&rpcscheduler.PeerHost{
+					Id:       idgen.HostID(hostname, 8003),
+					Ip:       "127.0.0.1",
+					HostName: hostname,
+				}, resource.WithUploadLoadLimit(1))
+				peer := resource.NewPeer(id, mockTask, host)
+				host.StorePeer(peer)
+				mockTask.StorePeer(peer)
+				return peer
+			}
+
+			peer := newPeer(mockPeerID, "foo")
+			peer.Priority = tc.priority
+			peer.FSM.SetState(resource.PeerStateRunning)
+
+			parent := newPeer(idgen.PeerID("127.0.0.2"), "bar")
+			parent.FSM.SetState(resource.PeerStateBackToSource)
+			parent.IsBackToSource.Store(true)
+
+			otherParent := newPeer(idgen.PeerID("127.0.0.3"), "baz")
+			otherParent.FSM.SetState(resource.PeerStateBackToSource)
+			otherParent.IsBackToSource.Store(true)
+
+			child := newPeer(idgen.PeerID("127.0.0.4"), "qux")
+			child.FSM.SetState(resource.PeerStateRunning)
+			if err := child.StoreParent(parent); err != nil {
+				t.Fatal(err)
+			}
+
+			tc.mock(child, stream, stream.EXPECT(), dynconfig.EXPECT())
+			dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1)
+			s := New(&config.SchedulerConfig{Preemption: tc.preemption}, dynconfig, mockPluginDir).(*scheduler)
+			// The other parent is blocked for the high priority peer,
+			// so the saturated host of parent is the only choice.
+			blocklist := set.NewSafeSet()
+			blocklist.Add(otherParent.ID)
+			tc.expect(t, s.preempt(context.Background(), peer, blocklist), child, parent, otherParent)
+		})
+	}
+}
+
+func TestScheduler_preemptChildOfOtherTask(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	stream := rpcschedulermocks.NewMockScheduler_ReportPieceResultServer(ctl)
+	dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+	otherTaskURL := "http://example.com/bar"
+	otherTask := resource.NewTask(idgen.TaskID(otherTaskURL, mockTaskURLMeta), otherTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+
+	hosts := map[string]*resource.Host{}
+	newPeer := func(id string, task *resource.Task, hostname string) *resource.Peer {
+		host, ok := hosts[hostname]
+		if !ok {
+			host = resource.NewHost(&rpcscheduler.PeerHost{
+				Id:       idgen.HostID(hostname, 8003),
+				Ip:       "127.0.0.1",
+				HostName: hostname,
+			}, resource.WithUploadLoadLimit(1))
+			hosts[hostname] = host
+		}
+
+		peer := resource.NewPeer(id, task, host)
+		host.StorePeer(peer)
+		task.StorePeer(peer)
+		return peer
+	}
+
+	peer := newPeer(mockPeerID, mockTask, "foo")
+	peer.Priority = base.Priority_HIGH_PRIORITY
+	peer.FSM.SetState(resource.PeerStateRunning)
+
+	candidateParent := newPeer(idgen.PeerID("127.0.0.2"), mockTask, "bar")
+	candidateParent.FSM.SetState(resource.PeerStateBackToSource)
+	candidateParent.IsBackToSource.Store(true)
+
+	// The upload load of host is held by the low priority child of other task.
+	parent := newPeer(idgen.PeerID("127.0.0.3"), otherTask, "bar")
+	parent.FSM.SetState(resource.PeerStateSucceeded)
+	parent.Host.DeletePeer(parent.ID)
+
+	otherParent := newPeer(idgen.PeerID("127.0.0.4"), otherTask, "baz")
+	otherParent.FSM.SetState(resource.PeerStateBackToSource)
+	otherParent.IsBackToSource.Store(true)
+
+	child := newPeer(idgen.PeerID("127.0.0.5"), otherTask, "qux")
+	child.Priority = base.Priority_LOW_PRIORITY
+	child.FSM.SetState(resource.PeerStateRunning)
+	child.StoreStream(stream)
+	if err := child.StoreParent(parent); err != nil {
+		t.Fatal(err)
+	}
+
+	gomock.InOrder(
+		dynconfig.EXPECT().Register(gomock.Any()).Return().Times(1),
+		dynconfig.EXPECT().GetSchedulerClusterConfig().Return(types.SchedulerClusterConfig{}, false).Times(2),
+		dynconfig.EXPECT().GetSchedulerClusterClientConfig().Return(types.SchedulerClusterClientConfig{}, false).Times(1),
+		stream.EXPECT().Send(gomock.Any()).Return(nil).Times(1),
+	)
+
+	s := New(&config.SchedulerConfig{Preemption: true}, dynconfig, mockPluginDir).(*scheduler)
+	assert := assert.New(t)
+	assert.Equal(candidateParent.Host.FreeUploadLoad(), int32(0))
+	assert.True(s.preempt(context.Background(), peer, set.NewSafeSet()))
+	assert.Equal(candidateParent.Host.FreeUploadLoad(), int32(1))
+	mainParent, ok := child.LoadParent()
+	assert.True(ok)
+	assert.Equal(mainParent.ID, otherParent.ID)
+}
+
 func TestScheduler_constructSuccessPeerPacket(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -940,3 +1544,87 @@ func TestScheduler_constructSuccessPeerPacket(t *testing.T) {
 		})
 	}
 }
+
+func TestScheduler_assignPieceRanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   func(peer *resource.Peer, parents []*resource.Peer)
+		expect func(t *testing.T, pieceRanges []*rpcscheduler.PeerPacket_PieceRange, parents []*resource.Peer)
+	}{
+		{
+			name: "total piece count is unknown",
+			mock: func(peer *resource.Peer, parents []*resource.Peer) {
+				parents[0].Pieces.Set(0)
+			},
+			expect: func(t *testing.T, pieceRanges []*rpcscheduler.PeerPacket_PieceRange, parents []*resource.Peer) {
+				assert := assert.New(t)
+				assert.Empty(pieceRanges)
+			},
+		},
+		{
+			name: "pieces are evenly assigned to parents",
+			mock: func(peer *resource.Peer, parents []*resource.Peer) {
+				peer.Task.TotalPieceCount.Store(4)
+				for i := uint(0); i < 4; i++ {
+					parents[0].Pieces.Set(i)
+					parents[1].Pieces.Set(i)
+				}
+			},
+			expect: func(t *testing.T, pieceRanges []*rpcscheduler.PeerPacket_PieceRange, parents []*resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(pieceRanges, []*rpcscheduler.PeerPacket_PieceRange{
+					{PeerId: parents[0].ID, StartNum: 0, EndNum: 2},
+					{PeerId: parents[1].ID, StartNum: 2, EndNum: 4},
+				})
+			},
+		},
+		{
+			name: "pieces are assigned to parents which have finished them",
+			mock: func(peer *resource.Peer, parents []*resource.Peer) {
+				peer.Task.TotalPieceCount.Store(4)
+				parents[0].Pieces.Set(0)
+				parents[1].Pieces.Set(1)
+				parents[1].Pieces.Set(2)
+			},
+			expect: func(t *testing.T, pieceRanges []*rpcscheduler.PeerPacket_PieceRange, parents []*resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(pieceRanges, []*rpcscheduler.PeerPacket_PieceRange{
+					{PeerId: parents[0].ID, StartNum: 0, EndNum: 1},
+					{PeerId: parents[1].ID, StartNum: 1, EndNum: 3},
+				})
+			},
+		},
+		{
+			name: "pieces finished by peer are not assigned",
+			mock: func(peer *resource.Peer, parents []*resource.Peer) {
+				peer.Task.TotalPieceCount.Store(4)
+				peer.Pieces.Set(0)
+				peer.Pieces.Set(1)
+				for i := uint(0); i < 4; i++ {
+					parents[0].Pieces.Set(i)
+				}
+			},
+			expect: func(t *testing.T, pieceRanges []*rpcscheduler.PeerPacket_PieceRange, parents []*resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(pieceRanges, []*rpcscheduler.PeerPacket_PieceRange{
+					{PeerId: parents[0].ID, StartNum: 2, EndNum: 4},
+				})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockHost := resource.NewHost(mockRawHost)
+			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+			peer := resource.NewPeer(mockPeerID, mockTask, mockHost)
+			parents := []*resource.Peer{
+				resource.NewPeer(idgen.PeerID("127.0.0.2"), mockTask, mockHost),
+				resource.NewPeer(idgen.PeerID("127.0.0.3"), mockTask, mockHost),
+			}
+
+			tc.mock(peer, parents)
+			tc.expect(t, assignPieceRanges(peer, parents), parents)
+		})
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math/rand"
+	"sort"
 	"time"
 
 	"go.opentelemetry.io/otel/trace"
@@ -28,18 +30,28 @@ import (
 	"d7y.io/dragonfly/v2/internal/dferrors"
 	logger "d7y.io/dragonfly/v2/internal/dflog"
 	"d7y.io/dragonfly/v2/pkg/container/set"
+	"d7y.io/dragonfly/v2/pkg/dfnet"
 	"d7y.io/dragonfly/v2/pkg/rpc/base"
 	"d7y.io/dragonfly/v2/pkg/rpc/base/common"
+	"d7y.io/dragonfly/v2/pkg/rpc/dfdaemon"
+	dfdaemonclient "d7y.io/dragonfly/v2/pkg/rpc/dfdaemon/client"
 	"d7y.io/dragonfly/v2/pkg/rpc/errordetails"
 	rpcscheduler "d7y.io/dragonfly/v2/pkg/rpc/scheduler"
 	pkgtime "d7y.io/dragonfly/v2/pkg/time"
+	"d7y.io/dragonfly/v2/scheduler/cluster"
 	"d7y.io/dragonfly/v2/scheduler/config"
+	"d7y.io/dragonfly/v2/scheduler/federation"
 	"d7y.io/dragonfly/v2/scheduler/metrics"
 	"d7y.io/dragonfly/v2/scheduler/resource"
 	"d7y.io/dragonfly/v2/scheduler/scheduler"
 	"d7y.io/dragonfly/v2/scheduler/storage"
 )
 
+const (
+	// dropTaskTimeout is the timeout of notifying dfdaemon to delete the task data.
+	dropTaskTimeout = 30 * time.Second
+)
+
 type Service struct {
 	// Resource interface.
 	resource resource.Resource
@@ -55,6 +67,25 @@ type Service struct {
 
 	// Storage interface.
 	storage storage.Storage
+
+	// newDaemonClient returns the client of dfdaemon by address.
+	newDaemonClient func(addr string) (dfdaemonclient.DaemonClient, error)
+
+	// objectCache caches the contents of small tasks, it is nil when disabled.
+	objectCache resource.ObjectCache
+
+	// federation finds peers of tasks in federated scheduler clusters, it is nil when disabled.
+	federation federation.Federation
+}
+
+// Option is a functional option for configuring the service.
+type Option func(s *Service)
+
+// WithFederation sets the federation of scheduler clusters.
+func WithFederation(f federation.Federation) Option {
+	return func(s *Service) {
+		s.federation = f
+	}
 }
 
 // New service instance.
@@ -64,15 +95,39 @@ func New(
 	scheduler scheduler.Scheduler,
 	dynconfig config.DynconfigInterface,
 	storage storage.Storage,
+	options ...Option,
 ) *Service {
-
-	return &Service{
+	s := &Service{
 		resource:  resource,
 		scheduler: scheduler,
 		config:    cfg,
 		dynconfig: dynconfig,
 		storage:   storage,
+		newDaemonClient: func(addr string) (dfdaemonclient.DaemonClient, error) {
+			return dfdaemonclient.GetClientByAddr([]dfnet.NetAddr{{Type: dfnet.TCP, Addr: addr}})
+		},
+		objectCache: newObjectCache(cfg),
 	}
+
+	for _, opt := range options {
+		opt(s)
+	}
+
+	return s
+}
+
+// ObjectCache returns the object cache of small tasks, it is nil when disabled.
+func (s *Service) ObjectCache() resource.ObjectCache {
+	return s.objectCache
+}
+
+// newObjectCache returns the object cache by config, it returns nil when disabled.
+func newObjectCache(cfg *config.Config) resource.ObjectCache {
+	if cfg.Scheduler == nil || !cfg.Scheduler.ObjectCache.Enable {
+		return nil
+	}
+
+	return resource.NewObjectCache(cfg.Scheduler.ObjectCache.ObjectSizeLimit, cfg.Scheduler.ObjectCache.SizeLimit)
 }
 
 // RegisterPeerTask registers peer and triggers seed peer download task.
@@ -85,13 +140,34 @@ func (s *Service) RegisterPeerTask(ctx context.Context, req *rpcscheduler.PeerTa
 		return nil, dferrors.New(base.Code_SchedTaskStatusError, msg)
 	}
 	host := s.registerHost(ctx, req.PeerHost)
-	peer := s.registerPeer(ctx, req.PeerId, task, host, req.UrlMeta.Tag)
+	peer := s.registerPeer(ctx, req.PeerId, task, host, req.UrlMeta)
 	peer.Log.Infof("register peer task request: %#v %#v %#v", req, req.UrlMeta, req.HostLoad)
 
 	// When the peer registers for the first time and
 	// does not have a seed peer, it will back-to-source.
 	peer.NeedBackToSource.Store(needBackToSource)
 
+	// The task state is TaskStateSucceeded and the content of task is cached,
+	// return the content directly and skip the scheduling of parents.
+	if data, ok := s.loadObject(task); ok {
+		peer.Log.Info("task content is cached and return piece content directly")
+		if err := peer.FSM.Event(resource.PeerEventRegisterTiny); err != nil {
+			msg := fmt.Sprintf("peer %s register is failed: %s", req.PeerId, err.Error())
+			peer.Log.Error(msg)
+			return nil, dferrors.New(base.Code_SchedError, msg)
+		}
+
+		metrics.ObjectCacheHitCount.Inc()
+		return &rpcscheduler.RegisterResult{
+			TaskId:    task.ID,
+			TaskType:  task.Type,
+			SizeScope: base.SizeScope_TINY,
+			DirectPiece: &rpcscheduler.RegisterResult_PieceContent{
+				PieceContent: data,
+			},
+		}, nil
+	}
+
 	// The task state is TaskStateSucceeded and SizeScope is not invalid.
 	sizeScope, err := task.SizeScope()
 	if task.FSM.Is(resource.TaskStateSucceeded) && err == nil {
@@ -178,7 +254,7 @@ func (s *Service) RegisterPeerTask(ctx context.Context, req *rpcscheduler.PeerTa
 				return nil, dferrors.New(base.Code_SchedError, msg)
 			}
 
-			peer.ReplaceParent(parent)
+			peer.ReplaceParents([]*resource.Peer{parent})
 			peer.Log.Infof("schedule parent successful, replace parent to %s ", parent.ID)
 			peer.Log.Debugf("peer ancestors is %v", peer.Ancestors())
 
@@ -402,10 +478,10 @@ func (s *Service) AnnounceTask(ctx context.Context, req *rpcscheduler.AnnounceTa
 	taskID := req.TaskId
 	peerID := req.PiecePacket.DstPid
 
-	task := resource.NewTask(taskID, req.Url, req.TaskType, req.UrlMeta)
+	task := resource.NewTask(taskID, req.Url, req.TaskType, req.UrlMeta, resource.WithEventHub(s.resource.EventHub()))
 	task, _ = s.resource.TaskManager().LoadOrStore(task)
 	host := s.registerHost(ctx, req.PeerHost)
-	peer := s.registerPeer(ctx, peerID, task, host, req.UrlMeta.Tag)
+	peer := s.registerPeer(ctx, peerID, task, host, req.UrlMeta)
 	peer.Log.Infof("announce peer task request: %#v %#v %#v %#v", req, req.UrlMeta, req.PeerHost, req.PiecePacket)
 
 	// If the task state is not TaskStateSucceeded,
@@ -459,6 +535,51 @@ func (s *Service) AnnounceTask(ctx context.Context, req *rpcscheduler.AnnounceTa
 	return nil
 }
 
+// FindTaskPeers finds the succeeded peers of task for the schedulers of federated clusters.
+func (s *Service) FindTaskPeers(ctx context.Context, req *rpcscheduler.FindTaskPeersRequest) (*rpcscheduler.FindTaskPeersResponse, error) {
+	task, loaded := s.resource.TaskManager().Load(req.TaskId)
+	if !loaded || !task.FSM.Is(resource.TaskStateSucceeded) {
+		return &rpcscheduler.FindTaskPeersResponse{}, nil
+	}
+
+	var peers []*resource.Peer
+	task.Peers.Range(func(_, v any) bool {
+		peer, ok := v.(*resource.Peer)
+		if !ok {
+			return true
+		}
+
+		if !peer.FSM.Is(resource.PeerStateSucceeded) || peer.Quarantined.Load() || peer.Host.Quarantined.Load() || peer.Host.Cordoned.Load() {
+			return true
+		}
+
+		// Peers in different security domains can not share the task.
+		if req.SecurityDomain != "" && peer.Host.SecurityDomain != "" && req.SecurityDomain != peer.Host.SecurityDomain {
+			return true
+		}
+
+		peers = append(peers, peer)
+		return true
+	})
+
+	// Seed peers are preferred because they are more stable.
+	sort.SliceStable(peers, func(i, j int) bool {
+		return peers[i].Host.Type != resource.HostTypeNormal && peers[j].Host.Type == resource.HostTypeNormal
+	})
+
+	if len(peers) > int(req.Limit) {
+		peers = peers[:req.Limit]
+	}
+
+	resp := &rpcscheduler.FindTaskPeersResponse{}
+	for _, peer := range peers {
+		resp.Peers = append(resp.Peers, cluster.NewAnnounceTaskRequest(peer))
+	}
+
+	task.Log.Infof("find %d task peers for federated scheduler cluster", len(resp.Peers))
+	return resp, nil
+}
+
 // LeaveTask makes the peer unschedulable.
 func (s *Service) LeaveTask(ctx context.Context, req *rpcscheduler.PeerTarget) error {
 	peer, ok := s.resource.PeerManager().Load(req.PeerId)
@@ -478,25 +599,98 @@ func (s *Service) LeaveTask(ctx context.Context, req *rpcscheduler.PeerTarget) e
 		return dferrors.New(base.Code_SchedTaskStatusError, msg)
 	}
 
-	peer.Children.Range(func(_, value any) bool {
-		child, ok := value.(*resource.Peer)
-		if !ok {
-			return true
-		}
-
-		// Reschedule a new parent to children of peer to exclude the current leave peer.
+	// Reschedule a new parent to children of peer to exclude the current leave peer.
+	children := peer.Children()
+	peer.Task.DeletePeerOutEdges(peer.ID)
+	for _, child := range children {
 		child.Log.Infof("schedule parent because of parent peer %s is leaving", peer.ID)
 		s.scheduler.ScheduleParent(ctx, child, child.BlockPeers)
-		return true
-	})
+	}
 
 	s.resource.PeerManager().Delete(peer.ID)
 	return nil
 }
 
+// SyncProbes stores probe results of the host and samples the hosts to be probed.
+func (s *Service) SyncProbes(ctx context.Context, req *rpcscheduler.SyncProbesRequest) (*rpcscheduler.SyncProbesResponse, error) {
+	host := s.registerHost(ctx, req.PeerHost)
+	host.UpdateAt.Store(time.Now())
+	host.Log.Infof("sync probes request: %d probes", len(req.Probes))
+
+	for _, probe := range req.Probes {
+		if probe.HostId == host.ID {
+			continue
+		}
+
+		rtt := time.Duration(probe.Rtt)
+		loss := float64(probe.Loss)
+		host.Probes.Store(probe.HostId, rtt, loss)
+
+		// Round-trip time is symmetric, the probe result is stored by target host as well.
+		if target, ok := s.resource.HostManager().Load(probe.HostId); ok {
+			target.Probes.Store(host.ID, rtt, loss)
+		}
+	}
+
+	var targets []*rpcscheduler.ProbeTarget
+	for _, target := range s.sampleProbeTargets(host) {
+		targets = append(targets, &rpcscheduler.ProbeTarget{
+			HostId:  target.ID,
+			Ip:      target.IP,
+			RpcPort: target.Port,
+		})
+	}
+
+	return &rpcscheduler.SyncProbesResponse{Targets: targets}, nil
+}
+
+// AnnounceHost stores the load of the host.
+func (s *Service) AnnounceHost(ctx context.Context, req *rpcscheduler.AnnounceHostRequest) error {
+	host := s.registerHost(ctx, req.PeerHost)
+	host.UpdateAt.Store(time.Now())
+	host.Stats.Store(resource.HostStat{
+		RxBandwidth:          req.Stats.RxBandwidth,
+		TxBandwidth:          req.Stats.TxBandwidth,
+		UploadBandwidthLimit: req.Stats.UploadBandwidthLimit,
+		DiskFree:             req.Stats.DiskFree,
+		DiskTotal:            req.Stats.DiskTotal,
+		CPURatio:             req.Stats.CpuRatio,
+		MemoryRatio:          req.Stats.MemoryRatio,
+	})
+
+	host.Log.Debugf("announce host request: %#v", req.Stats)
+	return nil
+}
+
+// Watch sends the lifecycle events matching the request to the watcher,
+// the watcher is dropped when it is too slow to receive events.
+func (s *Service) Watch(req *rpcscheduler.WatchRequest, stream rpcscheduler.Scheduler_WatchServer) error {
+	ctx := stream.Context()
+	subscription := s.resource.EventHub().Subscribe(req)
+	defer subscription.Close()
+
+	logger.Infof("watch request: %#v", req)
+	for {
+		select {
+		case e := <-subscription.Events():
+			if err := stream.Send(e); err != nil {
+				logger.Errorf("send event %#v error: %s", e, err.Error())
+				return err
+			}
+		case <-subscription.Dropped():
+			msg := "watcher is too slow to receive events"
+			logger.Warnf("watch request %#v is dropped: %s", req, msg)
+			return dferrors.New(base.Code_SchedError, msg)
+		case <-ctx.Done():
+			logger.Infof("context was done")
+			return ctx.Err()
+		}
+	}
+}
+
 // registerTask creates a new task or reuses a previous task.
 func (s *Service) registerTask(ctx context.Context, req *rpcscheduler.PeerTaskRequest) (*resource.Task, bool, error) {
-	task := resource.NewTask(req.TaskId, req.Url, base.TaskType_Normal, req.UrlMeta, resource.WithBackToSourceLimit(int32(s.config.Scheduler.BackSourceCount)))
+	task := resource.NewTask(req.TaskId, req.Url, base.TaskType_Normal, req.UrlMeta, resource.WithBackToSourceLimit(int32(s.config.Scheduler.BackSourceCount)), resource.WithEventHub(s.resource.EventHub()))
 	task, loaded := s.resource.TaskManager().LoadOrStore(task)
 	if loaded && !task.FSM.Is(resource.TaskStateFailed) {
 		task.Log.Infof("task state is %s", task.FSM.Current())
@@ -514,20 +708,52 @@ func (s *Service) registerTask(ctx context.Context, req *rpcscheduler.PeerTaskRe
 		return task, true, nil
 	}
 
-	// Start trigger seed peer task.
-	if s.config.SeedPeer.Enable {
-		if task.IsSeedPeerFailed() {
-			return task, true, nil
+	// Start trigger seed peer task, and find peers of task in federated
+	// scheduler clusters at the same time without blocking the seed peer.
+	if s.config.SeedPeer.Enable && !task.IsSeedPeerFailed() {
+		if s.federation != nil {
+			go s.findFederatedPeers(trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx)), task, req.PeerHost.SecurityDomain)
 		}
 
 		go s.triggerSeedPeerTask(ctx, task)
 		return task, false, nil
 	}
 
+	// Find peers of task in federated scheduler clusters before back-to-source,
+	// peers download the task from the federated peers.
+	if s.federation != nil && s.findFederatedPeers(ctx, task, req.PeerHost.SecurityDomain) {
+		return task, false, nil
+	}
+
 	// Task need to back-to-source.
 	return task, true, nil
 }
 
+// findFederatedPeers finds peers of task in federated scheduler clusters and announces them
+// to the task, it returns true when the task is succeeded by the federated peers.
+func (s *Service) findFederatedPeers(ctx context.Context, task *resource.Task, securityDomain string) bool {
+	peers, err := s.federation.FindPeers(ctx, task.ID, securityDomain)
+	if err != nil {
+		metrics.FederatedPeerFailureCount.Inc()
+		task.Log.Warnf("find peers in federated scheduler clusters failed: %s", err.Error())
+		return false
+	}
+
+	var announced bool
+	for _, peer := range peers {
+		if err := s.AnnounceTask(ctx, peer); err != nil {
+			metrics.FederatedPeerFailureCount.Inc()
+			task.Log.Warnf("announce federated peer %s failed: %s", peer.PiecePacket.DstPid, err.Error())
+			continue
+		}
+
+		metrics.FederatedPeerCount.Inc()
+		announced = true
+	}
+
+	return announced && task.FSM.Is(resource.TaskStateSucceeded)
+}
+
 // registerHost creates a new host or reuses a previous host.
 func (s *Service) registerHost(ctx context.Context, rawHost *rpcscheduler.PeerHost) *resource.Host {
 	host, ok := s.resource.HostManager().Load(rawHost.Id)
@@ -549,10 +775,14 @@ func (s *Service) registerHost(ctx context.Context, rawHost *rpcscheduler.PeerHo
 }
 
 // registerPeer creates a new peer or reuses a previous peer.
-func (s *Service) registerPeer(ctx context.Context, peerID string, task *resource.Task, host *resource.Host, tag string) *resource.Peer {
+func (s *Service) registerPeer(ctx context.Context, peerID string, task *resource.Task, host *resource.Host, urlMeta *base.UrlMeta) *resource.Peer {
 	var options []resource.PeerOption
-	if tag != "" {
-		options = append(options, resource.WithTag(tag))
+	if urlMeta.Tag != "" {
+		options = append(options, resource.WithTag(urlMeta.Tag))
+	}
+
+	if urlMeta.Priority != base.Priority_DEFAULT_PRIORITY {
+		options = append(options, resource.WithPriority(urlMeta.Priority))
 	}
 
 	peer, loaded := s.resource.PeerManager().LoadOrStore(resource.NewPeer(peerID, task, host, options...))
@@ -631,6 +861,14 @@ func (s *Service) handlePieceSuccess(ctx context.Context, peer *resource.Peer, p
 	// piece downloads successfully updates the task piece info.
 	if peer.FSM.Is(resource.PeerStateBackToSource) {
 		peer.Task.StorePiece(piece.PieceInfo)
+		return
+	}
+
+	// Parent restored from snapshot is validated
+	// when the piece is downloaded from it successfully.
+	if parent, ok := peer.LoadParentByID(piece.DstPid); ok && parent.NeedValidation.Load() {
+		parent.NeedValidation.Store(false)
+		parent.Log.Info("peer restored from snapshot has been validated")
 	}
 }
 
@@ -650,6 +888,17 @@ func (s *Service) handlePieceFail(ctx context.Context, peer *resource.Peer, piec
 		return
 	}
 
+	// If parent restored from snapshot has not been validated,
+	// the data of parent may have been removed after scheduler restarted.
+	// Only the failure meaning the data is missing fails the parent,
+	// other failures such as connection errors may be transient.
+	if parent.NeedValidation.Load() && (piece.Code == base.Code_ClientPieceNotFound || piece.Code == base.Code_CDNTaskNotFound) {
+		parent.Log.Info("peer restored from snapshot fails to be validated")
+		if err := parent.FSM.Event(resource.PeerEventDownloadFailed); err != nil {
+			peer.Log.Errorf("peer fsm event failed: %s", err.Error())
+		}
+	}
+
 	// It’s not a case of back-to-source downloading failed,
 	// to help peer to reschedule the parent node.
 	switch piece.Code {
@@ -674,6 +923,10 @@ func (s *Service) handlePieceFail(ctx context.Context, peer *resource.Peer, piec
 		if s.config.SeedPeer.Enable {
 			go s.triggerSeedPeerTask(ctx, parent.Task)
 		}
+	case base.Code_ClientPieceDigestMismatch:
+		// Dfdaemon downloading piece data from parent finds the digest does not match,
+		// the data of parent may be corrupted.
+		s.handleCorruptedPiece(ctx, peer, parent)
 	default:
 	}
 
@@ -684,10 +937,79 @@ func (s *Service) handlePieceFail(ctx context.Context, peer *resource.Peer, piec
 	}
 
 	peer.Log.Infof("schedule parent because of peer receive failed piece")
+	peer.DeleteParent(parent.ID)
 	peer.BlockPeers.Add(parent.ID)
 	s.scheduler.ScheduleParent(ctx, peer, peer.BlockPeers)
 }
 
+// handleCorruptedPiece records the corrupted piece uploaded by parent, the parent is quarantined
+// when it uploads corrupted pieces repeatedly, and both the parent and its host are quarantined
+// when the host has uploaded too many corrupted pieces.
+func (s *Service) handleCorruptedPiece(ctx context.Context, peer *resource.Peer, parent *resource.Peer) {
+	metrics.CorruptedPieceCount.Inc()
+	peerCount := parent.CorruptedPieceCount.Inc()
+	hostCount := parent.Host.CorruptedPieceCount.Inc()
+	parent.Log.Warnf("peer %s receives corrupted piece, corrupted piece count of peer is %d and host is %d", peer.ID, peerCount, hostCount)
+
+	// The host of parent is excluded from candidate parents
+	// when it has uploaded too many corrupted pieces.
+	if hostCount >= int32(s.config.Scheduler.HostCorruptedPieceLimit) && parent.Host.Quarantined.CAS(false, true) {
+		metrics.QuarantineHostCount.Inc()
+		parent.Host.Log.Warn("host is quarantined because of corrupted data")
+	}
+
+	if peerCount < int32(s.config.Scheduler.CorruptedPieceLimit) && hostCount < int32(s.config.Scheduler.HostCorruptedPieceLimit) {
+		return
+	}
+
+	if !parent.Quarantined.CAS(false, true) {
+		return
+	}
+
+	metrics.QuarantinePeerCount.Inc()
+	parent.Log.Warn("peer is quarantined because of corrupted data")
+
+	// Reschedule the children of quarantined peer, the peer
+	// received corrupted piece is rescheduled by the caller.
+	for _, child := range parent.Children() {
+		if child.ID == peer.ID || !child.FSM.Is(resource.PeerStateRunning) {
+			continue
+		}
+
+		blocklist := set.NewSafeSet()
+		blocklist.Add(parent.ID)
+		if _, ok := s.scheduler.NotifyAndFindParent(ctx, child, blocklist); !ok {
+			child.Log.Warnf("reschedule peer from quarantined parent %s failed", parent.ID)
+		}
+	}
+
+	go s.dropTask(parent)
+}
+
+// dropTask notifies the dfdaemon of peer to delete the task data.
+func (s *Service) dropTask(peer *resource.Peer) {
+	addr := fmt.Sprintf("%s:%d", peer.Host.IP, peer.Host.Port)
+	client, err := s.newDaemonClient(addr)
+	if err != nil {
+		peer.Log.Errorf("get dfdaemon client %s failed: %s", addr, err.Error())
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), dropTaskTimeout)
+	defer cancel()
+
+	if err := client.DeleteTask(ctx, &dfdaemon.DeleteTaskRequest{
+		Url:     peer.Task.URL,
+		UrlMeta: peer.Task.URLMeta,
+	}); err != nil {
+		peer.Log.Errorf("dfdaemon %s deletes task failed: %s", addr, err.Error())
+		return
+	}
+
+	peer.Log.Infof("dfdaemon %s deletes task", addr)
+}
+
 // handlePeerSuccess handles successful peer.
 func (s *Service) handlePeerSuccess(ctx context.Context, peer *resource.Peer) {
 	if err := peer.FSM.Event(resource.PeerEventDownloadSucceeded); err != nil {
@@ -717,6 +1039,62 @@ func (s *Service) handlePeerSuccess(ctx context.Context, peer *resource.Peer) {
 
 		// Tiny file downloaded successfully.
 		peer.Task.DirectPiece = data
+		return
+	}
+
+	// If the peer is seed peer and the task is small enough,
+	// it need to download the content and store it in object cache.
+	// The download does not block the seed peer reporting result.
+	if sizeScope != base.SizeScope_TINY && peer.Host.Type != resource.HostTypeNormal {
+		go s.storeObject(peer)
+	}
+}
+
+// loadObject returns the cached content of succeeded task.
+func (s *Service) loadObject(task *resource.Task) ([]byte, bool) {
+	if s.objectCache == nil || !task.FSM.Is(resource.TaskStateSucceeded) {
+		return nil, false
+	}
+
+	data, ok := s.objectCache.Load(task.ID)
+	if !ok {
+		return nil, false
+	}
+
+	// The task may be downloaded again and its content length is changed.
+	if int64(len(data)) != task.ContentLength.Load() {
+		s.objectCache.Delete(task.ID)
+		return nil, false
+	}
+
+	return data, true
+}
+
+// storeObject downloads the content of task from peer and stores it in object cache.
+func (s *Service) storeObject(peer *resource.Peer) {
+	if s.objectCache == nil {
+		return
+	}
+
+	contentLength := peer.Task.ContentLength.Load()
+	if contentLength <= 0 || contentLength > s.config.Scheduler.ObjectCache.ObjectSizeLimit {
+		return
+	}
+
+	data, err := peer.DownloadTinyFile()
+	if err != nil {
+		peer.Log.Errorf("download object failed: %s", err.Error())
+		return
+	}
+
+	if int64(len(data)) != contentLength {
+		peer.Log.Errorf("download object length of data is %d, task content length is %d", len(data), contentLength)
+		return
+	}
+
+	if s.objectCache.Store(peer.Task.ID, data) {
+		peer.Log.Infof("store object in cache, length of data is %d", len(data))
+		metrics.ObjectCacheStoreCount.Inc()
 	}
 }
 
@@ -728,16 +1106,12 @@ func (s *Service) handlePeerFail(ctx context.Context, peer *resource.Peer) {
 	}
 
 	// Reschedule a new parent to children of peer to exclude the current failed peer.
-	peer.Children.Range(func(_, value any) bool {
-		child, ok := value.(*resource.Peer)
-		if !ok {
-			return true
-		}
-
+	children := peer.Children()
+	peer.Task.DeletePeerOutEdges(peer.ID)
+	for _, child := range children {
 		child.Log.Infof("schedule parent because of parent peer %s is failed", peer.ID)
 		s.scheduler.ScheduleParent(ctx, child, child.BlockPeers)
-		return true
-	})
+	}
 }
 
 // handleLegacySeedPeer handles seed server's task has left,
@@ -754,16 +1128,12 @@ func (s *Service) handleLegacySeedPeer(ctx context.Context, peer *resource.Peer)
 	}
 
 	// Reschedule a new parent to children of peer to exclude the current failed peer.
-	peer.Children.Range(func(_, value any) bool {
-		child, ok := value.(*resource.Peer)
-		if !ok {
-			return true
-		}
-
+	children := peer.Children()
+	peer.Task.DeletePeerOutEdges(peer.ID)
+	for _, child := range children {
 		child.Log.Infof("schedule parent because of parent peer %s is failed", peer.ID)
 		s.scheduler.ScheduleParent(ctx, child, child.BlockPeers)
-		return true
-	})
+	}
 }
 
 // Conditions for the task to switch to the TaskStateSucceeded are:
@@ -793,6 +1163,7 @@ func (s *Service) handleTaskFail(ctx context.Context, task *resource.Task, backT
 	// notify other peers of the failure,
 	// and return the source metadata to peer.
 	if backToSourceErr != nil {
+		task.PublishEvent(rpcscheduler.EventType_SOURCE_ERROR, backToSourceErr)
 		if !backToSourceErr.Temporary {
 			task.NotifyPeers(&rpcscheduler.PeerPacket{
 				Code: base.Code_BackToSourceAborted,
@@ -810,6 +1181,7 @@ func (s *Service) handleTaskFail(ctx context.Context, task *resource.Task, backT
 			for _, detail := range st.Details() {
 				switch d := detail.(type) {
 				case *errordetails.SourceError:
+					task.PublishEvent(rpcscheduler.EventType_SOURCE_ERROR, d)
 					if !d.Temporary {
 						task.NotifyPeers(&rpcscheduler.PeerPacket{
 							Code: base.Code_BackToSourceAborted,
@@ -831,6 +1203,11 @@ func (s *Service) handleTaskFail(ctx context.Context, task *resource.Task, backT
 		task.PeerFailedCount.Store(0)
 	}
 
+	// The cached content of task may be stale when the task is failed.
+	if s.objectCache != nil {
+		s.objectCache.Delete(task.ID)
+	}
+
 	if task.FSM.Is(resource.TaskStateFailed) {
 		return
 	}
@@ -841,10 +1218,47 @@ func (s *Service) handleTaskFail(ctx context.Context, task *resource.Task, backT
 	}
 }
 
+// sampleProbeTargets samples the hosts to be probed by the host,
+// hosts that have not been probed are preferred, followed by
+// hosts with the least recently updated probe results.
+func (s *Service) sampleProbeTargets(host *resource.Host) []*resource.Host {
+	var candidates []*resource.Host
+	s.resource.HostManager().Range(func(_, value any) bool {
+		candidate, ok := value.(*resource.Host)
+		if !ok || candidate.ID == host.ID {
+			return true
+		}
+
+		candidates = append(candidates, candidate)
+		return true
+	})
+
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		pi, iok := host.Probes.Load(candidates[i].ID)
+		pj, jok := host.Probes.Load(candidates[j].ID)
+		if iok != jok {
+			return !iok
+		}
+
+		return pi.UpdateAt.Before(pj.UpdateAt)
+	})
+
+	if len(candidates) > s.config.Scheduler.ProbeCount {
+		candidates = candidates[:s.config.Scheduler.ProbeCount]
+	}
+
+	return candidates
+}
+
 // createRecord stores peer download records.
 func (s *Service) createRecord(peer *resource.Peer, peerState int, req *rpcscheduler.PeerResult) {
 	record := storage.Record{
 		ID:              peer.ID,
+		TaskID:          peer.Task.ID,
 		IP:              peer.Host.IP,
 		Hostname:        peer.Host.Hostname,
 		Tag:             peer.Tag,
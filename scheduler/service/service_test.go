@@ -17,6 +17,7 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io"
@@ -41,11 +42,16 @@ import (
 	"d7y.io/dragonfly/v2/pkg/idgen"
 	"d7y.io/dragonfly/v2/pkg/rpc/base"
 	"d7y.io/dragonfly/v2/pkg/rpc/base/common"
+	"d7y.io/dragonfly/v2/pkg/rpc/dfdaemon"
+	dfdaemonclient "d7y.io/dragonfly/v2/pkg/rpc/dfdaemon/client"
+	dfdaemonclientmocks "d7y.io/dragonfly/v2/pkg/rpc/dfdaemon/client/mocks"
 	"d7y.io/dragonfly/v2/pkg/rpc/errordetails"
 	rpcscheduler "d7y.io/dragonfly/v2/pkg/rpc/scheduler"
 	rpcschedulermocks "d7y.io/dragonfly/v2/pkg/rpc/scheduler/mocks"
 	"d7y.io/dragonfly/v2/scheduler/config"
 	configmocks "d7y.io/dragonfly/v2/scheduler/config/mocks"
+	"d7y.io/dragonfly/v2/scheduler/event"
+	federationmocks "d7y.io/dragonfly/v2/scheduler/federation/mocks"
 	"d7y.io/dragonfly/v2/scheduler/resource"
 	"d7y.io/dragonfly/v2/scheduler/scheduler"
 	"d7y.io/dragonfly/v2/scheduler/scheduler/mocks"
@@ -58,6 +64,7 @@ var (
 		RetryBackSourceLimit: 3,
 		RetryInterval:        10 * time.Millisecond,
 		BackSourceCount:      int(mockTaskBackToSourceLimit),
+		ProbeCount:           2,
 	}
 
 	mockRawHost = &rpcscheduler.PeerHost{
@@ -658,6 +665,7 @@ func TestService_RegisterPeerTask(t *testing.T) {
 			taskManager := resource.NewMockTaskManager(ctl)
 			peerManager := resource.NewMockPeerManager(ctl)
 			svc := New(&config.Config{Scheduler: mockSchedulerConfig}, res, scheduler, dynconfig, storage)
+			res.EXPECT().EventHub().Return(event.New()).AnyTimes()
 
 			mockHost := resource.NewHost(mockRawHost)
 			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
@@ -972,7 +980,7 @@ func TestService_ReportPeerResult(t *testing.T) {
 				mr *resource.MockResourceMockRecorder, mp *resource.MockPeerManagerMockRecorder, ms *storagemocks.MockStorageMockRecorder,
 			) {
 				mockPeer.FSM.SetState(resource.PeerStateFailed)
-				mockPeer.StoreParent(mockPeer)
+				mockPeer.StoreParent(resource.NewPeer(idgen.PeerID("127.0.0.2"), mockPeer.Task, mockPeer.Host))
 				gomock.InOrder(
 					mr.PeerManager().Return(peerManager).Times(1),
 					mp.Load(gomock.Eq(mockPeerID)).Return(mockPeer, true).Times(1),
@@ -1019,7 +1027,7 @@ func TestService_ReportPeerResult(t *testing.T) {
 				mr *resource.MockResourceMockRecorder, mp *resource.MockPeerManagerMockRecorder, ms *storagemocks.MockStorageMockRecorder,
 			) {
 				mockPeer.FSM.SetState(resource.PeerStateFailed)
-				mockPeer.StoreParent(mockPeer)
+				mockPeer.StoreParent(resource.NewPeer(idgen.PeerID("127.0.0.2"), mockPeer.Task, mockPeer.Host))
 				gomock.InOrder(
 					mr.PeerManager().Return(peerManager).Times(1),
 					mp.Load(gomock.Eq(mockPeerID)).Return(mockPeer, true).Times(1),
@@ -1089,7 +1097,7 @@ func TestService_ReportPeerResult(t *testing.T) {
 				mr *resource.MockResourceMockRecorder, mp *resource.MockPeerManagerMockRecorder, ms *storagemocks.MockStorageMockRecorder,
 			) {
 				mockPeer.FSM.SetState(resource.PeerStateFailed)
-				mockPeer.StoreParent(mockPeer)
+				mockPeer.StoreParent(resource.NewPeer(idgen.PeerID("127.0.0.2"), mockPeer.Task, mockPeer.Host))
 				gomock.InOrder(
 					mr.PeerManager().Return(peerManager).Times(1),
 					mp.Load(gomock.Eq(mockPeerID)).Return(mockPeer, true).Times(1),
@@ -1416,6 +1424,7 @@ func TestService_AnnounceTask(t *testing.T) {
 			taskManager := resource.NewMockTaskManager(ctl)
 			peerManager := resource.NewMockPeerManager(ctl)
 			svc := New(&config.Config{Scheduler: mockSchedulerConfig, Metrics: &config.MetricsConfig{EnablePeerHost: true}}, res, scheduler, dynconfig, storage)
+			res.EXPECT().EventHub().Return(event.New()).AnyTimes()
 			mockHost := resource.NewHost(mockRawHost)
 			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
 			mockPeer := resource.NewPeer(mockPeerID, mockTask, mockHost)
@@ -1426,6 +1435,149 @@ func TestService_AnnounceTask(t *testing.T) {
 	}
 }
 
+func TestService_FindTaskPeers(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    *rpcscheduler.FindTaskPeersRequest
+		mock   func(mockTask *resource.Task, mockPeer *resource.Peer, mockSeedPeer *resource.Peer, taskManager resource.TaskManager, mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder)
+		expect func(t *testing.T, mockPeer *resource.Peer, mockSeedPeer *resource.Peer, resp *rpcscheduler.FindTaskPeersResponse, err error)
+	}{
+		{
+			name: "task not found",
+			req:  &rpcscheduler.FindTaskPeersRequest{TaskId: mockTaskID, Limit: 2},
+			mock: func(mockTask *resource.Task, mockPeer *resource.Peer, mockSeedPeer *resource.Peer, taskManager resource.TaskManager, mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder) {
+				gomock.InOrder(
+					mr.TaskManager().Return(taskManager).Times(1),
+					mt.Load(gomock.Eq(mockTaskID)).Return(nil, false).Times(1),
+				)
+			},
+			expect: func(t *testing.T, mockPeer *resource.Peer, mockSeedPeer *resource.Peer, resp *rpcscheduler.FindTaskPeersResponse, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(len(resp.Peers), 0)
+			},
+		},
+		{
+			name: "task state is TaskStateRunning",
+			req:  &rpcscheduler.FindTaskPeersRequest{TaskId: mockTaskID, Limit: 2},
+			mock: func(mockTask *resource.Task, mockPeer *resource.Peer, mockSeedPeer *resource.Peer, taskManager resource.TaskManager, mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder) {
+				mockTask.FSM.SetState(resource.TaskStateRunning)
+				gomock.InOrder(
+					mr.TaskManager().Return(taskManager).Times(1),
+					mt.Load(gomock.Eq(mockTaskID)).Return(mockTask, true).Times(1),
+				)
+			},
+			expect: func(t *testing.T, mockPeer *resource.Peer, mockSeedPeer *resource.Peer, resp *rpcscheduler.FindTaskPeersResponse, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(len(resp.Peers), 0)
+			},
+		},
+		{
+			name: "find succeeded peers and seed peer is preferred",
+			req:  &rpcscheduler.FindTaskPeersRequest{TaskId: mockTaskID, Limit: 2},
+			mock: func(mockTask *resource.Task, mockPeer *resource.Peer, mockSeedPeer *resource.Peer, taskManager resource.TaskManager, mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder) {
+				mockTask.FSM.SetState(resource.TaskStateSucceeded)
+				mockPeer.FSM.SetState(resource.PeerStateSucceeded)
+				mockSeedPeer.FSM.SetState(resource.PeerStateSucceeded)
+				gomock.InOrder(
+					mr.TaskManager().Return(taskManager).Times(1),
+					mt.Load(gomock.Eq(mockTaskID)).Return(mockTask, true).Times(1),
+				)
+			},
+			expect: func(t *testing.T, mockPeer *resource.Peer, mockSeedPeer *resource.Peer, resp *rpcscheduler.FindTaskPeersResponse, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(len(resp.Peers), 2)
+				assert.Equal(resp.Peers[0].PiecePacket.DstPid, mockSeedPeer.ID)
+				assert.Equal(resp.Peers[1].PiecePacket.DstPid, mockPeer.ID)
+			},
+		},
+		{
+			name: "find succeeded peers within limit",
+			req:  &rpcscheduler.FindTaskPeersRequest{TaskId: mockTaskID, Limit: 1},
+			mock: func(mockTask *resource.Task, mockPeer *resource.Peer, mockSeedPeer *resource.Peer, taskManager resource.TaskManager, mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder) {
+				mockTask.FSM.SetState(resource.TaskStateSucceeded)
+				mockPeer.FSM.SetState(resource.PeerStateSucceeded)
+				mockSeedPeer.FSM.SetState(resource.PeerStateSucceeded)
+				gomock.InOrder(
+					mr.TaskManager().Return(taskManager).Times(1),
+					mt.Load(gomock.Eq(mockTaskID)).Return(mockTask, true).Times(1),
+				)
+			},
+			expect: func(t *testing.T, mockPeer *resource.Peer, mockSeedPeer *resource.Peer, resp *rpcscheduler.FindTaskPeersResponse, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(len(resp.Peers), 1)
+				assert.Equal(resp.Peers[0].PiecePacket.DstPid, mockSeedPeer.ID)
+			},
+		},
+		{
+			name: "peers are not succeeded or excluded",
+			req:  &rpcscheduler.FindTaskPeersRequest{TaskId: mockTaskID, Limit: 2},
+			mock: func(mockTask *resource.Task, mockPeer *resource.Peer, mockSeedPeer *resource.Peer, taskManager resource.TaskManager, mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder) {
+				mockTask.FSM.SetState(resource.TaskStateSucceeded)
+				mockPeer.FSM.SetState(resource.PeerStateSucceeded)
+				mockPeer.Quarantined.Store(true)
+				mockSeedPeer.FSM.SetState(resource.PeerStateRunning)
+				gomock.InOrder(
+					mr.TaskManager().Return(taskManager).Times(1),
+					mt.Load(gomock.Eq(mockTaskID)).Return(mockTask, true).Times(1),
+				)
+			},
+			expect: func(t *testing.T, mockPeer *resource.Peer, mockSeedPeer *resource.Peer, resp *rpcscheduler.FindTaskPeersResponse, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(len(resp.Peers), 0)
+			},
+		},
+		{
+			name: "security domain does not match",
+			req:  &rpcscheduler.FindTaskPeersRequest{TaskId: mockTaskID, SecurityDomain: "security_domain", Limit: 2},
+			mock: func(mockTask *resource.Task, mockPeer *resource.Peer, mockSeedPeer *resource.Peer, taskManager resource.TaskManager, mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder) {
+				mockTask.FSM.SetState(resource.TaskStateSucceeded)
+				mockPeer.FSM.SetState(resource.PeerStateSucceeded)
+				mockPeer.Host.SecurityDomain = "bar"
+				mockSeedPeer.FSM.SetState(resource.PeerStateSucceeded)
+				gomock.InOrder(
+					mr.TaskManager().Return(taskManager).Times(1),
+					mt.Load(gomock.Eq(mockTaskID)).Return(mockTask, true).Times(1),
+				)
+			},
+			expect: func(t *testing.T, mockPeer *resource.Peer, mockSeedPeer *resource.Peer, resp *rpcscheduler.FindTaskPeersResponse, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(len(resp.Peers), 1)
+				assert.Equal(resp.Peers[0].PiecePacket.DstPid, mockSeedPeer.ID)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			scheduler := mocks.NewMockScheduler(ctl)
+			res := resource.NewMockResource(ctl)
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			storage := storagemocks.NewMockStorage(ctl)
+			taskManager := resource.NewMockTaskManager(ctl)
+			svc := New(&config.Config{Scheduler: mockSchedulerConfig, Metrics: &config.MetricsConfig{EnablePeerHost: true}}, res, scheduler, dynconfig, storage)
+			mockHost := resource.NewHost(mockRawHost)
+			mockSeedHost := resource.NewHost(mockRawSeedHost, resource.WithHostType(resource.HostTypeSuperSeed))
+			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+			mockPeer := resource.NewPeer(mockPeerID, mockTask, mockHost)
+			mockSeedPeer := resource.NewPeer(mockSeedPeerID, mockTask, mockSeedHost)
+			mockTask.StorePeer(mockPeer)
+			mockTask.StorePeer(mockSeedPeer)
+
+			tc.mock(mockTask, mockPeer, mockSeedPeer, taskManager, res.EXPECT(), taskManager.EXPECT())
+			resp, err := svc.FindTaskPeers(context.Background(), tc.req)
+			tc.expect(t, mockPeer, mockSeedPeer, resp, err)
+		})
+	}
+}
+
 func TestService_LeaveTask(t *testing.T) {
 	mockHost := resource.NewHost(mockRawHost)
 	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
@@ -1687,6 +1839,306 @@ func TestService_LeaveTask(t *testing.T) {
 	}
 }
 
+func TestService_SyncProbes(t *testing.T) {
+	tests := []struct {
+		name   string
+		probes []*rpcscheduler.Probe
+		mock   func(host *resource.Host, seedHost *resource.Host, hostManager resource.HostManager, mr *resource.MockResourceMockRecorder, mh *resource.MockHostManagerMockRecorder)
+		expect func(t *testing.T, host *resource.Host, seedHost *resource.Host, resp *rpcscheduler.SyncProbesResponse, err error)
+	}{
+		{
+			name: "host has no probes",
+			mock: func(host *resource.Host, seedHost *resource.Host, hostManager resource.HostManager, mr *resource.MockResourceMockRecorder, mh *resource.MockHostManagerMockRecorder) {
+				gomock.InOrder(
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Load(gomock.Eq(host.ID)).Return(host, true).Times(1),
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Range(gomock.Any()).Do(func(f func(any, any) bool) {
+						f(host.ID, host)
+						f(seedHost.ID, seedHost)
+					}).Times(1),
+				)
+			},
+			expect: func(t *testing.T, host *resource.Host, seedHost *resource.Host, resp *rpcscheduler.SyncProbesResponse, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(len(resp.Targets), 1)
+				assert.Equal(resp.Targets[0].HostId, seedHost.ID)
+				assert.Equal(resp.Targets[0].Ip, seedHost.IP)
+				assert.Equal(resp.Targets[0].RpcPort, seedHost.Port)
+				assert.Equal(host.Probes.Len(), 0)
+			},
+		},
+		{
+			name: "store probes of host",
+			probes: []*rpcscheduler.Probe{
+				{HostId: mockRawSeedHost.Id, Rtt: int64(10 * time.Millisecond), Loss: 0.5},
+				{HostId: mockRawHost.Id, Rtt: 0, Loss: 0},
+			},
+			mock: func(host *resource.Host, seedHost *resource.Host, hostManager resource.HostManager, mr *resource.MockResourceMockRecorder, mh *resource.MockHostManagerMockRecorder) {
+				gomock.InOrder(
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Load(gomock.Eq(host.ID)).Return(host, true).Times(1),
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Load(gomock.Eq(seedHost.ID)).Return(seedHost, true).Times(1),
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Range(gomock.Any()).Return().Times(1),
+				)
+			},
+			expect: func(t *testing.T, host *resource.Host, seedHost *resource.Host, resp *rpcscheduler.SyncProbesResponse, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(len(resp.Targets), 0)
+				assert.Equal(host.Probes.Len(), 1)
+				probe, ok := host.Probes.Load(seedHost.ID)
+				assert.True(ok)
+				assert.Equal(probe.RTT, 10*time.Millisecond)
+				assert.Equal(probe.Loss, float64(0.5))
+				probe, ok = seedHost.Probes.Load(host.ID)
+				assert.True(ok)
+				assert.Equal(probe.RTT, 10*time.Millisecond)
+			},
+		},
+		{
+			name: "probed host does not exist",
+			probes: []*rpcscheduler.Probe{
+				{HostId: mockRawSeedHost.Id, Rtt: int64(10 * time.Millisecond), Loss: 0},
+			},
+			mock: func(host *resource.Host, seedHost *resource.Host, hostManager resource.HostManager, mr *resource.MockResourceMockRecorder, mh *resource.MockHostManagerMockRecorder) {
+				gomock.InOrder(
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Load(gomock.Eq(host.ID)).Return(host, true).Times(1),
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Load(gomock.Eq(seedHost.ID)).Return(nil, false).Times(1),
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Range(gomock.Any()).Return().Times(1),
+				)
+			},
+			expect: func(t *testing.T, host *resource.Host, seedHost *resource.Host, resp *rpcscheduler.SyncProbesResponse, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				_, ok := host.Probes.Load(seedHost.ID)
+				assert.True(ok)
+				assert.Equal(seedHost.Probes.Len(), 0)
+			},
+		},
+		{
+			name: "sample hosts that have not been probed first",
+			mock: func(host *resource.Host, seedHost *resource.Host, hostManager resource.HostManager, mr *resource.MockResourceMockRecorder, mh *resource.MockHostManagerMockRecorder) {
+				host.Probes.Store(seedHost.ID, 10*time.Millisecond, 0)
+				fooHost := resource.NewHost(&rpcscheduler.PeerHost{Id: "foo", Ip: "127.0.0.2", RpcPort: 8003})
+				barHost := resource.NewHost(&rpcscheduler.PeerHost{Id: "bar", Ip: "127.0.0.3", RpcPort: 8003})
+				gomock.InOrder(
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Load(gomock.Eq(host.ID)).Return(host, true).Times(1),
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Range(gomock.Any()).Do(func(f func(any, any) bool) {
+						f(seedHost.ID, seedHost)
+						f(fooHost.ID, fooHost)
+						f(barHost.ID, barHost)
+					}).Times(1),
+				)
+			},
+			expect: func(t *testing.T, host *resource.Host, seedHost *resource.Host, resp *rpcscheduler.SyncProbesResponse, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(len(resp.Targets), 2)
+				assert.ElementsMatch([]string{resp.Targets[0].HostId, resp.Targets[1].HostId}, []string{"foo", "bar"})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			scheduler := mocks.NewMockScheduler(ctl)
+			res := resource.NewMockResource(ctl)
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			storage := storagemocks.NewMockStorage(ctl)
+			hostManager := resource.NewMockHostManager(ctl)
+			svc := New(&config.Config{Scheduler: mockSchedulerConfig}, res, scheduler, dynconfig, storage)
+			host := resource.NewHost(mockRawHost)
+			seedHost := resource.NewHost(mockRawSeedHost, resource.WithHostType(resource.HostTypeSuperSeed))
+
+			tc.mock(host, seedHost, hostManager, res.EXPECT(), hostManager.EXPECT())
+			resp, err := svc.SyncProbes(context.Background(), &rpcscheduler.SyncProbesRequest{
+				PeerHost: mockRawHost,
+				Probes:   tc.probes,
+			})
+			tc.expect(t, host, seedHost, resp, err)
+		})
+	}
+}
+
+func TestService_AnnounceHost(t *testing.T) {
+	tests := []struct {
+		name   string
+		stats  *rpcscheduler.HostStats
+		mock   func(host *resource.Host, hostManager resource.HostManager, mr *resource.MockResourceMockRecorder, mh *resource.MockHostManagerMockRecorder, md *configmocks.MockDynconfigInterfaceMockRecorder)
+		expect func(t *testing.T, host *resource.Host, err error)
+	}{
+		{
+			name: "store stats of host",
+			stats: &rpcscheduler.HostStats{
+				RxBandwidth:          10,
+				TxBandwidth:          20,
+				UploadBandwidthLimit: 100,
+				DiskFree:             1024,
+				DiskTotal:            2048,
+				CpuRatio:             0.5,
+				MemoryRatio:          0.6,
+			},
+			mock: func(host *resource.Host, hostManager resource.HostManager, mr *resource.MockResourceMockRecorder, mh *resource.MockHostManagerMockRecorder, md *configmocks.MockDynconfigInterfaceMockRecorder) {
+				gomock.InOrder(
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Load(gomock.Eq(host.ID)).Return(host, true).Times(1),
+				)
+			},
+			expect: func(t *testing.T, host *resource.Host, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(host.Stats.Len(), 1)
+				stat, ok := host.Stats.Latest()
+				assert.True(ok)
+				assert.Equal(stat.RxBandwidth, uint64(10))
+				assert.Equal(stat.TxBandwidth, uint64(20))
+				assert.Equal(stat.UploadBandwidthLimit, uint64(100))
+				assert.Equal(stat.DiskFree, uint64(1024))
+				assert.Equal(stat.DiskTotal, uint64(2048))
+				assert.Equal(stat.CPURatio, 0.5)
+				assert.Equal(stat.MemoryRatio, 0.6)
+			},
+		},
+		{
+			name:  "register host that does not exist",
+			stats: &rpcscheduler.HostStats{TxBandwidth: 20},
+			mock: func(host *resource.Host, hostManager resource.HostManager, mr *resource.MockResourceMockRecorder, mh *resource.MockHostManagerMockRecorder, md *configmocks.MockDynconfigInterfaceMockRecorder) {
+				gomock.InOrder(
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Load(gomock.Eq(host.ID)).Return(nil, false).Times(1),
+					md.GetSchedulerClusterClientConfig().Return(types.SchedulerClusterClientConfig{}, false).Times(1),
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Store(gomock.Any()).Return().Times(1),
+				)
+			},
+			expect: func(t *testing.T, host *resource.Host, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			scheduler := mocks.NewMockScheduler(ctl)
+			res := resource.NewMockResource(ctl)
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			storage := storagemocks.NewMockStorage(ctl)
+			hostManager := resource.NewMockHostManager(ctl)
+			svc := New(&config.Config{Scheduler: mockSchedulerConfig}, res, scheduler, dynconfig, storage)
+			host := resource.NewHost(mockRawHost)
+
+			tc.mock(host, hostManager, res.EXPECT(), hostManager.EXPECT(), dynconfig.EXPECT())
+			tc.expect(t, host, svc.AnnounceHost(context.Background(), &rpcscheduler.AnnounceHostRequest{
+				PeerHost: mockRawHost,
+				Stats:    tc.stats,
+			}))
+		})
+	}
+}
+
+func TestService_Watch(t *testing.T) {
+	mockEvent := &rpcscheduler.Event{
+		Type:   rpcscheduler.EventType_TASK_SUCCEEDED,
+		TaskId: mockTaskID,
+	}
+
+	tests := []struct {
+		name   string
+		mock   func(ctx context.Context, cancel context.CancelFunc, ms *rpcschedulermocks.MockScheduler_WatchServerMockRecorder)
+		expect func(t *testing.T, err error)
+	}{
+		{
+			name: "context was done",
+			mock: func(ctx context.Context, cancel context.CancelFunc, ms *rpcschedulermocks.MockScheduler_WatchServerMockRecorder) {
+				cancel()
+				ms.Context().Return(ctx).Times(1)
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.ErrorIs(err, context.Canceled)
+			},
+		},
+		{
+			name: "send event",
+			mock: func(ctx context.Context, cancel context.CancelFunc, ms *rpcschedulermocks.MockScheduler_WatchServerMockRecorder) {
+				gomock.InOrder(
+					ms.Context().Return(ctx).Times(1),
+					ms.Send(gomock.Eq(mockEvent)).DoAndReturn(func(e *rpcscheduler.Event) error {
+						cancel()
+						return nil
+					}).MinTimes(1),
+				)
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.ErrorIs(err, context.Canceled)
+			},
+		},
+		{
+			name: "send event failed",
+			mock: func(ctx context.Context, cancel context.CancelFunc, ms *rpcschedulermocks.MockScheduler_WatchServerMockRecorder) {
+				gomock.InOrder(
+					ms.Context().Return(ctx).Times(1),
+					ms.Send(gomock.Eq(mockEvent)).Return(errors.New("foo")).Times(1),
+				)
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "foo")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			scheduler := mocks.NewMockScheduler(ctl)
+			res := resource.NewMockResource(ctl)
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			storage := storagemocks.NewMockStorage(ctl)
+			stream := rpcschedulermocks.NewMockScheduler_WatchServer(ctl)
+			hub := event.New()
+			svc := New(&config.Config{Scheduler: mockSchedulerConfig}, res, scheduler, dynconfig, storage)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			res.EXPECT().EventHub().Return(hub).Times(1)
+			tc.mock(ctx, cancel, stream.EXPECT())
+
+			done := make(chan struct{})
+			go func() {
+				for {
+					select {
+					case <-done:
+						return
+					case <-time.After(10 * time.Millisecond):
+						hub.Publish(mockEvent)
+					}
+				}
+			}()
+
+			err := svc.Watch(&rpcscheduler.WatchRequest{TaskId: mockTaskID}, stream)
+			close(done)
+			tc.expect(t, err)
+		})
+	}
+}
+
 func TestService_registerTask(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -2031,6 +2483,7 @@ func TestService_registerTask(t *testing.T) {
 			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
 			storage := storagemocks.NewMockStorage(ctl)
 			svc := New(tc.config, res, scheduler, dynconfig, storage)
+			res.EXPECT().EventHub().Return(event.New()).AnyTimes()
 
 			taskManager := resource.NewMockTaskManager(ctl)
 			hostManager := resource.NewMockHostManager(ctl)
@@ -2043,12 +2496,221 @@ func TestService_registerTask(t *testing.T) {
 	}
 }
 
-func TestService_registerHost(t *testing.T) {
+func TestService_registerTaskWithFederation(t *testing.T) {
 	tests := []struct {
 		name   string
-		req    *rpcscheduler.PeerTaskRequest
-		mock   func(mockHost *resource.Host, hostManager resource.HostManager, mr *resource.MockResourceMockRecorder, mh *resource.MockHostManagerMockRecorder, md *configmocks.MockDynconfigInterfaceMockRecorder)
-		expect func(t *testing.T, host *resource.Host)
+		config *config.Config
+		run    func(t *testing.T, svc *Service, req *rpcscheduler.PeerTaskRequest, mockTask *resource.Task, mockPeer *resource.Peer, taskManager resource.TaskManager, hostManager resource.HostManager, seedPeer resource.SeedPeer, mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder, mh *resource.MockHostManagerMockRecorder, mc *resource.MockSeedPeerMockRecorder, mf *federationmocks.MockFederationMockRecorder)
+	}{
+		{
+			name: "find peers in federated clusters without blocking seed peer",
+			config: &config.Config{
+				Scheduler: mockSchedulerConfig,
+				SeedPeer: &config.SeedPeerConfig{
+					Enable: true,
+				},
+			},
+			run: func(t *testing.T, svc *Service, req *rpcscheduler.PeerTaskRequest, mockTask *resource.Task, mockPeer *resource.Peer, taskManager resource.TaskManager, hostManager resource.HostManager, seedPeer resource.SeedPeer, mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder, mh *resource.MockHostManagerMockRecorder, mc *resource.MockSeedPeerMockRecorder, mf *federationmocks.MockFederationMockRecorder) {
+				var wg sync.WaitGroup
+				wg.Add(2)
+				defer wg.Wait()
+
+				// Federated clusters answer after seed peer has been triggered.
+				triggered := make(chan struct{})
+				mockTask.FSM.SetState(resource.TaskStatePending)
+				gomock.InOrder(
+					mr.TaskManager().Return(taskManager).Times(1),
+					mt.LoadOrStore(gomock.Any()).Return(mockTask, false).Times(1),
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Load(gomock.Any()).Return(nil, false).Times(1),
+				)
+				mf.FindPeers(gomock.Any(), gomock.Eq(mockTaskID), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, taskID string, securityDomain string) ([]*rpcscheduler.AnnounceTaskRequest, error) {
+						defer wg.Done()
+						<-triggered
+						return nil, nil
+					}).Times(1)
+				mr.SeedPeer().Return(seedPeer).Times(1)
+				mc.TriggerTask(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, task *resource.Task) {
+					close(triggered)
+					wg.Done()
+				}).Return(mockPeer, &rpcscheduler.PeerResult{}, errors.New("foo")).Times(1)
+
+				task, needBackToSource, err := svc.registerTask(context.Background(), req)
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.False(needBackToSource)
+				assert.EqualValues(mockTask, task)
+			},
+		},
+		{
+			name: "find peers in federated clusters before back-to-source",
+			config: &config.Config{
+				Scheduler: mockSchedulerConfig,
+				SeedPeer: &config.SeedPeerConfig{
+					Enable: false,
+				},
+			},
+			run: func(t *testing.T, svc *Service, req *rpcscheduler.PeerTaskRequest, mockTask *resource.Task, mockPeer *resource.Peer, taskManager resource.TaskManager, hostManager resource.HostManager, seedPeer resource.SeedPeer, mr *resource.MockResourceMockRecorder, mt *resource.MockTaskManagerMockRecorder, mh *resource.MockHostManagerMockRecorder, mc *resource.MockSeedPeerMockRecorder, mf *federationmocks.MockFederationMockRecorder) {
+				mockTask.FSM.SetState(resource.TaskStatePending)
+				gomock.InOrder(
+					mr.TaskManager().Return(taskManager).Times(1),
+					mt.LoadOrStore(gomock.Any()).Return(mockTask, false).Times(1),
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Load(gomock.Any()).Return(nil, false).Times(1),
+					mf.FindPeers(gomock.Any(), gomock.Eq(mockTaskID), gomock.Any()).Return(nil, nil).Times(1),
+				)
+
+				task, needBackToSource, err := svc.registerTask(context.Background(), req)
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.True(needBackToSource)
+				assert.EqualValues(mockTask, task)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			scheduler := mocks.NewMockScheduler(ctl)
+			res := resource.NewMockResource(ctl)
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			storage := storagemocks.NewMockStorage(ctl)
+			federation := federationmocks.NewMockFederation(ctl)
+			svc := New(tc.config, res, scheduler, dynconfig, storage, WithFederation(federation))
+			res.EXPECT().EventHub().Return(event.New()).AnyTimes()
+
+			taskManager := resource.NewMockTaskManager(ctl)
+			hostManager := resource.NewMockHostManager(ctl)
+			mockHost := resource.NewHost(mockRawHost)
+			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+			mockPeer := resource.NewPeer(mockPeerID, mockTask, mockHost)
+			seedPeer := resource.NewMockSeedPeer(ctl)
+			req := &rpcscheduler.PeerTaskRequest{
+				Url:     mockTaskURL,
+				UrlMeta: mockTaskURLMeta,
+				PeerHost: &rpcscheduler.PeerHost{
+					Id:             mockRawHost.Id,
+					SecurityDomain: mockRawHost.SecurityDomain,
+				},
+			}
+			tc.run(t, svc, req, mockTask, mockPeer, taskManager, hostManager, seedPeer, res.EXPECT(), taskManager.EXPECT(), hostManager.EXPECT(), seedPeer.EXPECT(), federation.EXPECT())
+		})
+	}
+}
+
+func TestService_findFederatedPeers(t *testing.T) {
+	mockFederatedPeer := &rpcscheduler.AnnounceTaskRequest{
+		TaskId:   mockTaskID,
+		Url:      mockURL,
+		UrlMeta:  &base.UrlMeta{},
+		PeerHost: mockRawHost,
+		PiecePacket: &base.PiecePacket{
+			DstPid:        mockPeerID,
+			PieceInfos:    []*base.PieceInfo{{PieceNum: 1, DownloadCost: 1}},
+			TotalPiece:    1,
+			ContentLength: 1000,
+		},
+	}
+
+	tests := []struct {
+		name string
+		mock func(mockHost *resource.Host, mockTask *resource.Task, mockPeer *resource.Peer,
+			hostManager resource.HostManager, taskManager resource.TaskManager, peerManager resource.PeerManager,
+			mr *resource.MockResourceMockRecorder, mh *resource.MockHostManagerMockRecorder, mt *resource.MockTaskManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder,
+			mf *federationmocks.MockFederationMockRecorder)
+		expect func(t *testing.T, mockTask *resource.Task, ok bool)
+	}{
+		{
+			name: "find peers failed",
+			mock: func(mockHost *resource.Host, mockTask *resource.Task, mockPeer *resource.Peer,
+				hostManager resource.HostManager, taskManager resource.TaskManager, peerManager resource.PeerManager,
+				mr *resource.MockResourceMockRecorder, mh *resource.MockHostManagerMockRecorder, mt *resource.MockTaskManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder,
+				mf *federationmocks.MockFederationMockRecorder) {
+				mockTask.FSM.SetState(resource.TaskStateRunning)
+				mf.FindPeers(gomock.Any(), gomock.Eq(mockTaskID), gomock.Eq("security_domain")).Return(nil, errors.New("foo")).Times(1)
+			},
+			expect: func(t *testing.T, mockTask *resource.Task, ok bool) {
+				assert := assert.New(t)
+				assert.False(ok)
+				assert.Equal(mockTask.FSM.Current(), resource.TaskStateRunning)
+			},
+		},
+		{
+			name: "peers are not found",
+			mock: func(mockHost *resource.Host, mockTask *resource.Task, mockPeer *resource.Peer,
+				hostManager resource.HostManager, taskManager resource.TaskManager, peerManager resource.PeerManager,
+				mr *resource.MockResourceMockRecorder, mh *resource.MockHostManagerMockRecorder, mt *resource.MockTaskManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder,
+				mf *federationmocks.MockFederationMockRecorder) {
+				mockTask.FSM.SetState(resource.TaskStateRunning)
+				mf.FindPeers(gomock.Any(), gomock.Eq(mockTaskID), gomock.Eq("security_domain")).Return(nil, nil).Times(1)
+			},
+			expect: func(t *testing.T, mockTask *resource.Task, ok bool) {
+				assert := assert.New(t)
+				assert.False(ok)
+				assert.Equal(mockTask.FSM.Current(), resource.TaskStateRunning)
+			},
+		},
+		{
+			name: "announce federated peers",
+			mock: func(mockHost *resource.Host, mockTask *resource.Task, mockPeer *resource.Peer,
+				hostManager resource.HostManager, taskManager resource.TaskManager, peerManager resource.PeerManager,
+				mr *resource.MockResourceMockRecorder, mh *resource.MockHostManagerMockRecorder, mt *resource.MockTaskManagerMockRecorder, mp *resource.MockPeerManagerMockRecorder,
+				mf *federationmocks.MockFederationMockRecorder) {
+				mockTask.FSM.SetState(resource.TaskStateRunning)
+				mockPeer.FSM.SetState(resource.PeerStateSucceeded)
+				gomock.InOrder(
+					mf.FindPeers(gomock.Any(), gomock.Eq(mockTaskID), gomock.Eq("security_domain")).Return([]*rpcscheduler.AnnounceTaskRequest{mockFederatedPeer}, nil).Times(1),
+					mr.TaskManager().Return(taskManager).Times(1),
+					mt.LoadOrStore(gomock.Any()).Return(mockTask, true).Times(1),
+					mr.HostManager().Return(hostManager).Times(1),
+					mh.Load(gomock.Any()).Return(mockHost, true).Times(1),
+					mr.PeerManager().Return(peerManager).Times(1),
+					mp.LoadOrStore(gomock.Any()).Return(mockPeer, true).Times(1),
+				)
+			},
+			expect: func(t *testing.T, mockTask *resource.Task, ok bool) {
+				assert := assert.New(t)
+				assert.True(ok)
+				assert.Equal(mockTask.FSM.Current(), resource.TaskStateSucceeded)
+				assert.Equal(mockTask.TotalPieceCount.Load(), int32(1))
+				assert.Equal(mockTask.ContentLength.Load(), int64(1000))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			scheduler := mocks.NewMockScheduler(ctl)
+			res := resource.NewMockResource(ctl)
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			storage := storagemocks.NewMockStorage(ctl)
+			federation := federationmocks.NewMockFederation(ctl)
+			hostManager := resource.NewMockHostManager(ctl)
+			taskManager := resource.NewMockTaskManager(ctl)
+			peerManager := resource.NewMockPeerManager(ctl)
+			svc := New(&config.Config{Scheduler: mockSchedulerConfig, Metrics: &config.MetricsConfig{EnablePeerHost: true}}, res, scheduler, dynconfig, storage, WithFederation(federation))
+			res.EXPECT().EventHub().Return(event.New()).AnyTimes()
+			mockHost := resource.NewHost(mockRawHost)
+			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+			mockPeer := resource.NewPeer(mockPeerID, mockTask, mockHost)
+
+			tc.mock(mockHost, mockTask, mockPeer, hostManager, taskManager, peerManager, res.EXPECT(), hostManager.EXPECT(), taskManager.EXPECT(), peerManager.EXPECT(), federation.EXPECT())
+			tc.expect(t, mockTask, svc.findFederatedPeers(context.Background(), mockTask, "security_domain"))
+		})
+	}
+}
+
+func TestService_registerHost(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    *rpcscheduler.PeerTaskRequest
+		mock   func(mockHost *resource.Host, hostManager resource.HostManager, mr *resource.MockResourceMockRecorder, mh *resource.MockHostManagerMockRecorder, md *configmocks.MockDynconfigInterfaceMockRecorder)
+		expect func(t *testing.T, host *resource.Host)
 	}{
 		{
 			name: "host already exists",
@@ -2318,6 +2980,27 @@ func TestService_registerPeer(t *testing.T) {
 				assert.Equal(peer.Tag, resource.DefaultTag)
 			},
 		},
+		{
+			name: "peer with tag and priority",
+			req: &rpcscheduler.PeerTaskRequest{
+				PeerId:  mockPeerID,
+				UrlMeta: &base.UrlMeta{Tag: "foo", Priority: base.Priority_HIGH_PRIORITY},
+			},
+			mock: func(mockPeer *resource.Peer, peerManager resource.PeerManager, mr *resource.MockResourceMockRecorder, mp *resource.MockPeerManagerMockRecorder) {
+				gomock.InOrder(
+					mr.PeerManager().Return(peerManager).Times(1),
+					mp.LoadOrStore(gomock.Any()).DoAndReturn(func(peer *resource.Peer) (*resource.Peer, bool) {
+						return peer, false
+					}).Times(1),
+				)
+			},
+			expect: func(t *testing.T, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(peer.ID, mockPeerID)
+				assert.Equal(peer.Tag, "foo")
+				assert.Equal(peer.Priority, base.Priority_HIGH_PRIORITY)
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2335,7 +3018,7 @@ func TestService_registerPeer(t *testing.T) {
 			mockPeer := resource.NewPeer(mockPeerID, mockTask, mockHost)
 
 			tc.mock(mockPeer, peerManager, res.EXPECT(), peerManager.EXPECT())
-			peer := svc.registerPeer(context.Background(), tc.req.PeerId, mockTask, mockHost, tc.req.UrlMeta.Tag)
+			peer := svc.registerPeer(context.Background(), tc.req.PeerId, mockTask, mockHost, tc.req.UrlMeta)
 			tc.expect(t, peer)
 		})
 	}
@@ -2373,6 +3056,32 @@ func TestService_handlePieceSuccess(t *testing.T) {
 				assert.Equal(peer.PieceCosts(), []int64{1})
 			},
 		},
+		{
+			name: "piece success and parent restored from snapshot is validated",
+			piece: &rpcscheduler.PieceResult{
+				DstPid: mockSeedPeerID,
+				PieceInfo: &base.PieceInfo{
+					PieceNum: 0,
+					PieceMd5: "ac32345ef819f03710e2105c81106fdd",
+				},
+				BeginTime: uint64(now.UnixNano()),
+				EndTime:   uint64(now.Add(1 * time.Millisecond).UnixNano()),
+			},
+			peer: resource.NewPeer(mockPeerID, mockTask, mockHost),
+			mock: func(peer *resource.Peer) {
+				peer.FSM.SetState(resource.PeerStateRunning)
+				parent := resource.NewPeer(mockSeedPeerID, mockTask, mockHost)
+				parent.NeedValidation.Store(true)
+				peer.StoreParent(parent)
+			},
+			expect: func(t *testing.T, peer *resource.Peer) {
+				assert := assert.New(t)
+				assert.Equal(peer.Pieces.Count(), uint(1))
+				parent, ok := peer.LoadParent()
+				assert.True(ok)
+				assert.False(parent.NeedValidation.Load())
+			},
+		},
 		{
 			name: "piece state is PeerStateBackToSource",
 			piece: &rpcscheduler.PieceResult{
@@ -2565,6 +3274,69 @@ func TestService_handlePieceFail(t *testing.T) {
 				assert.True(parent.FSM.Is(resource.PeerStateRunning))
 			},
 		},
+		{
+			name: "parent restored from snapshot fails to be validated",
+			config: &config.Config{
+				Scheduler: mockSchedulerConfig,
+				SeedPeer:  &config.SeedPeerConfig{Enable: true},
+				Metrics:   &config.MetricsConfig{EnablePeerHost: true},
+			},
+			piece: &rpcscheduler.PieceResult{
+				Code:   base.Code_ClientPieceNotFound,
+				DstPid: mockSeedPeerID,
+			},
+			peer:   resource.NewPeer(mockPeerID, mockTask, mockHost),
+			parent: resource.NewPeer(mockSeedPeerID, mockTask, mockHost),
+			run: func(t *testing.T, svc *Service, peer *resource.Peer, parent *resource.Peer, piece *rpcscheduler.PieceResult, peerManager resource.PeerManager, seedPeer resource.SeedPeer, ms *mocks.MockSchedulerMockRecorder, mr *resource.MockResourceMockRecorder, mp *resource.MockPeerManagerMockRecorder, mc *resource.MockSeedPeerMockRecorder) {
+				peer.FSM.SetState(resource.PeerStateRunning)
+				parent.FSM.SetState(resource.PeerStateSucceeded)
+				parent.NeedValidation.Store(true)
+				blocklist := set.NewSafeSet()
+				blocklist.Add(parent.ID)
+				gomock.InOrder(
+					mr.PeerManager().Return(peerManager).Times(1),
+					mp.Load(gomock.Eq(parent.ID)).Return(parent, true).Times(1),
+					ms.ScheduleParent(gomock.Any(), gomock.Eq(peer), gomock.Eq(blocklist)).Return().Times(1),
+				)
+
+				svc.handlePieceFail(context.Background(), peer, piece)
+				assert := assert.New(t)
+				assert.True(peer.FSM.Is(resource.PeerStateRunning))
+				assert.True(parent.FSM.Is(resource.PeerStateFailed))
+			},
+		},
+		{
+			name: "parent restored from snapshot is not failed by transient failure",
+			config: &config.Config{
+				Scheduler: mockSchedulerConfig,
+				SeedPeer:  &config.SeedPeerConfig{Enable: true},
+				Metrics:   &config.MetricsConfig{EnablePeerHost: true},
+			},
+			piece: &rpcscheduler.PieceResult{
+				Code:   base.Code_ClientPieceRequestFail,
+				DstPid: mockSeedPeerID,
+			},
+			peer:   resource.NewPeer(mockPeerID, mockTask, mockHost),
+			parent: resource.NewPeer(mockSeedPeerID, mockTask, mockHost),
+			run: func(t *testing.T, svc *Service, peer *resource.Peer, parent *resource.Peer, piece *rpcscheduler.PieceResult, peerManager resource.PeerManager, seedPeer resource.SeedPeer, ms *mocks.MockSchedulerMockRecorder, mr *resource.MockResourceMockRecorder, mp *resource.MockPeerManagerMockRecorder, mc *resource.MockSeedPeerMockRecorder) {
+				peer.FSM.SetState(resource.PeerStateRunning)
+				parent.FSM.SetState(resource.PeerStateSucceeded)
+				parent.NeedValidation.Store(true)
+				blocklist := set.NewSafeSet()
+				blocklist.Add(parent.ID)
+				gomock.InOrder(
+					mr.PeerManager().Return(peerManager).Times(1),
+					mp.Load(gomock.Eq(parent.ID)).Return(parent, true).Times(1),
+					ms.ScheduleParent(gomock.Any(), gomock.Eq(peer), gomock.Eq(blocklist)).Return().Times(1),
+				)
+
+				svc.handlePieceFail(context.Background(), peer, piece)
+				assert := assert.New(t)
+				assert.True(peer.FSM.Is(resource.PeerStateRunning))
+				assert.True(parent.FSM.Is(resource.PeerStateSucceeded))
+				assert.True(parent.NeedValidation.Load())
+			},
+		},
 		{
 			name: "piece result code is unknow",
 			config: &config.Config{
@@ -2595,6 +3367,165 @@ func TestService_handlePieceFail(t *testing.T) {
 				assert.True(parent.FSM.Is(resource.PeerStateRunning))
 			},
 		},
+		{
+			name: "piece result code is Code_ClientPieceDigestMismatch and parent is not quarantined",
+			config: &config.Config{
+				Scheduler: &config.SchedulerConfig{
+					RetryLimit:              10,
+					CorruptedPieceLimit:     3,
+					HostCorruptedPieceLimit: 10,
+				},
+				SeedPeer: &config.SeedPeerConfig{Enable: true},
+				Metrics:  &config.MetricsConfig{EnablePeerHost: true},
+			},
+			piece: &rpcscheduler.PieceResult{
+				Code:   base.Code_ClientPieceDigestMismatch,
+				DstPid: mockSeedPeerID,
+			},
+			peer:   resource.NewPeer(mockPeerID, mockTask, resource.NewHost(mockRawHost)),
+			parent: resource.NewPeer(mockSeedPeerID, mockTask, resource.NewHost(mockRawSeedHost)),
+			run: func(t *testing.T, svc *Service, peer *resource.Peer, parent *resource.Peer, piece *rpcscheduler.PieceResult, peerManager resource.PeerManager, seedPeer resource.SeedPeer, ms *mocks.MockSchedulerMockRecorder, mr *resource.MockResourceMockRecorder, mp *resource.MockPeerManagerMockRecorder, mc *resource.MockSeedPeerMockRecorder) {
+				peer.FSM.SetState(resource.PeerStateRunning)
+				parent.FSM.SetState(resource.PeerStateSucceeded)
+				blocklist := set.NewSafeSet()
+				blocklist.Add(parent.ID)
+				gomock.InOrder(
+					mr.PeerManager().Return(peerManager).Times(1),
+					mp.Load(gomock.Eq(parent.ID)).Return(parent, true).Times(1),
+					ms.ScheduleParent(gomock.Any(), gomock.Eq(peer), gomock.Eq(blocklist)).Return().Times(1),
+				)
+
+				svc.handlePieceFail(context.Background(), peer, piece)
+				assert := assert.New(t)
+				assert.Equal(parent.CorruptedPieceCount.Load(), int32(1))
+				assert.Equal(parent.Host.CorruptedPieceCount.Load(), int32(1))
+				assert.False(parent.Quarantined.Load())
+				assert.False(parent.Host.Quarantined.Load())
+			},
+		},
+		{
+			name: "piece result code is Code_ClientPieceDigestMismatch and parent is quarantined",
+			config: &config.Config{
+				Scheduler: &config.SchedulerConfig{
+					RetryLimit:              10,
+					CorruptedPieceLimit:     3,
+					HostCorruptedPieceLimit: 10,
+				},
+				SeedPeer: &config.SeedPeerConfig{Enable: true},
+				Metrics:  &config.MetricsConfig{EnablePeerHost: true},
+			},
+			piece: &rpcscheduler.PieceResult{
+				Code:   base.Code_ClientPieceDigestMismatch,
+				DstPid: mockSeedPeerID,
+			},
+			peer:   resource.NewPeer(mockPeerID, mockTask, resource.NewHost(mockRawHost)),
+			parent: resource.NewPeer(mockSeedPeerID, mockTask, resource.NewHost(mockRawSeedHost)),
+			run: func(t *testing.T, svc *Service, peer *resource.Peer, parent *resource.Peer, piece *rpcscheduler.PieceResult, peerManager resource.PeerManager, seedPeer resource.SeedPeer, ms *mocks.MockSchedulerMockRecorder, mr *resource.MockResourceMockRecorder, mp *resource.MockPeerManagerMockRecorder, mc *resource.MockSeedPeerMockRecorder) {
+				ctl := gomock.NewController(t)
+				defer ctl.Finish()
+				daemonClient := dfdaemonclientmocks.NewMockDaemonClient(ctl)
+				svc.newDaemonClient = func(addr string) (dfdaemonclient.DaemonClient, error) {
+					return daemonClient, nil
+				}
+
+				child := resource.NewPeer(idgen.PeerID("127.0.0.1"), mockTask, resource.NewHost(mockRawHost))
+				peer.FSM.SetState(resource.PeerStateRunning)
+				child.FSM.SetState(resource.PeerStateRunning)
+				parent.FSM.SetState(resource.PeerStateSucceeded)
+				parent.CorruptedPieceCount.Store(2)
+				mockTask.StorePeer(peer)
+				mockTask.StorePeer(child)
+				mockTask.StorePeer(parent)
+				if err := peer.StoreParent(parent); err != nil {
+					t.Fatal(err)
+				}
+				if err := child.StoreParent(parent); err != nil {
+					t.Fatal(err)
+				}
+
+				blocklist := set.NewSafeSet()
+				blocklist.Add(parent.ID)
+				done := make(chan struct{})
+				gomock.InOrder(
+					mr.PeerManager().Return(peerManager).Times(1),
+					mp.Load(gomock.Eq(parent.ID)).Return(parent, true).Times(1),
+					ms.NotifyAndFindParent(gomock.Any(), gomock.Eq(child), gomock.Eq(blocklist)).Return(nil, true).Times(1),
+					ms.ScheduleParent(gomock.Any(), gomock.Eq(peer), gomock.Eq(blocklist)).Return().Times(1),
+				)
+				gomock.InOrder(
+					daemonClient.EXPECT().DeleteTask(gomock.Any(), gomock.Eq(&dfdaemon.DeleteTaskRequest{
+						Url:     mockTask.URL,
+						UrlMeta: mockTask.URLMeta,
+					})).Return(nil).Times(1),
+					daemonClient.EXPECT().Close().DoAndReturn(func() error {
+						close(done)
+						return nil
+					}).Times(1),
+				)
+
+				svc.handlePieceFail(context.Background(), peer, piece)
+				<-done
+				assert := assert.New(t)
+				assert.Equal(parent.CorruptedPieceCount.Load(), int32(3))
+				assert.True(parent.Quarantined.Load())
+				assert.False(parent.Host.Quarantined.Load())
+			},
+		},
+		{
+			name: "piece result code is Code_ClientPieceDigestMismatch and host of parent is quarantined",
+			config: &config.Config{
+				Scheduler: &config.SchedulerConfig{
+					RetryLimit:              10,
+					CorruptedPieceLimit:     3,
+					HostCorruptedPieceLimit: 10,
+				},
+				SeedPeer: &config.SeedPeerConfig{Enable: true},
+				Metrics:  &config.MetricsConfig{EnablePeerHost: true},
+			},
+			piece: &rpcscheduler.PieceResult{
+				Code:   base.Code_ClientPieceDigestMismatch,
+				DstPid: mockSeedPeerID,
+			},
+			peer:   resource.NewPeer(mockPeerID, mockTask, resource.NewHost(mockRawHost)),
+			parent: resource.NewPeer(mockSeedPeerID, mockTask, resource.NewHost(mockRawSeedHost)),
+			run: func(t *testing.T, svc *Service, peer *resource.Peer, parent *resource.Peer, piece *rpcscheduler.PieceResult, peerManager resource.PeerManager, seedPeer resource.SeedPeer, ms *mocks.MockSchedulerMockRecorder, mr *resource.MockResourceMockRecorder, mp *resource.MockPeerManagerMockRecorder, mc *resource.MockSeedPeerMockRecorder) {
+				ctl := gomock.NewController(t)
+				defer ctl.Finish()
+				daemonClient := dfdaemonclientmocks.NewMockDaemonClient(ctl)
+				svc.newDaemonClient = func(addr string) (dfdaemonclient.DaemonClient, error) {
+					return daemonClient, nil
+				}
+
+				peer.FSM.SetState(resource.PeerStateRunning)
+				parent.FSM.SetState(resource.PeerStateSucceeded)
+				parent.Host.CorruptedPieceCount.Store(9)
+
+				blocklist := set.NewSafeSet()
+				blocklist.Add(parent.ID)
+				done := make(chan struct{})
+				ms.NotifyAndFindParent(gomock.Any(), gomock.Any(), gomock.Eq(blocklist)).Return(nil, true).AnyTimes()
+				gomock.InOrder(
+					mr.PeerManager().Return(peerManager).Times(1),
+					mp.Load(gomock.Eq(parent.ID)).Return(parent, true).Times(1),
+					ms.ScheduleParent(gomock.Any(), gomock.Eq(peer), gomock.Eq(blocklist)).Return().Times(1),
+				)
+				gomock.InOrder(
+					daemonClient.EXPECT().DeleteTask(gomock.Any(), gomock.Any()).Return(nil).Times(1),
+					daemonClient.EXPECT().Close().DoAndReturn(func() error {
+						close(done)
+						return nil
+					}).Times(1),
+				)
+
+				svc.handlePieceFail(context.Background(), peer, piece)
+				<-done
+				assert := assert.New(t)
+				assert.Equal(parent.CorruptedPieceCount.Load(), int32(1))
+				assert.Equal(parent.Host.CorruptedPieceCount.Load(), int32(10))
+				assert.True(parent.Quarantined.Load())
+				assert.True(parent.Host.Quarantined.Load())
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2732,6 +3663,253 @@ func TestService_handlePeerSuccess(t *testing.T) {
 	}
 }
 
+func TestService_loadObject(t *testing.T) {
+	tests := []struct {
+		name   string
+		enable bool
+		mock   func(task *resource.Task, objectCache resource.ObjectCache)
+		expect func(t *testing.T, data []byte, ok bool, objectCache resource.ObjectCache)
+	}{
+		{
+			name:   "load object",
+			enable: true,
+			mock: func(task *resource.Task, objectCache resource.ObjectCache) {
+				task.FSM.SetState(resource.TaskStateSucceeded)
+				task.ContentLength.Store(3)
+				objectCache.Store(task.ID, []byte("foo"))
+			},
+			expect: func(t *testing.T, data []byte, ok bool, objectCache resource.ObjectCache) {
+				assert := assert.New(t)
+				assert.True(ok)
+				assert.Equal(data, []byte("foo"))
+			},
+		},
+		{
+			name:   "object cache is disabled",
+			enable: false,
+			mock: func(task *resource.Task, objectCache resource.ObjectCache) {
+				task.FSM.SetState(resource.TaskStateSucceeded)
+			},
+			expect: func(t *testing.T, data []byte, ok bool, objectCache resource.ObjectCache) {
+				assert := assert.New(t)
+				assert.False(ok)
+			},
+		},
+		{
+			name:   "task state is TaskStateRunning",
+			enable: true,
+			mock: func(task *resource.Task, objectCache resource.ObjectCache) {
+				task.FSM.SetState(resource.TaskStateRunning)
+				task.ContentLength.Store(3)
+				objectCache.Store(task.ID, []byte("foo"))
+			},
+			expect: func(t *testing.T, data []byte, ok bool, objectCache resource.ObjectCache) {
+				assert := assert.New(t)
+				assert.False(ok)
+				assert.Equal(objectCache.Len(), 1)
+			},
+		},
+		{
+			name:   "object length does not match task content length",
+			enable: true,
+			mock: func(task *resource.Task, objectCache resource.ObjectCache) {
+				task.FSM.SetState(resource.TaskStateSucceeded)
+				task.ContentLength.Store(4)
+				objectCache.Store(task.ID, []byte("foo"))
+			},
+			expect: func(t *testing.T, data []byte, ok bool, objectCache resource.ObjectCache) {
+				assert := assert.New(t)
+				assert.False(ok)
+				assert.Equal(objectCache.Len(), 0)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			scheduler := mocks.NewMockScheduler(ctl)
+			res := resource.NewMockResource(ctl)
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			storage := storagemocks.NewMockStorage(ctl)
+			svc := New(&config.Config{Scheduler: mockSchedulerConfig}, res, scheduler, dynconfig, storage)
+			objectCache := resource.NewObjectCache(1024, 1024)
+			if tc.enable {
+				svc.objectCache = objectCache
+			}
+
+			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+			tc.mock(mockTask, objectCache)
+			data, ok := svc.loadObject(mockTask)
+			tc.expect(t, data, ok, objectCache)
+		})
+	}
+}
+
+func TestService_storeObject(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write(bytes.Repeat([]byte{1}, 256)); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer s.Close()
+
+	tests := []struct {
+		name   string
+		mock   func(peer *resource.Peer)
+		expect func(t *testing.T, objectCache resource.ObjectCache)
+	}{
+		{
+			name: "store object",
+			mock: func(peer *resource.Peer) {
+				peer.Task.ContentLength.Store(256)
+			},
+			expect: func(t *testing.T, objectCache resource.ObjectCache) {
+				assert := assert.New(t)
+				data, ok := objectCache.Load(mockTaskID)
+				assert.True(ok)
+				assert.Equal(data, bytes.Repeat([]byte{1}, 256))
+			},
+		},
+		{
+			name: "task content length exceeds object size limit",
+			mock: func(peer *resource.Peer) {
+				peer.Task.ContentLength.Store(2048)
+			},
+			expect: func(t *testing.T, objectCache resource.ObjectCache) {
+				assert := assert.New(t)
+				assert.Equal(objectCache.Len(), 0)
+			},
+		},
+		{
+			name: "length of downloaded data does not match task content length",
+			mock: func(peer *resource.Peer) {
+				peer.Task.ContentLength.Store(512)
+			},
+			expect: func(t *testing.T, objectCache resource.ObjectCache) {
+				assert := assert.New(t)
+				assert.Equal(objectCache.Len(), 0)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			scheduler := mocks.NewMockScheduler(ctl)
+			res := resource.NewMockResource(ctl)
+			dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+			storage := storagemocks.NewMockStorage(ctl)
+
+			url, err := url.Parse(s.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ip, rawPort, err := net.SplitHostPort(url.Host)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			port, err := strconv.ParseInt(rawPort, 10, 32)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mockRawSeedHost.Ip = ip
+			mockRawSeedHost.DownPort = int32(port)
+			mockSeedHost := resource.NewHost(mockRawSeedHost, resource.WithHostType(resource.HostTypeSuperSeed))
+			mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+			peer := resource.NewPeer(mockSeedPeerID, mockTask, mockSeedHost)
+			svc := New(&config.Config{
+				Scheduler: &config.SchedulerConfig{
+					ObjectCache: config.ObjectCacheConfig{
+						Enable:          true,
+						ObjectSizeLimit: 1024,
+						SizeLimit:       1024 * 1024,
+					},
+				},
+			}, res, scheduler, dynconfig, storage)
+
+			tc.mock(peer)
+			svc.storeObject(peer)
+			tc.expect(t, svc.objectCache)
+		})
+	}
+}
+
+func TestService_handlePeerSuccessWithObjectCache(t *testing.T) {
+	release := make(chan struct{})
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		if _, err := w.Write(bytes.Repeat([]byte{1}, 256)); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer s.Close()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	scheduler := mocks.NewMockScheduler(ctl)
+	res := resource.NewMockResource(ctl)
+	dynconfig := configmocks.NewMockDynconfigInterface(ctl)
+	storage := storagemocks.NewMockStorage(ctl)
+
+	url, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ip, rawPort, err := net.SplitHostPort(url.Host)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	port, err := strconv.ParseInt(rawPort, 10, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockRawSeedHost.Ip = ip
+	mockRawSeedHost.DownPort = int32(port)
+	mockSeedHost := resource.NewHost(mockRawSeedHost, resource.WithHostType(resource.HostTypeSuperSeed))
+	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
+	peer := resource.NewPeer(mockSeedPeerID, mockTask, mockSeedHost)
+	peer.FSM.SetState(resource.PeerStateRunning)
+	peer.Task.ContentLength.Store(256)
+	peer.Task.TotalPieceCount.Store(1)
+	svc := New(&config.Config{
+		Scheduler: &config.SchedulerConfig{
+			ObjectCache: config.ObjectCacheConfig{
+				Enable:          true,
+				ObjectSizeLimit: 1024,
+				SizeLimit:       1024 * 1024,
+			},
+		},
+		Metrics: &config.MetricsConfig{EnablePeerHost: true},
+	}, res, scheduler, dynconfig, storage)
+
+	// The seed peer succeeds before the object is downloaded.
+	svc.handlePeerSuccess(context.Background(), peer)
+	assert := assert.New(t)
+	assert.True(peer.FSM.Is(resource.PeerStateSucceeded))
+	assert.Equal(svc.objectCache.Len(), 0)
+
+	close(release)
+	assert.Eventually(func() bool {
+		data, ok := svc.objectCache.Load(mockTaskID)
+		return ok && bytes.Equal(data, bytes.Repeat([]byte{1}, 256))
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
 func TestService_handlePeerFail(t *testing.T) {
 	mockHost := resource.NewHost(mockRawHost)
 	mockTask := resource.NewTask(mockTaskID, mockTaskURL, base.TaskType_Normal, mockTaskURLMeta, resource.WithBackToSourceLimit(mockTaskBackToSourceLimit))
@@ -0,0 +1,85 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulator
+
+import (
+	"encoding/json"
+
+	"d7y.io/dragonfly/v2/manager/types"
+	"d7y.io/dragonfly/v2/scheduler/config"
+)
+
+// dynconfig is the static dynconfig of simulation,
+// the scheduler cluster config does not change during simulation.
+type dynconfig struct {
+	clusterConfig types.SchedulerClusterConfig
+}
+
+// NewDynconfig returns the static dynconfig with the scheduler cluster config.
+func NewDynconfig(clusterConfig types.SchedulerClusterConfig) config.DynconfigInterface {
+	return &dynconfig{clusterConfig: clusterConfig}
+}
+
+// GetSchedulerClusterConfig returns the scheduler cluster config.
+func (d *dynconfig) GetSchedulerClusterConfig() (types.SchedulerClusterConfig, bool) {
+	return d.clusterConfig, true
+}
+
+// GetSchedulerClusterClientConfig returns the empty client config.
+func (d *dynconfig) GetSchedulerClusterClientConfig() (types.SchedulerClusterClientConfig, bool) {
+	return types.SchedulerClusterClientConfig{}, false
+}
+
+// Get returns the dynconfig data with the scheduler cluster config.
+func (d *dynconfig) Get() (*config.DynconfigData, error) {
+	clusterConfig, err := json.Marshal(d.clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &config.DynconfigData{
+		SchedulerCluster: &config.SchedulerCluster{Config: clusterConfig},
+	}, nil
+}
+
+// Register notifies the observer at once, the config does not change.
+func (d *dynconfig) Register(o config.Observer) {
+	data, err := d.Get()
+	if err != nil {
+		return
+	}
+
+	o.OnNotify(data)
+}
+
+// Deregister does nothing, the config does not change.
+func (d *dynconfig) Deregister(config.Observer) {}
+
+// Notify does nothing, the config does not change.
+func (d *dynconfig) Notify() error {
+	return nil
+}
+
+// Serve does nothing, the config does not change.
+func (d *dynconfig) Serve() error {
+	return nil
+}
+
+// Stop does nothing, the config does not change.
+func (d *dynconfig) Stop() error {
+	return nil
+}
@@ -0,0 +1,427 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"d7y.io/dragonfly/v2/pkg/container/set"
+	"d7y.io/dragonfly/v2/pkg/idgen"
+	"d7y.io/dragonfly/v2/pkg/rpc/base"
+	rpcscheduler "d7y.io/dragonfly/v2/pkg/rpc/scheduler"
+	"d7y.io/dragonfly/v2/scheduler/resource"
+	"d7y.io/dragonfly/v2/scheduler/scheduler"
+	"d7y.io/dragonfly/v2/scheduler/storage"
+)
+
+const (
+	// DefaultBandwidth is the default upload bandwidth of host in bytes per second.
+	DefaultBandwidth = 100 * 1024 * 1024
+
+	// DefaultSourceBandwidth is the default bandwidth of downloading back-to-source in bytes per second.
+	DefaultSourceBandwidth = 20 * 1024 * 1024
+
+	// defaultPieceSize is the piece size used when the record has no piece count.
+	defaultPieceSize = 4 * 1024 * 1024
+)
+
+// Simulator replays the download records against the scheduler.
+type Simulator interface {
+	// Simulate reconstructs tasks, hosts and arrival order from the records,
+	// and schedules the peers in arrival order with a simulated piece transfer model.
+	Simulate([]storage.Record) (*Result, error)
+}
+
+// Result is the metrics of simulation.
+type Result struct {
+	// TaskCount is the count of reconstructed tasks.
+	TaskCount int `json:"task_count"`
+
+	// PeerCount is the count of peers.
+	PeerCount int `json:"peer_count"`
+
+	// SeedPeerCount is the count of seed peers.
+	SeedPeerCount int `json:"seed_peer_count"`
+
+	// BackToSourceCount is the count of normal peers downloading back-to-source.
+	BackToSourceCount int `json:"back_to_source_count"`
+
+	// BackToSourceRatio is the ratio of normal peers downloading back-to-source.
+	BackToSourceRatio float64 `json:"back_to_source_ratio"`
+
+	// RecordedBackToSourceRatio is the ratio of normal peers downloading back-to-source in records.
+	RecordedBackToSourceRatio float64 `json:"recorded_back_to_source_ratio"`
+
+	// DepthDistribution is the count of peers by the depth of tree.
+	DepthDistribution map[int]int `json:"depth_distribution"`
+
+	// HostUploads is the upload load of hosts, sorted by upload count in descending order.
+	HostUploads []HostUpload `json:"host_uploads"`
+
+	// CompletionTime is the distribution of estimated download time of peers.
+	CompletionTime Distribution `json:"completion_time"`
+
+	// RecordedCompletionTime is the distribution of download time of peers in records.
+	RecordedCompletionTime Distribution `json:"recorded_completion_time"`
+}
+
+// HostUpload is the upload load of host.
+type HostUpload struct {
+	// Hostname is host name.
+	Hostname string `json:"hostname"`
+
+	// IP is host ip.
+	IP string `json:"ip"`
+
+	// UploadCount is the count of children served by host.
+	UploadCount int `json:"upload_count"`
+
+	// MaxConcurrentUploadCount is the maximum count of children served by host at the same time.
+	MaxConcurrentUploadCount int32 `json:"max_concurrent_upload_count"`
+}
+
+// Distribution is the distribution of durations.
+type Distribution struct {
+	Mean time.Duration `json:"mean"`
+	P50  time.Duration `json:"p50"`
+	P90  time.Duration `json:"p90"`
+	P99  time.Duration `json:"p99"`
+	Max  time.Duration `json:"max"`
+}
+
+// Option is a functional option for configuring the simulator.
+type Option func(s *simulator)
+
+// WithBandwidth sets the upload bandwidth of host in bytes per second,
+// the bandwidth is shared by the children of host.
+func WithBandwidth(bandwidth int64) Option {
+	return func(s *simulator) {
+		s.bandwidth = bandwidth
+	}
+}
+
+// WithSourceBandwidth sets the bandwidth of downloading back-to-source in bytes per second.
+func WithSourceBandwidth(bandwidth int64) Option {
+	return func(s *simulator) {
+		s.sourceBandwidth = bandwidth
+	}
+}
+
+type simulator struct {
+	// scheduler is the scheduler to be simulated.
+	scheduler scheduler.Scheduler
+
+	// bandwidth is the upload bandwidth of host.
+	bandwidth int64
+
+	// sourceBandwidth is the bandwidth of downloading back-to-source.
+	sourceBandwidth int64
+}
+
+// New returns a new Simulator.
+func New(scheduler scheduler.Scheduler, options ...Option) Simulator {
+	s := &simulator{
+		scheduler:       scheduler,
+		bandwidth:       DefaultBandwidth,
+		sourceBandwidth: DefaultSourceBandwidth,
+	}
+
+	for _, opt := range options {
+		opt(s)
+	}
+
+	return s
+}
+
+// download is the simulated download of peer.
+type download struct {
+	peer     *resource.Peer
+	startAt  time.Duration
+	finishAt time.Duration
+}
+
+// Simulate reconstructs tasks, hosts and arrival order from the records,
+// and schedules the peers in arrival order with a simulated piece transfer model.
+func (s *simulator) Simulate(records []storage.Record) (*Result, error) {
+	if len(records) == 0 {
+		return nil, errors.New("records are empty")
+	}
+
+	records = append([]storage.Record(nil), records...)
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].CreateAt < records[j].CreateAt
+	})
+	origin := records[0].CreateAt
+
+	var (
+		result = &Result{
+			DepthDistribution: map[int]int{},
+		}
+		taskIDs         = groupTasks(records)
+		tasks           = map[string]*resource.Task{}
+		hosts           = map[string]*resource.Host{}
+		uploads         = map[*resource.Host]*HostUpload{}
+		downloads       = map[string]*download{}
+		running         []*download
+		costs           []time.Duration
+		recordedCosts   []time.Duration
+		normalPeerCount int
+		recordedCount   int
+	)
+
+	for _, record := range records {
+		now := time.Duration(record.CreateAt - origin)
+		running = s.advance(running, now)
+
+		task, ok := tasks[taskIDs[record.ID]]
+		if !ok {
+			task = newTask(taskIDs[record.ID], record)
+			tasks[task.ID] = task
+		}
+
+		hostKey := fmt.Sprintf("%s-%s", record.Hostname, record.IP)
+		host, ok := hosts[hostKey]
+		if !ok {
+			host = newHost(record)
+			hosts[hostKey] = host
+			uploads[host] = &HostUpload{Hostname: host.Hostname, IP: host.IP}
+		}
+
+		peer := resource.NewPeer(record.ID, task, host)
+		task.StorePeer(peer)
+		host.StorePeer(peer)
+		peer.FSM.SetState(resource.PeerStateRunning)
+		if !task.FSM.Is(resource.TaskStateSucceeded) {
+			task.FSM.SetState(resource.TaskStateRunning)
+		}
+
+		d := &download{peer: peer, startAt: now}
+		size := task.ContentLength.Load()
+		if host.Type != resource.HostTypeNormal {
+			result.SeedPeerCount++
+			peer.IsBackToSource.Store(true)
+			d.finishAt = now + transferTime(size, s.sourceBandwidth, record.Cost)
+		} else if parent, ok := s.scheduler.FindParent(context.Background(), peer, set.NewSafeSet()); ok {
+			peer.ReplaceParents([]*resource.Peer{parent})
+
+			upload := uploads[parent.Host]
+			upload.UploadCount++
+			if count := parent.Host.UploadPeerCount.Load(); count > upload.MaxConcurrentUploadCount {
+				upload.MaxConcurrentUploadCount = count
+			}
+
+			// The bandwidth of parent is shared by its children, and child
+			// can not finish before parent.
+			d.finishAt = now + transferTime(size, s.bandwidth/int64(parent.Host.UploadPeerCount.Load()), record.Cost)
+			if pd, ok := downloads[parent.ID]; ok && pd.finishAt > d.finishAt {
+				d.finishAt = pd.finishAt
+			}
+		} else {
+			result.BackToSourceCount++
+			peer.IsBackToSource.Store(true)
+			d.finishAt = now + transferTime(size, s.sourceBandwidth, record.Cost)
+		}
+
+		if host.Type == resource.HostTypeNormal {
+			normalPeerCount++
+			result.DepthDistribution[peer.Depth()]++
+
+			switch record.State {
+			case storage.PeerStateBackToSourceSucceeded, storage.PeerStateBackToSourceFailed:
+				recordedCount++
+			}
+		}
+
+		downloads[peer.ID] = d
+		running = append(running, d)
+		costs = append(costs, d.finishAt-d.startAt)
+		recordedCosts = append(recordedCosts, time.Duration(record.Cost)*time.Millisecond)
+	}
+	s.advance(running, math.MaxInt64)
+
+	result.TaskCount = len(tasks)
+	result.PeerCount = len(records)
+	if normalPeerCount > 0 {
+		result.BackToSourceRatio = float64(result.BackToSourceCount) / float64(normalPeerCount)
+		result.RecordedBackToSourceRatio = float64(recordedCount) / float64(normalPeerCount)
+	}
+
+	for _, upload := range uploads {
+		if upload.UploadCount > 0 {
+			result.HostUploads = append(result.HostUploads, *upload)
+		}
+	}
+	sort.Slice(result.HostUploads, func(i, j int) bool {
+		if result.HostUploads[i].UploadCount != result.HostUploads[j].UploadCount {
+			return result.HostUploads[i].UploadCount > result.HostUploads[j].UploadCount
+		}
+
+		return result.HostUploads[i].Hostname < result.HostUploads[j].Hostname
+	})
+
+	result.CompletionTime = newDistribution(costs)
+	result.RecordedCompletionTime = newDistribution(recordedCosts)
+	return result, nil
+}
+
+// advance moves the simulated clock to now, the downloads finished before now
+// succeed and release the upload of parents, and the pieces of running downloads
+// are updated by the elapsed time.
+func (s *simulator) advance(running []*download, now time.Duration) []*download {
+	var remaining []*download
+	for _, d := range running {
+		peer := d.peer
+		totalPieceCount := uint(peer.Task.TotalPieceCount.Load())
+		if d.finishAt <= now {
+			for i := uint(0); i < totalPieceCount; i++ {
+				peer.Pieces.Set(i)
+			}
+
+			peer.FSM.SetState(resource.PeerStateSucceeded)
+			peer.Task.FSM.SetState(resource.TaskStateSucceeded)
+			peer.DeleteParents()
+			continue
+		}
+
+		if elapsed := now - d.startAt; elapsed > 0 {
+			finished := uint(float64(totalPieceCount) * float64(elapsed) / float64(d.finishAt-d.startAt))
+			for i := uint(0); i < finished; i++ {
+				peer.Pieces.Set(i)
+			}
+		}
+
+		remaining = append(remaining, d)
+	}
+
+	return remaining
+}
+
+// groupTasks returns the map of peer id and task id. The records written before
+// task id is recorded do not carry it, so their task is reconstructed as the peers
+// connected by parent relations.
+func groupTasks(records []storage.Record) map[string]string {
+	parents := map[string]string{}
+	var find func(id string) string
+	find = func(id string) string {
+		parent, ok := parents[id]
+		if !ok || parent == id {
+			parents[id] = id
+			return id
+		}
+
+		root := find(parent)
+		parents[id] = root
+		return root
+	}
+
+	for _, record := range records {
+		if record.TaskID != "" {
+			continue
+		}
+
+		find(record.ID)
+		if record.ParentID != "" {
+			parents[find(record.ID)] = find(record.ParentID)
+		}
+	}
+
+	taskIDs := map[string]string{}
+	for _, record := range records {
+		if record.TaskID != "" {
+			taskIDs[record.ID] = record.TaskID
+			continue
+		}
+
+		taskIDs[record.ID] = idgen.TaskID(find(record.ID), nil)
+	}
+
+	return taskIDs
+}
+
+// newTask returns the task reconstructed from the record.
+func newTask(id string, record storage.Record) *resource.Task {
+	task := resource.NewTask(id, record.ID, base.TaskType_Normal, nil)
+	task.ContentLength.Store(record.ContentLength)
+
+	totalPieceCount := record.TotalPieceCount
+	if totalPieceCount <= 0 && record.ContentLength > 0 {
+		totalPieceCount = int32((record.ContentLength + defaultPieceSize - 1) / defaultPieceSize)
+	}
+
+	if totalPieceCount <= 0 {
+		totalPieceCount = 1
+	}
+	task.TotalPieceCount.Store(totalPieceCount)
+	return task
+}
+
+// newHost returns the host reconstructed from the record.
+func newHost(record storage.Record) *resource.Host {
+	return resource.NewHost(&rpcscheduler.PeerHost{
+		Id:             idgen.HostID(record.Hostname, 0),
+		Ip:             record.IP,
+		HostName:       record.Hostname,
+		SecurityDomain: record.SecurityDomain,
+		Idc:            record.IDC,
+		NetTopology:    record.NetTopology,
+		Location:       record.Location,
+	}, resource.WithHostType(resource.HostType(record.HostType)))
+}
+
+// transferTime returns the time of transferring the content with the bandwidth,
+// the recorded cost is used if the content length is unknown.
+func transferTime(contentLength, bandwidth int64, cost uint32) time.Duration {
+	if contentLength <= 0 || bandwidth <= 0 {
+		return time.Duration(cost) * time.Millisecond
+	}
+
+	return time.Duration(float64(contentLength) / float64(bandwidth) * float64(time.Second))
+}
+
+// newDistribution returns the distribution of durations.
+func newDistribution(durations []time.Duration) Distribution {
+	if len(durations) == 0 {
+		return Distribution{}
+	}
+
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+
+	percentile := func(p float64) time.Duration {
+		return sorted[int(p*float64(len(sorted)-1))]
+	}
+
+	return Distribution{
+		Mean: sum / time.Duration(len(sorted)),
+		P50:  percentile(0.5),
+		P90:  percentile(0.9),
+		P99:  percentile(0.99),
+		Max:  sorted[len(sorted)-1],
+	}
+}
@@ -0,0 +1,248 @@
+/*
+ *     Copyright 2022 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"d7y.io/dragonfly/v2/manager/types"
+	"d7y.io/dragonfly/v2/pkg/idgen"
+	"d7y.io/dragonfly/v2/scheduler/config"
+	"d7y.io/dragonfly/v2/scheduler/resource"
+	"d7y.io/dragonfly/v2/scheduler/scheduler"
+	"d7y.io/dragonfly/v2/scheduler/storage"
+)
+
+var (
+	mockTaskID = idgen.TaskID("https://example.com", nil)
+
+	mockSeedRecord = storage.Record{
+		ID:            "seed",
+		IP:            "127.0.0.1",
+		Hostname:      "seed",
+		Cost:          1000,
+		ContentLength: 8 * 1024 * 1024,
+		HostType:      int(resource.HostTypeSuperSeed),
+		CreateAt:      0,
+	}
+
+	mockChildRecord = storage.Record{
+		ID:            "foo",
+		IP:            "127.0.0.2",
+		Hostname:      "foo",
+		Cost:          2000,
+		ContentLength: 8 * 1024 * 1024,
+		HostType:      int(resource.HostTypeNormal),
+		CreateAt:      int64(time.Millisecond),
+		ParentID:      "seed",
+	}
+
+	mockGrandchildRecord = storage.Record{
+		ID:            "bar",
+		IP:            "127.0.0.3",
+		Hostname:      "bar",
+		Cost:          3000,
+		ContentLength: 8 * 1024 * 1024,
+		HostType:      int(resource.HostTypeNormal),
+		CreateAt:      int64(2 * time.Millisecond),
+		ParentID:      "foo",
+	}
+
+	mockBackToSourceRecord = storage.Record{
+		ID:            "baz",
+		IP:            "127.0.0.4",
+		Hostname:      "baz",
+		Cost:          4000,
+		ContentLength: 8 * 1024 * 1024,
+		State:         storage.PeerStateBackToSourceSucceeded,
+		HostType:      int(resource.HostTypeNormal),
+		CreateAt:      int64(3 * time.Millisecond),
+	}
+)
+
+func TestSimulator_Simulate(t *testing.T) {
+	tests := []struct {
+		name    string
+		records []storage.Record
+		expect  func(t *testing.T, result *Result, err error)
+	}{
+		{
+			name:    "records are empty",
+			records: []storage.Record{},
+			expect: func(t *testing.T, result *Result, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "records are empty")
+			},
+		},
+		{
+			name:    "peers download from seed peer",
+			records: []storage.Record{mockGrandchildRecord, mockChildRecord, mockSeedRecord},
+			expect: func(t *testing.T, result *Result, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(result.TaskCount, 1)
+				assert.Equal(result.PeerCount, 3)
+				assert.Equal(result.SeedPeerCount, 1)
+				assert.Equal(result.BackToSourceCount, 0)
+				assert.Equal(result.BackToSourceRatio, float64(0))
+				assert.Equal(result.DepthDistribution[1], 0)
+				assert.NotEmpty(result.HostUploads)
+				assert.Greater(result.CompletionTime.Max, time.Duration(0))
+				assert.Equal(result.RecordedCompletionTime.Max, 3*time.Second)
+			},
+		},
+		{
+			name:    "peer downloads back-to-source",
+			records: []storage.Record{mockSeedRecord, mockChildRecord, mockBackToSourceRecord},
+			expect: func(t *testing.T, result *Result, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(result.TaskCount, 2)
+				assert.Equal(result.PeerCount, 3)
+				assert.Equal(result.BackToSourceCount, 1)
+				assert.Equal(result.BackToSourceRatio, 0.5)
+				assert.Equal(result.RecordedBackToSourceRatio, 0.5)
+				assert.Equal(result.DepthDistribution, map[int]int{1: 1, 2: 1})
+				assert.Equal(len(result.HostUploads), 1)
+				assert.Equal(result.HostUploads[0].Hostname, "seed")
+				assert.Equal(result.HostUploads[0].UploadCount, 1)
+				assert.Equal(result.HostUploads[0].MaxConcurrentUploadCount, int32(1))
+			},
+		},
+		{
+			name: "back-to-source peers of the same task",
+			records: func() []storage.Record {
+				record := mockBackToSourceRecord
+				record.TaskID = mockTaskID
+				otherRecord := mockBackToSourceRecord
+				otherRecord.ID = "qux"
+				otherRecord.Hostname = "qux"
+				otherRecord.IP = "127.0.0.5"
+				otherRecord.TaskID = mockTaskID
+				return []storage.Record{record, otherRecord}
+			}(),
+			expect: func(t *testing.T, result *Result, err error) {
+				assert := assert.New(t)
+				assert.NoError(err)
+				assert.Equal(result.TaskCount, 1)
+				assert.Equal(result.PeerCount, 2)
+				assert.Equal(result.RecordedBackToSourceRatio, float64(1))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dynconfig := NewDynconfig(types.SchedulerClusterConfig{})
+			s := New(scheduler.New(&config.SchedulerConfig{Algorithm: "default"}, dynconfig, ""))
+			result, err := s.Simulate(tc.records)
+			tc.expect(t, result, err)
+		})
+	}
+}
+
+func TestSimulator_groupTasks(t *testing.T) {
+	tests := []struct {
+		name    string
+		records func() []storage.Record
+		expect  func(t *testing.T, taskIDs map[string]string)
+	}{
+		{
+			name: "group peers by parent relations without task id",
+			records: func() []storage.Record {
+				return []storage.Record{mockGrandchildRecord, mockChildRecord, mockSeedRecord, mockBackToSourceRecord}
+			},
+			expect: func(t *testing.T, taskIDs map[string]string) {
+				assert := assert.New(t)
+				assert.Equal(len(taskIDs), 4)
+				assert.Equal(taskIDs["seed"], taskIDs["foo"])
+				assert.Equal(taskIDs["foo"], taskIDs["bar"])
+				assert.NotEqual(taskIDs["seed"], taskIDs["baz"])
+			},
+		},
+		{
+			name: "group unlinked back-to-source peers by task id",
+			records: func() []storage.Record {
+				record := mockBackToSourceRecord
+				record.TaskID = mockTaskID
+				otherRecord := mockBackToSourceRecord
+				otherRecord.ID = "qux"
+				otherRecord.TaskID = mockTaskID
+				return []storage.Record{record, otherRecord}
+			},
+			expect: func(t *testing.T, taskIDs map[string]string) {
+				assert := assert.New(t)
+				assert.Equal(len(taskIDs), 2)
+				assert.Equal(taskIDs["baz"], mockTaskID)
+				assert.Equal(taskIDs["qux"], mockTaskID)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expect(t, groupTasks(tc.records()))
+		})
+	}
+}
+
+func TestSimulator_transferTime(t *testing.T) {
+	tests := []struct {
+		name          string
+		contentLength int64
+		bandwidth     int64
+		cost          uint32
+		expect        time.Duration
+	}{
+		{
+			name:          "transfer content with bandwidth",
+			contentLength: 1024,
+			bandwidth:     512,
+			cost:          100,
+			expect:        2 * time.Second,
+		},
+		{
+			name:          "content length is unknown",
+			contentLength: -1,
+			bandwidth:     512,
+			cost:          100,
+			expect:        100 * time.Millisecond,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(transferTime(tc.contentLength, tc.bandwidth, tc.cost), tc.expect)
+		})
+	}
+}
+
+func TestSimulator_newDistribution(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(newDistribution(nil), Distribution{})
+	assert.Equal(newDistribution([]time.Duration{3 * time.Second, 1 * time.Second, 2 * time.Second}), Distribution{
+		Mean: 2 * time.Second,
+		P50:  2 * time.Second,
+		P90:  2 * time.Second,
+		P99:  2 * time.Second,
+		Max:  3 * time.Second,
+	})
+}
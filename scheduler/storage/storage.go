@@ -82,6 +82,9 @@ type Record struct {
 	// ID is peer id.
 	ID string `csv:"id"`
 
+	// TaskID is task id.
+	TaskID string `csv:"taskID"`
+
 	// IP is host ip.
 	IP string `csv:"ip"`
 
@@ -273,37 +276,7 @@ func (s *storage) List() ([]Record, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	fileInfos, err := s.backups()
-	if err != nil {
-		return nil, err
-	}
-
-	var readers []io.Reader
-	var closers []io.ReadCloser
-	defer func() {
-		for _, closer := range closers {
-			if err := closer.Close(); err != nil {
-				logger.Error(err)
-			}
-		}
-	}()
-
-	for _, fileInfo := range fileInfos {
-		file, err := os.Open(filepath.Join(s.baseDir, fileInfo.Name()))
-		if err != nil {
-			return nil, err
-		}
-
-		readers = append(readers, file)
-		closers = append(closers, file)
-	}
-
-	var records []Record
-	if err := gocsv.UnmarshalWithoutHeaders(io.MultiReader(readers...), &records); err != nil {
-		return nil, err
-	}
-
-	return records, nil
+	return ListRecords(s.baseDir)
 }
 
 // Clear removes all records.
@@ -380,9 +353,51 @@ func (s *storage) backupFilename() string {
 	return filepath.Join(s.baseDir, fmt.Sprintf("%s-%s.%s", RecordFilePrefix, timestamp, RecordFileExt))
 }
 
-// backupFilename returns backup file information.
+// backups returns backup file information.
 func (s *storage) backups() ([]fs.FileInfo, error) {
-	fileInfos, err := ioutil.ReadDir(s.baseDir)
+	return backups(s.baseDir)
+}
+
+// ListRecords returns all of records in csv files of the base directory.
+// Unlike New, it does not truncate the record file, so it can be used
+// to read records offline, e.g. for training.
+func ListRecords(baseDir string) ([]Record, error) {
+	fileInfos, err := backups(baseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var readers []io.Reader
+	var closers []io.ReadCloser
+	defer func() {
+		for _, closer := range closers {
+			if err := closer.Close(); err != nil {
+				logger.Error(err)
+			}
+		}
+	}()
+
+	for _, fileInfo := range fileInfos {
+		file, err := os.Open(filepath.Join(baseDir, fileInfo.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		readers = append(readers, file)
+		closers = append(closers, file)
+	}
+
+	var records []Record
+	if err := gocsv.UnmarshalWithoutHeaders(io.MultiReader(readers...), &records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// backups returns record file information of the base directory.
+func backups(baseDir string) ([]fs.FileInfo, error) {
+	fileInfos, err := ioutil.ReadDir(baseDir)
 	if err != nil {
 		return nil, err
 	}
@@ -339,6 +339,66 @@ func TestStorage_List(t *testing.T) {
 	}
 }
 
+func TestStorage_ListRecords(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseDir string
+		mock    func(t *testing.T, baseDir string)
+		expect  func(t *testing.T, baseDir string)
+	}{
+		{
+			name:    "directory does not exist",
+			baseDir: "bar",
+			mock:    func(t *testing.T, baseDir string) {},
+			expect: func(t *testing.T, baseDir string) {
+				assert := assert.New(t)
+				_, err := ListRecords(baseDir)
+				assert.Error(err)
+			},
+		},
+		{
+			name:    "list records without truncating record file",
+			baseDir: os.TempDir(),
+			mock: func(t *testing.T, baseDir string) {
+				s, err := New(baseDir, WithBufferSize(1))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if err := s.Create(Record{ID: "1"}); err != nil {
+					t.Fatal(err)
+				}
+
+				if err := s.Create(Record{ID: "2"}); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(t *testing.T, baseDir string) {
+				assert := assert.New(t)
+				records, err := ListRecords(baseDir)
+				assert.NoError(err)
+				assert.Equal(len(records), 1)
+				assert.Equal(records[0].ID, "1")
+
+				records, err = ListRecords(baseDir)
+				assert.NoError(err)
+				assert.Equal(len(records), 1)
+
+				if err := os.Remove(filepath.Join(baseDir, fmt.Sprintf("%s.%s", RecordFilePrefix, RecordFileExt))); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mock(t, tc.baseDir)
+			tc.expect(t, tc.baseDir)
+		})
+	}
+}
+
 func TestStorage_Clear(t *testing.T) {
 	tests := []struct {
 		name    string